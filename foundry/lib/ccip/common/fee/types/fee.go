@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 )
 
 // Opt is an option for a gas estimator
@@ -13,3 +14,19 @@ const (
 )
 
 type Fee fmt.Stringer
+
+// Urgency is how fast a transaction needs to be included, estimators supporting it price the fee accordingly.
+type Urgency string
+
+const (
+	UrgencyLow    Urgency = "Low"
+	UrgencyMedium Urgency = "Medium"
+	UrgencyHigh   Urgency = "High"
+)
+
+// Urgencies are the supported urgencies, from the least to the most urgent.
+var Urgencies = []Urgency{UrgencyLow, UrgencyMedium, UrgencyHigh}
+
+func (u Urgency) IsValid() bool {
+	return slices.Contains(Urgencies, u)
+}
@@ -54,6 +54,25 @@ var (
 
 var ErrTxRemoved = errors.New("tx removed")
 
+// ErrTxCancelled resumes the pipeline runs of cancelled transactions, see Txm.Cancel.
+var ErrTxCancelled = errors.New("transaction cancelled")
+
+// RevertError is returned by a TransmitChecker that dropped a transaction because it reverted during simulation.
+// Its Reason is recorded on the transaction alongside the error.
+type RevertError struct {
+	// Reason is the decoded revert reason.
+	Reason string
+	Err    error
+}
+
+func (e *RevertError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.Err
+}
+
 type ProcessUnstartedTxs[ADDR types.Hashable] func(ctx context.Context, fromAddress ADDR) (retryable bool, err error)
 
 // TransmitCheckerFactory creates a transmit checker based on a spec.
@@ -135,6 +154,11 @@ type Broadcaster[
 	// Each key has its own trigger
 	triggers map[ADDR]chan struct{}
 
+	// relayFailures counts the failed private relay submissions of the in_progress txs by ID,
+	// to fall back to the public mempool after PrivateRelay.FallbackAttempts
+	relayFailuresMu sync.Mutex
+	relayFailures   map[int64]uint32
+
 	chStop services.StopChan
 	wg     sync.WaitGroup
 
@@ -179,6 +203,7 @@ func NewBroadcaster[
 		checkerFactory:   checkerFactory,
 		autoSyncSequence: autoSyncSequence,
 		sequenceTracker:  sequenceTracker,
+		relayFailures:    make(map[int64]uint32),
 	}
 
 	b.processUnstartedTxsImpl = b.processUnstartedTxs
@@ -452,7 +477,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		lgr.Warn("Transmission checker timed out, sending anyway")
 	} else if err != nil {
 		etx.Error = null.StringFrom(err.Error())
-		lgr.Warnw("Transmission checker failed, fatally erroring transaction.", "err", err)
+		var revertErr *RevertError
+		if errors.As(err, &revertErr) {
+			etx.RevertReason = null.StringFrom(revertErr.Reason)
+		}
+		lgr.Warnw("Transmission checker failed, fatally erroring transaction.", "err", err, "revertReason", etx.RevertReason.String)
 		return eb.saveFatallyErroredTransaction(lgr, etx), true
 	}
 	cancel()
@@ -467,6 +496,49 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	return eb.handleInProgressTx(ctx, *etx, attempt, time.Now())
 }
 
+// sendTransaction sends the attempt through the private relay until it failed PrivateRelay.FallbackAttempts times,
+// and to the public mempool otherwise
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) sendTransaction(ctx context.Context, lgr logger.SugaredLogger, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (client.SendTxReturnCode, error) {
+	relay := eb.txConfig.PrivateRelay()
+	if !relay.Enabled() {
+		lgr.Infow("Sending transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", attempt.ChainSpecificFeeLimit, "callerProvidedFeeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
+		return eb.client.SendTransactionReturnCode(ctx, etx, attempt, lgr)
+	}
+
+	eb.relayFailuresMu.Lock()
+	failures := eb.relayFailures[etx.ID]
+	eb.relayFailuresMu.Unlock()
+
+	var errType client.SendTxReturnCode
+	var err error
+	if fallbackAttempts := relay.FallbackAttempts(); fallbackAttempts > 0 && failures >= fallbackAttempts {
+		lgr.Warnw("Private relay fallback reached, sending transaction publicly", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "relayFailures", failures, "fallbackAttempts", fallbackAttempts)
+		errType, err = eb.client.SendTransactionReturnCode(ctx, etx, attempt, lgr)
+	} else {
+		lgr.Infow("Sending transaction to private relay", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", attempt.ChainSpecificFeeLimit, "callerProvidedFeeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
+		errType, err = eb.client.SendPrivateTransactionReturnCode(ctx, relay, etx, attempt, lgr)
+	}
+
+	eb.relayFailuresMu.Lock()
+	defer eb.relayFailuresMu.Unlock()
+	switch errType {
+	case client.Successful, client.TransactionAlreadyKnown, client.Fatal:
+		delete(eb.relayFailures, etx.ID)
+	default:
+		// Relays also fail with errors the nodes never return (auth, unknown methods, rejected bundles), which are
+		// classified as Unknown. The tx stays in_progress and is sent again unless its sequence was consumed.
+		eb.relayFailures[etx.ID] = failures + 1
+	}
+	return errType, err
+}
+
+// forgetRelayFailures drops the private relay failures of a tx that left in_progress
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) forgetRelayFailures(etxID int64) {
+	eb.relayFailuresMu.Lock()
+	defer eb.relayFailuresMu.Unlock()
+	delete(eb.relayFailures, etxID)
+}
+
 // There can be at most one in_progress transaction per address.
 // Here we complete the job that we didn't finish last time.
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleInProgressTx(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], initialBroadcastAt time.Time) (error, bool) {
@@ -475,8 +547,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	}
 
 	lgr := etx.GetLogger(logger.With(eb.lggr, "fee", attempt.TxFee))
-	lgr.Infow("Sending transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", attempt.ChainSpecificFeeLimit, "callerProvidedFeeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
-	errType, err := eb.client.SendTransactionReturnCode(ctx, etx, attempt, lgr)
+	errType, err := eb.sendTransaction(ctx, lgr, etx, attempt)
 
 	if errType != client.Fatal {
 		etx.InitialBroadcastAt = &initialBroadcastAt
@@ -581,6 +652,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 			// Despite the error, the RPC node considers the previously sent
 			// transaction to have been accepted. In this case, the right thing to
 			// do is assume success and hand off to Confirmer
+			eb.forgetRelayFailures(etx.ID)
 
 			err = eb.txStore.UpdateTxAttemptInProgressToBroadcast(ctx, &etx, attempt, txmgrtypes.TxAttemptBroadcast)
 			if err != nil {
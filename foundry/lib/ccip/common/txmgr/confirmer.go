@@ -304,6 +304,15 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 	ec.lggr.Debugw("Finished RebroadcastWhereNecessary", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 	mark = time.Now()
 
+	if ec.txConfig.PrivateRelay().Enabled() {
+		if err := ec.ResubmitPrivateTransactions(ctx, head.BlockNumber()); err != nil {
+			return fmt.Errorf("ResubmitPrivateTransactions failed: %w", err)
+		}
+
+		ec.lggr.Debugw("Finished ResubmitPrivateTransactions", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
+		mark = time.Now()
+	}
+
 	if err := ec.EnsureConfirmedTransactionsInLongestChain(ctx, head); err != nil {
 		return fmt.Errorf("EnsureConfirmedTransactionsInLongestChain failed: %w", err)
 	}
@@ -699,13 +708,29 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Fin
 		lggr.Infow(fmt.Sprintf("Found %d transactions to be re-sent that were previously rejected due to insufficient native token balance", len(etxInsufficientFunds)), "blockNum", blockNum, "address", address)
 	}
 
+	// Txs with an urgency are bumped by the threshold of their fee tier, so the lowest of the thresholds is queried
+	// and the txs that have not been pending for their own threshold yet are filtered out afterwards
+	queryThreshold := gasBumpThreshold
+	tiered := false
+	for _, urgency := range feetypes.Urgencies {
+		threshold := int64(ec.feeConfig.FeeTierBumpThreshold(urgency))
+		tiered = tiered || threshold != gasBumpThreshold
+		if threshold > 0 && (queryThreshold == 0 || threshold < queryThreshold) {
+			queryThreshold = threshold
+		}
+	}
+
 	// TODO: Just pass the Q through everything
-	etxBumps, err := ec.txStore.FindTxsRequiringGasBump(ctx, address, blockNum, gasBumpThreshold, bumpDepth, chainID)
+	etxBumps, err := ec.txStore.FindTxsRequiringGasBump(ctx, address, blockNum, queryThreshold, bumpDepth, chainID)
 	if ctx.Err() != nil {
 		return nil, nil
 	} else if err != nil {
 		return nil, err
 	}
+	// A tier threshold higher than gasBumpThreshold doesn't change the query, the txs of the tier are filtered all the same
+	if tiered {
+		etxBumps = ec.filterTxsByBumpThreshold(etxBumps, blockNum, gasBumpThreshold)
+	}
 
 	if len(etxBumps) > 0 {
 		// txes are ordered by sequence asc so the first will always be the oldest
@@ -747,6 +772,32 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Fin
 	return
 }
 
+// filterTxsByBumpThreshold keeps the txs whose attempts were all broadcast at least the bump threshold of the tx
+// blocks ago, the threshold is the one of the fee tier for txs with an urgency and gasBumpThreshold otherwise.
+// A threshold of 0 disables bumping.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) filterTxsByBumpThreshold(etxs []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockNum, gasBumpThreshold int64) (filtered []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	for _, etx := range etxs {
+		threshold := gasBumpThreshold
+		if etx.Urgency != "" {
+			threshold = int64(ec.feeConfig.FeeTierBumpThreshold(etx.Urgency))
+		}
+		if threshold == 0 {
+			continue
+		}
+		bump := true
+		for _, attempt := range etx.TxAttempts {
+			if attempt.BroadcastBeforeBlockNum == nil || *attempt.BroadcastBeforeBlockNum > blockNum-threshold {
+				bump = false
+				break
+			}
+		}
+		if bump {
+			filtered = append(filtered, etx)
+		}
+	}
+	return
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) attemptForRebroadcast(ctx context.Context, lggr logger.Logger, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	if len(etx.TxAttempts) > 0 {
 		etx.TxAttempts[0].Tx = etx
@@ -820,7 +871,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) han
 
 	now := time.Now()
 	lggr.Debugw("Sending transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", attempt.ChainSpecificFeeLimit, "callerProvidedFeeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
-	errType, sendError := ec.client.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+	errType, sendError := ec.sendTransaction(ctx, lggr, &etx, attempt, blockHeight)
 
 	switch errType {
 	case client.Underpriced:
@@ -912,6 +963,75 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) han
 	}
 }
 
+// sendTransaction sends the attempt through the private relay while the tx is within the fallback
+// window of the relay, and to the public mempool otherwise
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sendTransaction(ctx context.Context, lggr logger.SugaredLogger, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockHeight int64) (client.SendTxReturnCode, error) {
+	relay := ec.txConfig.PrivateRelay()
+	if relay.Enabled() {
+		private, err := ec.withinPrivateRelayWindow(ctx, relay, etx, blockHeight)
+		if err != nil {
+			return client.Retryable, err
+		}
+		if private {
+			return ec.client.SendPrivateTransactionReturnCode(ctx, relay, *etx, attempt, lggr)
+		}
+		lggr.Debugw("Private relay fallback reached, sending transaction publicly", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "fallbackBlocks", relay.FallbackBlocks())
+	}
+	return ec.client.SendTransactionReturnCode(ctx, *etx, attempt, lggr)
+}
+
+// withinPrivateRelayWindow returns true if the tx was first broadcast less than FallbackBlocks ago.
+// The attempts of the tx are lazily loaded since they are only needed with a fallback.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) withinPrivateRelayWindow(ctx context.Context, relay txmgrtypes.PrivateRelayConfig, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockHeight int64) (bool, error) {
+	if relay.FallbackBlocks() == 0 {
+		return true, nil
+	}
+	if len(etx.TxAttempts) == 0 {
+		if err := ec.txStore.LoadTxAttempts(ctx, etx); err != nil {
+			return false, fmt.Errorf("failed to load TxAttempts: %w", err)
+		}
+	}
+	var firstBroadcastBlockNum *int64
+	for _, a := range etx.TxAttempts {
+		if a.BroadcastBeforeBlockNum != nil && (firstBroadcastBlockNum == nil || *a.BroadcastBeforeBlockNum < *firstBroadcastBlockNum) {
+			firstBroadcastBlockNum = a.BroadcastBeforeBlockNum
+		}
+	}
+	// no attempt has been seen by a head yet, the tx was only just broadcast
+	if firstBroadcastBlockNum == nil {
+		return true, nil
+	}
+	return blockHeight-*firstBroadcastBlockNum < int64(relay.FallbackBlocks()), nil
+}
+
+// ResubmitPrivateTransactions re-sends the highest priced attempt of every unconfirmed tx.
+// Txs sent through the private relay never show up in the public mempool, and relays only keep
+// them for a limited number of blocks, so they are re-submitted to the relay on every head until
+// included, and sent publicly on every head once past the fallback window.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ResubmitPrivateTransactions(ctx context.Context, blockHeight int64) error {
+	for _, address := range ec.enabledAddresses {
+		// a threshold of 1 block finds every unconfirmed tx whose attempts were all broadcast before this head
+		etxs, err := ec.txStore.FindTxsRequiringGasBump(ctx, address, blockHeight, 1, 0, ec.chainID)
+		if err != nil {
+			return fmt.Errorf("FindTxsRequiringGasBump failed: %w", err)
+		}
+		for _, etx := range etxs {
+			if len(etx.TxAttempts) == 0 {
+				continue
+			}
+			lggr := etx.GetLogger(ec.lggr)
+			attempt := etx.TxAttempts[0]
+			lggr.Debugw("Re-submitting transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "blockHeight", blockHeight)
+			errType, err := ec.sendTransaction(ctx, lggr, etx, attempt, blockHeight)
+			if errType != client.Successful && errType != client.TransactionAlreadyKnown {
+				// the attempt is still broadcast, it is retried on the next head
+				lggr.Warnw("Failed to re-submit transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "errType", errType, "err", err)
+			}
+		}
+	}
+	return nil
+}
+
 // EnsureConfirmedTransactionsInLongestChain finds all confirmed txes up to the depth
 // of the given chain and ensures that every one has a receipt with a block hash that is
 // in the given chain.
@@ -1113,7 +1233,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Res
 	for _, data := range receiptsPlus {
 		var taskErr error
 		var output interface{}
-		if data.FailOnRevert && data.Receipt.GetStatus() == 0 {
+		if data.Cancelled {
+			// The receipt is the one of the transfer that replaced the transaction.
+			taskErr = ErrTxCancelled
+		} else if data.FailOnRevert && data.Receipt.GetStatus() == 0 {
 			taskErr = fmt.Errorf("transaction %s reverted on-chain", data.Receipt.GetTxHash())
 		} else {
 			output = data.Receipt
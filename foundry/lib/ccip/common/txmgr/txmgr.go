@@ -49,6 +49,12 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// Cancel marks an unstarted transaction fatally errored, or replaces an unconfirmed one with a zero value transfer
+	// to its own sender at the same sequence and with bumped fees, flagging it as cancelled in its meta
+	Cancel(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// Replace swaps the payload, and the gas limit when not nil, of an unstarted transaction, or re-sends an unconfirmed
+	// one with them at the same sequence and with bumped fees
+	Replace(ctx context.Context, txID int64, payload []byte, gasLimit *uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -60,6 +66,8 @@ type TxManager[
 	FindEarliestUnconfirmedBroadcastTime(ctx context.Context) (nullv4.Time, error)
 	FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error)
 	CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (count uint32, err error)
+	// FindReceiptByTxHash returns the receipt of the transaction attempt with the given hash
+	FindReceiptByTxHash(ctx context.Context, hash TX_HASH) (txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], error)
 }
 
 type reset struct {
@@ -250,6 +258,141 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) abandon(ad
 	return nil
 }
 
+// Cancel stops Broadcaster/Confirmer, cancels the transaction, then starts them again.
+// An unconfirmed transaction is replaced by a zero value transfer to its own sender, which the Confirmer sends on the next head.
+// It is flagged as cancelled in its meta so that its pipeline run is resumed with ErrTxCancelled rather than the receipt of the
+// replacement, the pipeline run of an unstarted transaction is resumed right away.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Cancel(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return b.replaceWithReset(ctx, txID, func(tx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+		meta, err := tx.GetMeta()
+		if err != nil {
+			return fmt.Errorf("failed to read meta of tx %d: %w", tx.ID, err)
+		}
+		if meta == nil {
+			meta = &txmgrtypes.TxMeta[ADDR, TX_HASH]{}
+		}
+		cancelled := true
+		meta.Cancelled = &cancelled
+		if err = tx.SetMeta(meta); err != nil {
+			return fmt.Errorf("failed to flag tx %d as cancelled: %w", tx.ID, err)
+		}
+		tx.ToAddress = tx.FromAddress
+		tx.Value = *big.NewInt(0)
+		tx.EncodedPayload = []byte{}
+		return nil
+	}, true)
+}
+
+// Replace stops Broadcaster/Confirmer, replaces the payload and the optional gas limit of the transaction, then starts them again.
+// An unconfirmed transaction is re-sent with the new payload by the Confirmer on the next head.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Replace(ctx context.Context, txID int64, payload []byte, gasLimit *uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return b.replaceWithReset(ctx, txID, func(tx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+		tx.EncodedPayload = payload
+		if gasLimit != nil {
+			tx.FeeLimit = *gasLimit
+		}
+		return nil
+	}, false)
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) replaceWithReset(ctx context.Context, txID int64, modify func(*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error, cancel bool) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	ok := b.IfStarted(func() {
+		done := make(chan error)
+		var replaceErr error
+		f := func() {
+			etx, replaceErr = b.replace(ctx, txID, modify, cancel)
+		}
+
+		b.reset <- reset{f, done}
+		if err = <-done; err == nil {
+			err = replaceErr
+		}
+	})
+	if !ok {
+		return etx, errors.New("not started")
+	}
+	return etx, err
+}
+
+// replace modifies the transaction and persists it along with any replacement attempt
+// this must not be run while Broadcaster or Confirmer are running
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) replace(ctx context.Context, txID int64, modify func(*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error, cancel bool) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	tx, err := b.txStore.GetTxByID(ctx, txID)
+	if err != nil {
+		return etx, fmt.Errorf("failed to load tx %d: %w", txID, err)
+	}
+	if tx == nil || tx.ChainID.String() != b.chainID.String() {
+		return etx, fmt.Errorf("tx %d not found for chain %s", txID, b.chainID.String())
+	}
+	lggr := tx.GetLogger(b.logger)
+
+	switch tx.State {
+	case TxUnstarted:
+		if cancel {
+			tx.Error = nullv4.StringFrom("cancelled")
+			if err = b.resumeCancelled(ctx, tx, lggr); err != nil {
+				return etx, err
+			}
+			if err = b.txStore.UpdateTxFatalError(ctx, tx); err != nil {
+				return etx, fmt.Errorf("failed to cancel tx %d: %w", txID, err)
+			}
+			lggr.Infow("Cancelled unstarted transaction")
+			return *tx, nil
+		}
+		if err = modify(tx); err != nil {
+			return etx, err
+		}
+		if err = b.txStore.UpdateTxUnstartedPayload(ctx, tx); err != nil {
+			return etx, fmt.Errorf("failed to replace tx %d: %w", txID, err)
+		}
+		lggr.Infow("Replaced payload of unstarted transaction")
+		return *tx, nil
+	case TxUnconfirmed:
+		if len(tx.TxAttempts) == 0 {
+			return etx, fmt.Errorf("unconfirmed tx %d has no attempts", txID)
+		}
+		for _, attempt := range tx.TxAttempts {
+			if attempt.State == txmgrtypes.TxAttemptInProgress {
+				return etx, fmt.Errorf("tx %d has an attempt in progress, try again later", txID)
+			}
+		}
+		if err = modify(tx); err != nil {
+			return etx, err
+		}
+		// attempts are sorted by fee descending, so the first one is used as the base for the bump
+		attempt, _, _, _, err := b.txAttemptBuilder.NewBumpTxAttempt(ctx, *tx, tx.TxAttempts[0], tx.TxAttempts, lggr)
+		if err != nil {
+			return etx, fmt.Errorf("failed to build replacement attempt for tx %d: %w", txID, err)
+		}
+		if err = b.txStore.UpdateTxUnconfirmedForReplacement(ctx, tx, &attempt); err != nil {
+			return etx, fmt.Errorf("failed to save replacement attempt for tx %d: %w", txID, err)
+		}
+		tx.TxAttempts = append([]txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{attempt}, tx.TxAttempts...)
+		lggr.Infow("Saved replacement attempt for unconfirmed transaction", "cancel", cancel, "attemptID", attempt.ID)
+		return *tx, nil
+	default:
+		if cancel {
+			return etx, fmt.Errorf("cannot cancel tx %d in state %s", txID, tx.State)
+		}
+		return etx, fmt.Errorf("cannot replace tx %d in state %s", txID, tx.State)
+	}
+}
+
+// resumeCancelled resumes the pipeline run waiting for the cancelled unstarted transaction with ErrTxCancelled.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeCancelled(ctx context.Context, tx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], lggr logger.SugaredLogger) error {
+	if !tx.PipelineTaskRunID.Valid || b.resumeCallback == nil || !tx.SignalCallback {
+		return nil
+	}
+	err := b.resumeCallback(tx.PipelineTaskRunID.UUID, nil, ErrTxCancelled)
+	if errors.Is(err, sql.ErrNoRows) {
+		lggr.Debugw("callback missing or already resumed")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to resume pipeline of tx %d: %w", tx.ID, err)
+	}
+	return b.txStore.UpdateTxCallbackCompleted(ctx, tx.PipelineTaskRunID.UUID, b.chainID)
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (merr error) {
 	return b.StopOnce("Txm", func() error {
 		close(b.chStop)
@@ -494,6 +637,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		}
 	}
 
+	if txRequest.Urgency != "" && !txRequest.Urgency.IsValid() {
+		return tx, fmt.Errorf("Txm#CreateTransaction: invalid urgency %q, must be one of %v", txRequest.Urgency, feetypes.Urgencies)
+	}
+
 	if err = b.checkEnabled(ctx, txRequest.FromAddress); err != nil {
 		return tx, err
 	}
@@ -595,6 +742,14 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindEarlie
 	return b.txStore.FindEarliestUnconfirmedBroadcastTime(ctx, b.chainID)
 }
 
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindReceiptByTxHash(ctx context.Context, hash TX_HASH) (txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], error) {
+	receipt, err := b.txStore.FindReceiptByTxHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error) {
 	return b.txStore.FindEarliestUnconfirmedTxAttemptBlock(ctx, b.chainID)
 }
@@ -641,6 +796,16 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Res
 	return nil
 }
 
+// Cancel does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Cancel(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
+// Replace does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Replace(ctx context.Context, txID int64, payload []byte, gasLimit *uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return etx, errors.New(n.ErrMsg)
@@ -674,6 +839,10 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Fin
 	return nullv4.Time{}, errors.New(n.ErrMsg)
 }
 
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FindReceiptByTxHash(ctx context.Context, hash TX_HASH) (txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], error) {
+	return nil, errors.New(n.ErrMsg)
+}
+
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error) {
 	return nullv4.Int{}, errors.New(n.ErrMsg)
 }
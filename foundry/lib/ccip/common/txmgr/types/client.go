@@ -60,6 +60,14 @@ type TransactionClient[
 		attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 		lggr logger.SugaredLogger,
 	) (client.SendTxReturnCode, error)
+	// SendPrivateTransactionReturnCode sends the attempt to the private relay instead of the public mempool
+	SendPrivateTransactionReturnCode(
+		ctx context.Context,
+		relay PrivateRelayConfig,
+		tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+		attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+		lggr logger.SugaredLogger,
+	) (client.SendTxReturnCode, error)
 	SendEmptyTransaction(
 		ctx context.Context,
 		newTxAttempt func(ctx context.Context, seq SEQ, feeLimit uint64, fee FEE, fromAddress ADDR) (attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error),
@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"net/url"
+	"time"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+)
 
 type TransactionManagerChainConfig interface {
 	BroadcasterChainConfig
@@ -34,6 +39,7 @@ type BroadcasterFeeConfig interface {
 
 type BroadcasterTransactionsConfig interface {
 	MaxInFlight() uint32
+	PrivateRelay() PrivateRelayConfig
 }
 
 type BroadcasterListenerConfig interface {
@@ -48,6 +54,8 @@ type ConfirmerFeeConfig interface {
 	BumpThreshold() uint64
 	MaxFeePrice() string // logging value
 	BumpPercent() uint16
+	// FeeTierBumpThreshold is the bump threshold of the txs with the given urgency
+	FeeTierBumpThreshold(urgency feetypes.Urgency) uint64
 }
 
 type ConfirmerChainConfig interface {
@@ -63,6 +71,7 @@ type ConfirmerDatabaseConfig interface {
 type ConfirmerTransactionsConfig interface {
 	MaxInFlight() uint32
 	ForwardersEnabled() bool
+	PrivateRelay() PrivateRelayConfig
 }
 
 type ResenderChainConfig interface {
@@ -74,6 +83,17 @@ type ResenderTransactionsConfig interface {
 	MaxInFlight() uint32
 }
 
+// PrivateRelayConfig is the config of the relay that receives the signed txs in place of the public mempool
+type PrivateRelayConfig interface {
+	Enabled() bool
+	URL() *url.URL
+	Method() string
+	// FallbackBlocks is the number of blocks after the first broadcast before a tx is sent publicly, 0 never falls back
+	FallbackBlocks() uint32
+	// FallbackAttempts is the number of failed relay submissions of a new tx before it is sent publicly, 0 never falls back
+	FallbackAttempts() uint32
+}
+
 // ReaperConfig is the config subset used by the reaper
 //
 //go:generate mockery --quiet --name ReaperChainConfig --structname ReaperConfig --output ./mocks/ --case=underscore
@@ -95,6 +95,9 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Urgency selects the fee tier the tx is priced and bumped with, the fees are estimated as is when empty.
+	Urgency feetypes.Urgency
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -161,6 +164,10 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
 	SeqNumbers []uint64 `json:"SeqNumbers,omitempty"`
+
+	// Cancelled is set on txs cancelled by replacing them with a zero value transfer to their sender, their pipeline run is
+	// resumed with ErrTxCancelled once the transfer is confirmed
+	Cancelled *bool `json:"Cancelled,omitempty"`
 }
 
 type TxAttempt[
@@ -207,6 +214,8 @@ type Tx[
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
 	FeeLimit uint64
 	Error    null.String
+	// RevertReason is the decoded reason of the revert that caused the tx to be dropped before broadcast, if any.
+	RevertReason null.String
 	// BroadcastAt is updated every time an attempt for this tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt *time.Time
@@ -233,6 +242,9 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Urgency selects the fee tier the tx is priced and bumped with, the fees are estimated as is when empty.
+	Urgency feetypes.Urgency
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -260,6 +272,17 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetMeta() (*TxMeta[A
 	return &m, nil
 }
 
+// SetMeta replaces the Tx's meta with the JSON encoding of m.
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetMeta(m *TxMeta[ADDR, TX_HASH]) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshalling meta: %w", err)
+	}
+	meta := sqlutil.JSON(b)
+	e.Meta = &meta
+	return nil
+}
+
 // GetLogger returns a new logger with metadata fields.
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger.Logger) logger.SugaredLogger {
 	lgr = logger.With(lgr,
@@ -269,6 +292,10 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger
 		"feeLimit", e.FeeLimit,
 	)
 
+	if e.Urgency != "" {
+		lgr = logger.With(lgr, "urgency", e.Urgency)
+	}
+
 	meta, err := e.GetMeta()
 	if err != nil {
 		lgr.Errorw("failed to get meta of the transaction", "err", err)
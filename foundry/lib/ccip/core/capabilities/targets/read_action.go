@@ -0,0 +1,272 @@
+package targets
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	abiutil "github.com/smartcontractkit/chainlink/v2/core/chains/evm/abi"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func InitializeRead(registry commontypes.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer, lggr logger.Logger) error {
+	for _, chain := range legacyEVMChains.Slice() {
+		capability := NewEvmRead(chain, lggr)
+		if err := registry.Add(context.TODO(), capability); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ capabilities.ActionCapability = &EvmRead{}
+)
+
+// Confidence levels of the block the calls are made at.
+const (
+	ConfidenceLatest    = "latest"
+	ConfidenceSafe      = "safe"
+	ConfidenceFinalized = "finalized"
+	ConfidencePending   = "pending"
+)
+
+// EvmRead reads contracts state with a single batch of eth_call requests.
+type EvmRead struct {
+	chain legacyevm.Chain
+	capabilities.CapabilityInfo
+	lggr logger.Logger
+}
+
+func NewEvmRead(chain legacyevm.Chain, lggr logger.Logger) *EvmRead {
+	// generate ID based on chain selector
+	name := fmt.Sprintf("read_%v", chain.ID())
+	chainName, err := chainselectors.NameFromChainId(chain.ID().Uint64())
+	if err == nil {
+		name = fmt.Sprintf("read_%v", chainName)
+	}
+
+	info := capabilities.MustNewCapabilityInfo(
+		name,
+		capabilities.CapabilityTypeAction,
+		"Read action.",
+		"v1.0.0",
+	)
+
+	return &EvmRead{
+		chain,
+		info,
+		lggr.Named("EvmRead"),
+	}
+}
+
+// EvmReadConfig selects the block the calls are made at: either a block number, or a confidence level, latest by default.
+type EvmReadConfig struct {
+	BlockNumber uint64
+	Confidence  string
+}
+
+// EvmReadCall is a contract call, Args are converted to the types of the Method inputs.
+type EvmReadCall struct {
+	Address string
+	// ABI is the JSON ABI of the contract, it must contain Method.
+	ABI    string
+	Method string
+	Args   []any
+}
+
+func parseReadConfig(rawConfig *values.Map) (EvmReadConfig, error) {
+	var config EvmReadConfig
+	if rawConfig == nil {
+		return config, nil
+	}
+	configAny, err := rawConfig.Unwrap()
+	if err != nil {
+		return config, err
+	}
+	if err = mapstructure.Decode(configAny, &config); err != nil {
+		return config, err
+	}
+
+	switch config.Confidence {
+	case "", ConfidenceLatest, ConfidenceSafe, ConfidenceFinalized, ConfidencePending:
+	default:
+		return config, fmt.Errorf("unknown confidence %q", config.Confidence)
+	}
+	if config.BlockNumber > 0 && config.Confidence != "" {
+		return config, errors.New("only one of blockNumber and confidence can be set")
+	}
+	return config, nil
+}
+
+func (c EvmReadConfig) blockTag() string {
+	if c.BlockNumber > 0 {
+		return hexutil.EncodeBig(new(big.Int).SetUint64(c.BlockNumber))
+	}
+	if c.Confidence != "" {
+		return c.Confidence
+	}
+	return ConfidenceLatest
+}
+
+type packedCall struct {
+	address common.Address
+	method  abi.Method
+	data    []byte
+}
+
+func packCall(call EvmReadCall) (packedCall, error) {
+	if !common.IsHexAddress(call.Address) {
+		return packedCall{}, fmt.Errorf("invalid address %q", call.Address)
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(call.ABI))
+	if err != nil {
+		return packedCall{}, fmt.Errorf("invalid abi: %w", err)
+	}
+	method, ok := contractABI.Methods[call.Method]
+	if !ok {
+		return packedCall{}, fmt.Errorf("method %q not found in abi", call.Method)
+	}
+	if len(call.Args) != len(method.Inputs) {
+		return packedCall{}, fmt.Errorf("method %s expects %d arguments, got %d", method.Name, len(method.Inputs), len(call.Args))
+	}
+
+	args := make([]any, len(call.Args))
+	for i, arg := range call.Args {
+		args[i], err = abiutil.ConvertArg(method.Inputs[i].Type, arg)
+		if err != nil {
+			return packedCall{}, fmt.Errorf("argument %d of %s: %w", i, method.Name, err)
+		}
+	}
+
+	data, err := contractABI.Pack(method.Name, args...)
+	if err != nil {
+		return packedCall{}, err
+	}
+	return packedCall{address: common.HexToAddress(call.Address), method: method, data: data}, nil
+}
+
+// unpackOutputs returns the single output of a method, or a map of its outputs keyed by name (or position when unnamed).
+func unpackOutputs(method abi.Method, data []byte) (values.Value, error) {
+	outputs, err := method.Outputs.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(outputs) == 1 {
+		return abiutil.WrapValue(outputs[0])
+	}
+
+	m := &values.Map{Underlying: make(map[string]values.Value, len(outputs))}
+	for i, output := range outputs {
+		name := method.Outputs[i].Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		v, err := abiutil.WrapValue(output)
+		if err != nil {
+			return nil, fmt.Errorf("output %s: %w", name, err)
+		}
+		m.Underlying[name] = v
+	}
+	return m, nil
+}
+
+// Execute makes the calls of the `calls` input in a single batch, and responds with the list of their decoded outputs.
+func (cap *EvmRead) Execute(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest) error {
+	cap.lggr.Debugw("Execute", "request", request)
+
+	reqConfig, err := parseReadConfig(request.Config)
+	if err != nil {
+		return err
+	}
+
+	inputsAny, err := request.Inputs.Unwrap()
+	if err != nil {
+		return err
+	}
+	inputs := inputsAny.(map[string]any)
+	rawCalls, ok := inputs["calls"]
+	if !ok {
+		return errors.New("malformed data: inputs doesn't contain a calls key")
+	}
+
+	var calls []EvmReadCall
+	if err = mapstructure.Decode(rawCalls, &calls); err != nil {
+		return fmt.Errorf("malformed calls: %w", err)
+	}
+	if len(calls) == 0 {
+		return errors.New("no calls to make")
+	}
+
+	blockTag := reqConfig.blockTag()
+	packed := make([]packedCall, len(calls))
+	results := make([]hexutil.Bytes, len(calls))
+	batch := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		packed[i], err = packCall(call)
+		if err != nil {
+			return fmt.Errorf("call %d: %w", i, err)
+		}
+
+		batch[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []any{
+				map[string]any{
+					"to":   packed[i].address,
+					"data": hexutil.Bytes(packed[i].data),
+				},
+				blockTag,
+			},
+			Result: &results[i],
+		}
+	}
+
+	if err = cap.chain.Client().BatchCallContext(ctx, batch); err != nil {
+		return fmt.Errorf("batch call: %w", err)
+	}
+
+	outputs := &values.List{Underlying: make([]values.Value, len(calls))}
+	for i := range batch {
+		if batch[i].Error != nil {
+			return fmt.Errorf("call %d to %s of %s failed: %w", i, packed[i].method.Name, packed[i].address, batch[i].Error)
+		}
+		outputs.Underlying[i], err = unpackOutputs(packed[i].method, results[i])
+		if err != nil {
+			return fmt.Errorf("call %d to %s of %s: failed to unpack outputs: %w", i, packed[i].method.Name, packed[i].address, err)
+		}
+	}
+
+	cap.lggr.Debugw("Calls made", "request", request, "blockTag", blockTag)
+	go func() {
+		callback <- capabilities.CapabilityResponse{
+			Value: outputs,
+			Err:   nil,
+		}
+		close(callback)
+	}()
+	return nil
+}
+
+func (cap *EvmRead) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (cap *EvmRead) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
@@ -0,0 +1,148 @@
+package targets_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/targets"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const readTestABI = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"latestRoundData","stateMutability":"view","inputs":[],"outputs":[{"name":"roundId","type":"uint80"},{"name":"answer","type":"int256"},{"name":"updatedAt","type":"uint256"}]}
+]`
+
+func newReadRequest(t *testing.T, config map[string]any, calls []any) capabilities.CapabilityRequest {
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	inputs, err := values.NewMap(map[string]any{"calls": calls})
+	require.NoError(t, err)
+	return capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "hello"},
+		Config:   cfg,
+		Inputs:   inputs,
+	}
+}
+
+func TestEvmRead(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(readTestABI))
+	require.NoError(t, err)
+
+	contract := testutils.NewAddress()
+	owner := testutils.NewAddress()
+
+	balance, err := contractABI.Methods["balanceOf"].Outputs.Pack(big.NewInt(42))
+	require.NoError(t, err)
+	round, err := contractABI.Methods["latestRoundData"].Outputs.Pack(big.NewInt(7), big.NewInt(-3), big.NewInt(1700000000))
+	require.NoError(t, err)
+
+	chain := evmmocks.NewChain(t)
+	client := evmclimocks.NewClient(t)
+	chain.On("ID").Return(big.NewInt(11155111))
+	chain.On("Client").Return(client)
+	client.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		batch := args.Get(1).([]rpc.BatchElem)
+		require.Len(t, batch, 2)
+		for _, elem := range batch {
+			assert.Equal(t, "eth_call", elem.Method)
+			assert.Equal(t, "0x64", elem.Args[1])
+			assert.Equal(t, contract, elem.Args[0].(map[string]any)["to"])
+		}
+		data := batch[0].Args[0].(map[string]any)["data"].(hexutil.Bytes)
+		expected, err := contractABI.Pack("balanceOf", owner)
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Bytes(expected), data)
+
+		*batch[0].Result.(*hexutil.Bytes) = balance
+		*batch[1].Result.(*hexutil.Bytes) = round
+	})
+
+	capability := targets.NewEvmRead(chain, logger.TestLogger(t))
+	assert.Equal(t, "read_ethereum-testnet-sepolia", capability.ID)
+
+	req := newReadRequest(t, map[string]any{"blockNumber": 100}, []any{
+		map[string]any{"address": contract.Hex(), "abi": readTestABI, "method": "balanceOf", "args": []any{owner.Hex()}},
+		map[string]any{"address": contract.Hex(), "abi": readTestABI, "method": "latestRoundData", "args": []any{}},
+	})
+
+	ch := make(chan capabilities.CapabilityResponse)
+	require.NoError(t, capability.Execute(testutils.Context(t), ch, req))
+
+	response := <-ch
+	require.NoError(t, response.Err)
+	outputs, err := response.Value.Unwrap()
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		*big.NewInt(42),
+		map[string]any{
+			"roundId":   *big.NewInt(7),
+			"answer":    *big.NewInt(-3),
+			"updatedAt": *big.NewInt(1700000000),
+		},
+	}, outputs)
+}
+
+func TestEvmRead_Errors(t *testing.T) {
+	contract := testutils.NewAddress().Hex()
+	balanceOf := func(args ...any) map[string]any {
+		return map[string]any{"address": contract, "abi": readTestABI, "method": "balanceOf", "args": args}
+	}
+
+	testCases := []struct {
+		name   string
+		config map[string]any
+		calls  []any
+		err    string
+	}{
+		{"unknown confidence", map[string]any{"confidence": "soon"}, []any{balanceOf(contract)}, `unknown confidence "soon"`},
+		{"block and confidence", map[string]any{"confidence": "safe", "blockNumber": 1}, []any{balanceOf(contract)}, "only one of blockNumber and confidence"},
+		{"no calls", map[string]any{}, []any{}, "no calls to make"},
+		{"unknown method", map[string]any{}, []any{map[string]any{"address": contract, "abi": readTestABI, "method": "totalSupply"}}, `method "totalSupply" not found`},
+		{"missing argument", map[string]any{}, []any{balanceOf()}, "expects 1 arguments, got 0"},
+		{"invalid argument", map[string]any{}, []any{balanceOf("0x1234")}, "argument 0 of balanceOf"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := evmmocks.NewChain(t)
+			chain.On("ID").Return(big.NewInt(11155111))
+			capability := targets.NewEvmRead(chain, logger.TestLogger(t))
+
+			err := capability.Execute(testutils.Context(t), make(chan capabilities.CapabilityResponse), newReadRequest(t, tc.config, tc.calls))
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	t.Run("reverted call", func(t *testing.T) {
+		chain := evmmocks.NewChain(t)
+		client := evmclimocks.NewClient(t)
+		chain.On("ID").Return(big.NewInt(11155111))
+		chain.On("Client").Return(client)
+		client.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			batch := args.Get(1).([]rpc.BatchElem)
+			assert.Equal(t, "finalized", batch[0].Args[1])
+			batch[0].Error = errors.New("execution reverted")
+		})
+		capability := targets.NewEvmRead(chain, logger.TestLogger(t))
+
+		req := newReadRequest(t, map[string]any{"confidence": "finalized"}, []any{balanceOf(common.Address{}.Hex())})
+		err := capability.Execute(testutils.Context(t), make(chan capabilities.CapabilityResponse), req)
+		require.ErrorContains(t, err, "call 0 to balanceOf")
+		require.ErrorContains(t, err, "execution reverted")
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/mapstructure"
@@ -18,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	abiutil "github.com/smartcontractkit/chainlink/v2/core/chains/evm/abi"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -77,6 +79,8 @@ type EvmConfig struct {
 	Address string
 	Params  []any
 	ABI     string
+	// DryRun simulates the forwarder call instead of submitting a transaction.
+	DryRun bool
 }
 
 // TODO: enforce required key presence
@@ -217,6 +221,16 @@ func (cap *EvmWrite) Execute(ctx context.Context, callback chan<- capabilities.C
 		return err
 	}
 
+	if reqConfig.DryRun {
+		forwarderAddress := config.ForwarderAddress().Address()
+		return cap.simulate(ctx, callback, request, ethereum.CallMsg{
+			From: config.FromAddress().Address(),
+			To:   &forwarderAddress,
+			Gas:  uint64(defaultGasLimit),
+			Data: calldata,
+		})
+	}
+
 	txMeta := &txmgr.TxMeta{
 		// FwdrDestAddress could also be set for better logging but it's used for various purposes around Operator Forwarders
 		WorkflowExecutionID: &request.Metadata.WorkflowExecutionID,
@@ -252,6 +266,44 @@ func (cap *EvmWrite) Execute(ctx context.Context, callback chan<- capabilities.C
 	return nil
 }
 
+// simulate runs the forwarder call with eth_call instead of submitting it, and responds with the
+// gas estimate, revert reason and decoded result of the simulation.
+func (cap *EvmWrite) simulate(ctx context.Context, callback chan<- capabilities.CapabilityResponse, request capabilities.CapabilityRequest, msg ethereum.CallMsg) error {
+	sim, err := evmclient.SimulateCall(ctx, cap.chain.Client(), msg)
+	if err != nil {
+		return fmt.Errorf("failed to simulate report: %w", err)
+	}
+
+	output := map[string]any{
+		"dryRun":       true,
+		"reverted":     sim.Reverted,
+		"revertReason": sim.RevertReason,
+		"gasEstimate":  sim.GasEstimate,
+		"result":       nil,
+	}
+	if !sim.Reverted {
+		results, err := forwardABI.Methods["report"].Outputs.Unpack(sim.Result)
+		if err != nil {
+			return fmt.Errorf("failed to unpack simulated report result: %w", err)
+		}
+		output["result"] = results[0]
+	}
+	value, err := values.NewMap(output)
+	if err != nil {
+		return err
+	}
+
+	cap.lggr.Debugw("Transaction simulated", "request", request, "simulation", sim)
+	go func() {
+		callback <- capabilities.CapabilityResponse{
+			Value: value,
+			Err:   nil,
+		}
+		close(callback)
+	}()
+	return nil
+}
+
 func (cap *EvmWrite) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
 	return nil
 }
@@ -4,9 +4,14 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/targets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -142,3 +147,108 @@ func TestEvmWrite_EmptyReport(t *testing.T) {
 	response := <-ch
 	require.Nil(t, response.Err)
 }
+
+func TestEvmWrite_DryRun(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		a := testutils.NewAddress()
+		addr, err := types.NewEIP55Address(a.Hex())
+		require.NoError(t, err)
+		c.EVM[0].ChainWriter.FromAddress = &addr
+
+		forwarderA := testutils.NewAddress()
+		forwarderAddr, err := types.NewEIP55Address(forwarderA.Hex())
+		require.NoError(t, err)
+		c.EVM[0].ChainWriter.ForwarderAddress = &forwarderAddr
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	newRequest := func(t *testing.T) capabilities.CapabilityRequest {
+		config, err := values.NewMap(map[string]any{
+			"abi":    "receive(report bytes)",
+			"params": []any{"$(report)"},
+			"dryRun": true,
+		})
+		require.NoError(t, err)
+
+		inputs, err := values.NewMap(map[string]any{
+			"report": []byte{1, 2, 3},
+		})
+		require.NoError(t, err)
+
+		return capabilities.CapabilityRequest{
+			Metadata: capabilities.RequestMetadata{
+				WorkflowID: "hello",
+			},
+			Config: config,
+			Inputs: inputs,
+		}
+	}
+
+	t.Run("returns the simulated gas and result without submitting a transaction", func(t *testing.T) {
+		chain := evmmocks.NewChain(t)
+		client := evmclimocks.NewClient(t)
+		chain.On("ID").Return(big.NewInt(11155111))
+		chain.On("Config").Return(evmcfg)
+		chain.On("Client").Return(client)
+		// no transaction is expected to be created
+		chain.On("TxManager").Return(txmmocks.NewMockEvmTxManager(t))
+
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest").Return(nil).Run(func(args mock.Arguments) {
+			callArg := args.Get(3).(map[string]any)
+			require.Equal(t, evmcfg.EVM().ChainWriter().ForwarderAddress().Address(), *callArg["to"].(*common.Address))
+			require.Equal(t, forwardABI.Methods["report"].ID, []byte(callArg["input"].(hexutil.Bytes))[:4])
+
+			result, err := forwardABI.Methods["report"].Outputs.Pack(true)
+			require.NoError(t, err)
+			*args.Get(1).(*hexutil.Bytes) = result
+		}).Once()
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_estimateGas", mock.Anything, "latest").Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Uint64) = 54321
+		}).Once()
+
+		capability := targets.NewEvmWrite(chain, logger.TestLogger(t))
+		ch := make(chan capabilities.CapabilityResponse)
+		require.NoError(t, capability.Execute(testutils.Context(t), ch, newRequest(t)))
+
+		response := <-ch
+		require.Nil(t, response.Err)
+		output, err := response.Value.Unwrap()
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"dryRun":       true,
+			"reverted":     false,
+			"revertReason": "",
+			"gasEstimate":  int64(54321),
+			"result":       true,
+		}, output)
+	})
+
+	t.Run("returns the revert reason", func(t *testing.T) {
+		chain := evmmocks.NewChain(t)
+		client := evmclimocks.NewClient(t)
+		chain.On("ID").Return(big.NewInt(11155111))
+		chain.On("Config").Return(evmcfg)
+		chain.On("Client").Return(client)
+		// no transaction is expected to be created
+		chain.On("TxManager").Return(txmmocks.NewMockEvmTxManager(t))
+
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest").
+			Return(&evmclient.JsonError{Code: 3, Message: "execution reverted: invalid report"}).Once()
+
+		capability := targets.NewEvmWrite(chain, logger.TestLogger(t))
+		ch := make(chan capabilities.CapabilityResponse)
+		require.NoError(t, capability.Execute(testutils.Context(t), ch, newRequest(t)))
+
+		response := <-ch
+		require.Nil(t, response.Err)
+		output, err := response.Value.Unwrap()
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"dryRun":       true,
+			"reverted":     true,
+			"revertReason": "execution reverted: invalid report",
+			"gasEstimate":  int64(0),
+			"result":       nil,
+		}, output)
+	})
+}
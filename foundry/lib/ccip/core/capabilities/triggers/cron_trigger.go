@@ -0,0 +1,168 @@
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/robfig/cron/v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const cronTriggerType = "cron"
+
+var cronInfo = capabilities.MustNewCapabilityInfo(
+	"cron-trigger",
+	capabilities.CapabilityTypeTrigger,
+	"Issues a trigger on a cron schedule.",
+	"v1.0.0",
+)
+
+func InitializeCron(registry commontypes.CapabilitiesRegistry, lggr logger.Logger) error {
+	return registry.Add(context.TODO(), NewCronTrigger(lggr))
+}
+
+var (
+	_ capabilities.TriggerCapability = &CronTrigger{}
+)
+
+// CronTrigger emits a trigger event for every tick of the schedule of each registered workflow.
+type CronTrigger struct {
+	capabilities.CapabilityInfo
+	lggr logger.Logger
+
+	mu            sync.Mutex
+	registrations map[string]*cronRegistration
+}
+
+type cronRegistration struct {
+	cron     *cron.Cron
+	callback chan<- capabilities.CapabilityResponse
+	stopCh   chan struct{}
+}
+
+func NewCronTrigger(lggr logger.Logger) *CronTrigger {
+	return &CronTrigger{
+		CapabilityInfo: cronInfo,
+		lggr:           lggr.Named("CronTrigger"),
+		registrations:  make(map[string]*cronRegistration),
+	}
+}
+
+type CronConfig struct {
+	// Schedule is a cron schedule with a CRON_TZ time zone, e.g. "CRON_TZ=UTC 0 */5 * * * *", or an interval, e.g. "@every 1m".
+	Schedule string
+}
+
+func parseCronConfig(rawConfig *values.Map) (CronConfig, error) {
+	var config CronConfig
+	configAny, err := rawConfig.Unwrap()
+	if err != nil {
+		return config, err
+	}
+	if err = mapstructure.Decode(configAny, &config); err != nil {
+		return config, err
+	}
+	return config, utils.ValidateCronSchedule(config.Schedule)
+}
+
+func (t *CronTrigger) RegisterTrigger(ctx context.Context, callback chan<- capabilities.CapabilityResponse, req capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(req)
+	if err != nil {
+		return err
+	}
+
+	config, err := parseCronConfig(req.Config)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.registrations[triggerID]; ok {
+		return fmt.Errorf("triggerId %s already registered", triggerID)
+	}
+
+	reg := &cronRegistration{
+		cron:     cron.New(cron.WithParser(cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor))),
+		callback: callback,
+		stopCh:   make(chan struct{}),
+	}
+	_, err = reg.cron.AddFunc(config.Schedule, func() {
+		t.emit(triggerID, reg, time.Now())
+	})
+	if err != nil {
+		return fmt.Errorf("invalid cron schedule %q: %w", config.Schedule, err)
+	}
+
+	t.registrations[triggerID] = reg
+	reg.cron.Start()
+	t.lggr.Debugw("RegisterTrigger", "triggerID", triggerID, "schedule", config.Schedule)
+	return nil
+}
+
+func (t *CronTrigger) emit(triggerID string, reg *cronRegistration, tick time.Time) {
+	resp, err := newCronTriggerResponse(triggerID, tick)
+	if err != nil {
+		t.lggr.Errorw("failed to create cron trigger event", "triggerID", triggerID, "err", err)
+		return
+	}
+
+	select {
+	case <-reg.stopCh:
+	case reg.callback <- resp:
+	}
+}
+
+func newCronTriggerResponse(triggerID string, tick time.Time) (capabilities.CapabilityResponse, error) {
+	payload, err := values.NewMap(map[string]any{
+		"scheduledExecutionTime": tick.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
+	event, err := values.Wrap(capabilities.TriggerEvent{
+		TriggerType: cronTriggerType,
+		ID:          fmt.Sprintf("%s|%d", triggerID, tick.UnixMilli()),
+		Timestamp:   strconv.FormatInt(tick.UnixMilli(), 10),
+		Payload:     payload,
+	})
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+	return capabilities.CapabilityResponse{Value: event}, nil
+}
+
+func (t *CronTrigger) UnregisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(req)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	reg, ok := t.registrations[triggerID]
+	delete(t.registrations, triggerID)
+	t.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", triggerID)
+	}
+
+	// Wait for any running tick to return before closing the callback.
+	close(reg.stopCh)
+	<-reg.cron.Stop().Done()
+	close(reg.callback)
+	t.lggr.Debugw("UnregisterTrigger", "triggerID", triggerID)
+	return nil
+}
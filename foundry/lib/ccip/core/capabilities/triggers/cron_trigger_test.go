@@ -0,0 +1,85 @@
+package triggers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newTriggerRequest(t *testing.T, workflowID string, config map[string]any) capabilities.CapabilityRequest {
+	inputs, err := values.NewMap(map[string]any{"triggerId": "trigger-1"})
+	require.NoError(t, err)
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	return capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: workflowID},
+		Config:   cfg,
+		Inputs:   inputs,
+	}
+}
+
+func TestCronTrigger(t *testing.T) {
+	ctx := testutils.Context(t)
+	trigger := triggers.NewCronTrigger(logger.TestLogger(t))
+
+	req := newTriggerRequest(t, "workflow-1", map[string]any{"schedule": "@every 1s"})
+	callback := make(chan capabilities.CapabilityResponse)
+	require.NoError(t, trigger.RegisterTrigger(ctx, callback, req))
+
+	err := trigger.RegisterTrigger(ctx, make(chan capabilities.CapabilityResponse), req)
+	require.ErrorContains(t, err, "already registered")
+
+	var resp capabilities.CapabilityResponse
+	select {
+	case resp = <-callback:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for a cron trigger event")
+	}
+	require.NoError(t, resp.Err)
+
+	event := &capabilities.TriggerEvent{}
+	require.NoError(t, resp.Value.UnwrapTo(event))
+	assert.Equal(t, "cron", event.TriggerType)
+	assert.Contains(t, event.ID, "workflow-1|trigger-1|")
+
+	payload, err := event.Payload.Unwrap()
+	require.NoError(t, err)
+	scheduled, err := time.Parse(time.RFC3339Nano, payload.(map[string]any)["scheduledExecutionTime"].(string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), scheduled, testutils.WaitTimeout(t))
+
+	require.NoError(t, trigger.UnregisterTrigger(ctx, req))
+	// The callback is closed once unregistered, there might be one last event in flight.
+	for range callback {
+	}
+	require.ErrorContains(t, trigger.UnregisterTrigger(ctx, req), "not registered")
+}
+
+func TestCronTrigger_InvalidConfig(t *testing.T) {
+	ctx := testutils.Context(t)
+	trigger := triggers.NewCronTrigger(logger.TestLogger(t))
+
+	for name, schedule := range map[string]any{
+		"missing time zone": "0 * * * *",
+		"invalid schedule":  "CRON_TZ=UTC not a schedule",
+		"wrong type":        int64(5),
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := newTriggerRequest(t, "workflow-1", map[string]any{"schedule": schedule})
+			require.Error(t, trigger.RegisterTrigger(ctx, make(chan capabilities.CapabilityResponse), req))
+		})
+	}
+
+	req := newTriggerRequest(t, "workflow-1", map[string]any{"schedule": "@every 1s"})
+	req.Inputs = nil
+	require.ErrorContains(t, trigger.RegisterTrigger(ctx, make(chan capabilities.CapabilityResponse), req), "triggerId not found")
+}
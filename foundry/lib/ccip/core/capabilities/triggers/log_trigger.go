@@ -0,0 +1,379 @@
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mitchellh/mapstructure"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	abiutil "github.com/smartcontractkit/chainlink/v2/core/chains/evm/abi"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const logTriggerType = "log"
+
+// InitializeLogTriggers adds a log trigger for each chain running a log poller.
+func InitializeLogTriggers(registry commontypes.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer, lggr logger.Logger) error {
+	for _, chain := range legacyEVMChains.Slice() {
+		if !chain.Config().Feature().LogPoller() {
+			continue
+		}
+		capability := NewLogTrigger(chain.ID(), chain.LogPoller(), chain.Config().EVM().LogPollInterval(), lggr)
+		if err := registry.Add(context.TODO(), capability); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ capabilities.TriggerCapability = &LogTrigger{}
+)
+
+// LogTrigger emits a trigger event for every log matching the filter of each registered workflow.
+// Logs are read from the log poller, which tracks them once the filter is registered.
+type LogTrigger struct {
+	capabilities.CapabilityInfo
+	chainID    *big.Int
+	lp         logpoller.LogPoller
+	pollPeriod time.Duration
+	lggr       logger.Logger
+
+	mu            sync.Mutex
+	registrations map[string]*logRegistration
+}
+
+type logRegistration struct {
+	filter    logpoller.Filter
+	event     abi.Event
+	config    LogConfig
+	callback  chan<- capabilities.CapabilityResponse
+	lastBlock int64
+	stopCh    services.StopChan
+	wg        sync.WaitGroup
+}
+
+func NewLogTrigger(chainID *big.Int, lp logpoller.LogPoller, pollPeriod time.Duration, lggr logger.Logger) *LogTrigger {
+	// generate ID based on chain selector
+	name := fmt.Sprintf("log_trigger_%v", chainID)
+	chainName, err := chainselectors.NameFromChainId(chainID.Uint64())
+	if err == nil {
+		name = fmt.Sprintf("log_trigger_%v", chainName)
+	}
+
+	info := capabilities.MustNewCapabilityInfo(
+		name,
+		capabilities.CapabilityTypeTrigger,
+		"Issues a trigger on EVM logs.",
+		"v1.0.0",
+	)
+
+	return &LogTrigger{
+		CapabilityInfo: info,
+		chainID:        chainID,
+		lp:             lp,
+		pollPeriod:     pollPeriod,
+		lggr:           lggr.Named("LogTrigger"),
+		registrations:  make(map[string]*logRegistration),
+	}
+}
+
+type LogConfig struct {
+	// Address of the contract emitting the logs.
+	Address string
+	// ABI is the JSON ABI of the contract, it must contain Event.
+	ABI string
+	// Event is the name of the event to trigger on, e.g. "Deposit".
+	Event string
+	// Topic2, Topic3 and Topic4 optionally restrict the values of the indexed event arguments.
+	Topic2 []string
+	Topic3 []string
+	Topic4 []string
+	// Confirmations is the number of blocks a log must be buried under before triggering.
+	Confirmations uint32
+}
+
+func parseLogConfig(rawConfig *values.Map) (LogConfig, abi.Event, error) {
+	var config LogConfig
+	configAny, err := rawConfig.Unwrap()
+	if err != nil {
+		return config, abi.Event{}, err
+	}
+	if err = mapstructure.Decode(configAny, &config); err != nil {
+		return config, abi.Event{}, err
+	}
+
+	if !common.IsHexAddress(config.Address) {
+		return config, abi.Event{}, fmt.Errorf("invalid address %q", config.Address)
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(config.ABI))
+	if err != nil {
+		return config, abi.Event{}, fmt.Errorf("invalid abi: %w", err)
+	}
+	event, ok := contractABI.Events[config.Event]
+	if !ok {
+		return config, abi.Event{}, fmt.Errorf("event %q not found in abi", config.Event)
+	}
+	return config, event, nil
+}
+
+func parseTopics(topics []string) ([]common.Hash, error) {
+	hashes := make([]common.Hash, 0, len(topics))
+	for _, topic := range topics {
+		b, err := hexutil.Decode(topic)
+		if err != nil {
+			return nil, fmt.Errorf("invalid topic %q: %w", topic, err)
+		}
+		if len(b) > common.HashLength {
+			return nil, fmt.Errorf("invalid topic %q: longer than %d bytes", topic, common.HashLength)
+		}
+		hashes = append(hashes, common.BytesToHash(b))
+	}
+	return hashes, nil
+}
+
+func (t *LogTrigger) RegisterTrigger(ctx context.Context, callback chan<- capabilities.CapabilityResponse, req capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(req)
+	if err != nil {
+		return err
+	}
+
+	config, event, err := parseLogConfig(req.Config)
+	if err != nil {
+		return err
+	}
+
+	filter := logpoller.Filter{
+		Name:      logpoller.FilterName("WorkflowLogTrigger", triggerID),
+		Addresses: []common.Address{common.HexToAddress(config.Address)},
+		EventSigs: []common.Hash{event.ID},
+	}
+	if filter.Topic2, err = parseTopics(config.Topic2); err != nil {
+		return err
+	}
+	if filter.Topic3, err = parseTopics(config.Topic3); err != nil {
+		return err
+	}
+	if filter.Topic4, err = parseTopics(config.Topic4); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.registrations[triggerID]; ok {
+		return fmt.Errorf("triggerId %s already registered", triggerID)
+	}
+
+	// Only the logs emitted from now on trigger the workflow.
+	latest, err := t.lp.LatestBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	if err = t.lp.RegisterFilter(ctx, filter); err != nil {
+		return fmt.Errorf("failed to register log filter: %w", err)
+	}
+
+	reg := &logRegistration{
+		filter:    filter,
+		event:     event,
+		config:    config,
+		callback:  callback,
+		lastBlock: latest.BlockNumber - int64(config.Confirmations),
+		stopCh:    make(services.StopChan),
+	}
+	t.registrations[triggerID] = reg
+
+	reg.wg.Add(1)
+	go t.pollLoop(triggerID, reg)
+	t.lggr.Debugw("RegisterTrigger", "triggerID", triggerID, "address", config.Address, "event", config.Event)
+	return nil
+}
+
+func (t *LogTrigger) pollLoop(triggerID string, reg *logRegistration) {
+	defer reg.wg.Done()
+
+	ctx, cancel := reg.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(t.pollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.poll(ctx, triggerID, reg); err != nil {
+				t.lggr.Errorw("failed to poll logs", "triggerID", triggerID, "err", err)
+			}
+		}
+	}
+}
+
+// poll emits the matching logs between the last processed block and the latest confirmed block.
+func (t *LogTrigger) poll(ctx context.Context, triggerID string, reg *logRegistration) error {
+	latest, err := t.lp.LatestBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	end := latest.BlockNumber - int64(reg.config.Confirmations)
+	if end <= reg.lastBlock {
+		return nil
+	}
+
+	address := reg.filter.Addresses[0]
+	logs, err := t.lp.Logs(ctx, reg.lastBlock+1, end, reg.event.ID, address)
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(logs, func(a, b logpoller.Log) int {
+		if a.BlockNumber != b.BlockNumber {
+			return int(a.BlockNumber - b.BlockNumber)
+		}
+		return int(a.LogIndex - b.LogIndex)
+	})
+
+	for _, log := range logs {
+		if !matchesTopics(reg.filter, log) {
+			continue
+		}
+
+		resp, err := t.newLogTriggerResponse(reg.event, log)
+		if err != nil {
+			// The log cannot be decoded with the configured event, we skip it rather than block the trigger.
+			t.lggr.Errorw("failed to decode log", "triggerID", triggerID, "txHash", log.TxHash, "logIndex", log.LogIndex, "err", err)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case reg.callback <- resp:
+		}
+	}
+
+	reg.lastBlock = end
+	return nil
+}
+
+func matchesTopics(filter logpoller.Filter, log logpoller.Log) bool {
+	topics := log.GetTopics()
+	for i, allowed := range [][]common.Hash{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(allowed) == 0 {
+			continue
+		}
+		if len(topics) <= i+1 || !slices.Contains(allowed, topics[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *LogTrigger) newLogTriggerResponse(event abi.Event, log logpoller.Log) (capabilities.CapabilityResponse, error) {
+	decoded := map[string]any{}
+	if err := event.Inputs.UnpackIntoMap(decoded, log.Data); err != nil {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("failed to unpack data: %w", err)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	topics := log.GetTopics()
+	if len(topics) != len(indexed)+1 {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("expected %d topics, got %d", len(indexed)+1, len(topics))
+	}
+	if err := abi.ParseTopicsIntoMap(decoded, indexed, topics[1:]); err != nil {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("failed to parse topics: %w", err)
+	}
+
+	data := &values.Map{Underlying: make(map[string]values.Value, len(decoded))}
+	for name, v := range decoded {
+		dv, err := abiutil.WrapValue(v)
+		if err != nil {
+			return capabilities.CapabilityResponse{}, fmt.Errorf("failed to wrap %s: %w", name, err)
+		}
+		data.Underlying[name] = dv
+	}
+
+	payload, err := values.NewMap(map[string]any{
+		"chainID":     t.chainID.String(),
+		"address":     log.Address.Hex(),
+		"event":       event.Name,
+		"blockNumber": log.BlockNumber,
+		"blockHash":   log.BlockHash.Hex(),
+		"txHash":      log.TxHash.Hex(),
+		"logIndex":    log.LogIndex,
+		"data":        data,
+	})
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
+	timestamp := log.BlockTimestamp.UnixMilli()
+	if log.BlockTimestamp.IsZero() {
+		timestamp = time.Now().UnixMilli()
+	}
+	eventValue, err := values.Wrap(capabilities.TriggerEvent{
+		TriggerType: logTriggerType,
+		ID:          fmt.Sprintf("%s|%s|%d", t.chainID, log.TxHash.Hex(), log.LogIndex),
+		Timestamp:   strconv.FormatInt(timestamp, 10),
+		Payload:     payload,
+	})
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+	return capabilities.CapabilityResponse{Value: eventValue}, nil
+}
+
+func (t *LogTrigger) UnregisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) error {
+	triggerID, err := getTriggerID(req)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	reg, ok := t.registrations[triggerID]
+	delete(t.registrations, triggerID)
+	t.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", triggerID)
+	}
+
+	// Wait for the poll loop to return before closing the callback.
+	close(reg.stopCh)
+	reg.wg.Wait()
+	close(reg.callback)
+
+	if err := t.lp.UnregisterFilter(ctx, reg.filter.Name); err != nil {
+		return fmt.Errorf("failed to unregister log filter: %w", err)
+	}
+	t.lggr.Debugw("UnregisterTrigger", "triggerID", triggerID)
+	return nil
+}
@@ -0,0 +1,131 @@
+package triggers_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const depositABI = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Deposit","type":"event"}]`
+
+func newDepositLog(t *testing.T, event abi.Event, address common.Address, owner common.Address, amount int64, block int64, logIndex int64) logpoller.Log {
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(amount))
+	require.NoError(t, err)
+	return logpoller.Log{
+		LogIndex:    logIndex,
+		BlockHash:   common.BigToHash(big.NewInt(block)),
+		BlockNumber: block,
+		Topics:      pq.ByteaArray{event.ID.Bytes(), common.BytesToHash(owner.Bytes()).Bytes()},
+		EventSig:    event.ID,
+		Address:     address,
+		TxHash:      common.BigToHash(big.NewInt(block*1000 + logIndex)),
+		Data:        data,
+	}
+}
+
+func TestLogTrigger(t *testing.T) {
+	ctx := testutils.Context(t)
+	lp := lpmocks.NewLogPoller(t)
+
+	parsed, err := abi.JSON(strings.NewReader(depositABI))
+	require.NoError(t, err)
+	event := parsed.Events["Deposit"]
+
+	address := testutils.NewAddress()
+	owner := testutils.NewAddress()
+	otherOwner := testutils.NewAddress()
+
+	trigger := triggers.NewLogTrigger(big.NewInt(1234567), lp, 10*time.Millisecond, logger.TestLogger(t))
+	assert.Equal(t, "log_trigger_1234567", trigger.ID)
+
+	req := newTriggerRequest(t, "workflow-1", map[string]any{
+		"address":       address.Hex(),
+		"abi":           depositABI,
+		"event":         "Deposit",
+		"topic2":        []any{common.BytesToHash(owner.Bytes()).Hex()},
+		"confirmations": int64(1),
+	})
+
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 10}, nil).Once()
+	lp.On("RegisterFilter", mock.Anything, mock.MatchedBy(func(f logpoller.Filter) bool {
+		return f.Name == logpoller.FilterName("WorkflowLogTrigger", "workflow-1|trigger-1") &&
+			len(f.Addresses) == 1 && f.Addresses[0] == address &&
+			len(f.EventSigs) == 1 && f.EventSigs[0] == event.ID &&
+			len(f.Topic2) == 1 && f.Topic2[0] == common.BytesToHash(owner.Bytes())
+	})).Return(nil).Once()
+	// Blocks 10 to 12 are available, 12 isn't confirmed yet.
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 12}, nil)
+	lp.On("Logs", mock.Anything, int64(10), int64(11), event.ID, address).Return([]logpoller.Log{
+		newDepositLog(t, event, address, owner, 200, 11, 0),
+		newDepositLog(t, event, address, otherOwner, 300, 10, 1),
+		newDepositLog(t, event, address, owner, 100, 10, 0),
+	}, nil).Once()
+
+	callback := make(chan capabilities.CapabilityResponse)
+	require.NoError(t, trigger.RegisterTrigger(ctx, callback, req))
+
+	var payloads []map[string]any
+	for len(payloads) < 2 {
+		select {
+		case resp := <-callback:
+			require.NoError(t, resp.Err)
+			te := &capabilities.TriggerEvent{}
+			require.NoError(t, resp.Value.UnwrapTo(te))
+			assert.Equal(t, "log", te.TriggerType)
+			payload, err := te.Payload.Unwrap()
+			require.NoError(t, err)
+			payloads = append(payloads, payload.(map[string]any))
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for a log trigger event")
+		}
+	}
+
+	// Logs are emitted in order, the log of another owner is filtered out.
+	assert.Equal(t, int64(10), payloads[0]["blockNumber"])
+	assert.Equal(t, int64(11), payloads[1]["blockNumber"])
+	assert.Equal(t, "Deposit", payloads[0]["event"])
+	assert.Equal(t, "1234567", payloads[0]["chainID"])
+	assert.Equal(t, address.Hex(), payloads[0]["address"])
+	data := payloads[0]["data"].(map[string]any)
+	assert.Equal(t, owner.Hex(), data["owner"])
+	assert.Equal(t, *big.NewInt(100), data["amount"])
+
+	lp.On("UnregisterFilter", mock.Anything, logpoller.FilterName("WorkflowLogTrigger", "workflow-1|trigger-1")).Return(nil).Once()
+	require.NoError(t, trigger.UnregisterTrigger(ctx, req))
+	_, open := <-callback
+	assert.False(t, open)
+}
+
+func TestLogTrigger_InvalidConfig(t *testing.T) {
+	ctx := testutils.Context(t)
+	trigger := triggers.NewLogTrigger(big.NewInt(1337), lpmocks.NewLogPoller(t), time.Second, logger.TestLogger(t))
+	address := testutils.NewAddress().Hex()
+
+	for name, config := range map[string]map[string]any{
+		"invalid address": {"address": "0x1234", "abi": depositABI, "event": "Deposit"},
+		"invalid abi":     {"address": address, "abi": "not an abi", "event": "Deposit"},
+		"unknown event":   {"address": address, "abi": depositABI, "event": "Withdraw"},
+		"invalid topic":   {"address": address, "abi": depositABI, "event": "Deposit", "topic2": []any{"not hex"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := newTriggerRequest(t, "workflow-1", config)
+			require.Error(t, trigger.RegisterTrigger(ctx, make(chan capabilities.CapabilityResponse), req))
+		})
+	}
+}
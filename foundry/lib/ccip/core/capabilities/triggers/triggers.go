@@ -0,0 +1,23 @@
+package triggers
+
+import (
+	"errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+)
+
+// getTriggerID returns the triggerId of the request inputs, namespaced to the workflow ID.
+func getTriggerID(req capabilities.CapabilityRequest) (string, error) {
+	if req.Inputs == nil {
+		return "", errors.New("triggerId not found in inputs")
+	}
+	inputs, err := req.Inputs.Unwrap()
+	if err != nil {
+		return "", err
+	}
+	id, ok := inputs.(map[string]any)["triggerId"].(string)
+	if !ok {
+		return "", errors.New("triggerId not found in inputs")
+	}
+	return req.Metadata.WorkflowID + "|" + id, nil
+}
@@ -0,0 +1,245 @@
+package abi
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+// WrapValue wraps an ABI decoded value, converting the go-ethereum types values.Wrap doesn't support.
+// Addresses are wrapped as hex strings, fixed size byte arrays as bytes and tuples as maps keyed by field name.
+func WrapValue(v any) (values.Value, error) {
+	switch tv := v.(type) {
+	case *big.Int:
+		return values.NewBigInt(*tv), nil
+	case common.Address:
+		return values.NewString(tv.Hex()), nil
+	case common.Hash:
+		return values.NewBytes(tv.Bytes()), nil
+	case string, bool, []byte:
+		return values.Wrap(tv)
+	}
+
+	val := reflect.ValueOf(v)
+	// nolint
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return values.NewInt64(val.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return values.NewBigInt(*new(big.Int).SetUint64(val.Uint())), nil
+	case reflect.Array:
+		// Fixed size byte arrays, e.g. bytes32.
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, val.Len())
+			reflect.Copy(reflect.ValueOf(b), val)
+			return values.NewBytes(b), nil
+		}
+		return wrapList(val)
+	case reflect.Slice:
+		return wrapList(val)
+	case reflect.Struct:
+		// Tuples are decoded to anonymous structs.
+		m := &values.Map{Underlying: make(map[string]values.Value, val.NumField())}
+		for i := 0; i < val.NumField(); i++ {
+			fv, err := WrapValue(val.Field(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			m.Underlying[val.Type().Field(i).Name] = fv
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("could not wrap into value %+v", v)
+}
+
+func wrapList(val reflect.Value) (values.Value, error) {
+	l := &values.List{Underlying: make([]values.Value, 0, val.Len())}
+	for i := 0; i < val.Len(); i++ {
+		ev, err := WrapValue(val.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		l.Underlying = append(l.Underlying, ev)
+	}
+	return l, nil
+}
+
+// ConvertArg converts an unwrapped value to the go type expected by abi.Arguments.Pack for the given ABI type.
+// Numbers can be given as integers, big integers, integral decimals or decimal/hex strings,
+// addresses and bytes as hex strings, and tuples as maps.
+func ConvertArg(t abi.Type, v any) (any, error) {
+	switch t.T {
+	case abi.AddressTy:
+		switch tv := v.(type) {
+		case common.Address:
+			return tv, nil
+		case string:
+			if !common.IsHexAddress(tv) {
+				return nil, fmt.Errorf("invalid address %q", tv)
+			}
+			return common.HexToAddress(tv), nil
+		case []byte:
+			if len(tv) != common.AddressLength {
+				return nil, fmt.Errorf("invalid address length %d", len(tv))
+			}
+			return common.BytesToAddress(tv), nil
+		}
+	case abi.IntTy, abi.UintTy:
+		n, err := toBigInt(v)
+		if err != nil {
+			return nil, err
+		}
+		return convertInt(t, n)
+	case abi.BoolTy:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case abi.StringTy:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case abi.BytesTy:
+		return toBytes(v)
+	case abi.FixedBytesTy:
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != t.Size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", t.Size, len(b))
+		}
+		arr := reflect.New(t.GetType()).Elem()
+		reflect.Copy(arr, reflect.ValueOf(b))
+		return arr.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		list, ok := v.([]any)
+		if !ok {
+			break
+		}
+		if t.T == abi.ArrayTy && len(list) != t.Size {
+			return nil, fmt.Errorf("expected %d elements, got %d", t.Size, len(list))
+		}
+		res := reflect.New(t.GetType()).Elem()
+		if t.T == abi.SliceTy {
+			res = reflect.MakeSlice(t.GetType(), len(list), len(list))
+		}
+		for i, elem := range list {
+			converted, err := ConvertArg(*t.Elem, elem)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			res.Index(i).Set(reflect.ValueOf(converted))
+		}
+		return res.Interface(), nil
+	case abi.TupleTy:
+		m, ok := v.(map[string]any)
+		if !ok {
+			break
+		}
+		// Tuple fields can be keyed by their ABI name or, like WrapValue does, by their go field name.
+		res := reflect.New(t.GetType()).Elem()
+		for i, elem := range t.TupleElems {
+			fv, exists := m[t.TupleRawNames[i]]
+			if !exists {
+				fv, exists = m[t.TupleType.Field(i).Name]
+			}
+			if !exists {
+				return nil, fmt.Errorf("missing tuple field %s", t.TupleRawNames[i])
+			}
+			converted, err := ConvertArg(*elem, fv)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", t.TupleRawNames[i], err)
+			}
+			res.Field(i).Set(reflect.ValueOf(converted))
+		}
+		return res.Interface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", t)
+	}
+	return nil, fmt.Errorf("cannot convert %T to %s", v, t)
+}
+
+func toBigInt(v any) (*big.Int, error) {
+	switch tv := v.(type) {
+	case int64:
+		return big.NewInt(tv), nil
+	case int:
+		return big.NewInt(int64(tv)), nil
+	case uint64:
+		return new(big.Int).SetUint64(tv), nil
+	case *big.Int:
+		return tv, nil
+	case big.Int:
+		return &tv, nil
+	case decimal.Decimal:
+		if !tv.IsInteger() {
+			return nil, fmt.Errorf("%s is not an integer", tv)
+		}
+		return tv.BigInt(), nil
+	case string:
+		n, ok := new(big.Int).SetString(tv, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", tv)
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("cannot convert %T to an integer", v)
+}
+
+func convertInt(t abi.Type, n *big.Int) (any, error) {
+	if t.T == abi.UintTy && n.Sign() < 0 {
+		return nil, fmt.Errorf("%s is negative", n)
+	}
+
+	typ := t.GetType()
+	if typ == reflect.TypeOf(&big.Int{}) {
+		bitLen := n.BitLen()
+		if t.T == abi.IntTy {
+			// Signed integers need a sign bit, in two's complement a negative n takes the bits of -n-1, e.g. -128 fits an int8.
+			if n.Sign() < 0 {
+				bitLen = new(big.Int).Not(n).BitLen()
+			}
+			bitLen++
+		}
+		if bitLen > t.Size {
+			return nil, fmt.Errorf("%s overflows %s", n, t)
+		}
+		return n, nil
+	}
+
+	rv := reflect.New(typ).Elem()
+	if t.T == abi.UintTy {
+		if !n.IsUint64() || rv.OverflowUint(n.Uint64()) {
+			return nil, fmt.Errorf("%s overflows %s", n, t)
+		}
+		rv.SetUint(n.Uint64())
+	} else {
+		if !n.IsInt64() || rv.OverflowInt(n.Int64()) {
+			return nil, fmt.Errorf("%s overflows %s", n, t)
+		}
+		rv.SetInt(n.Int64())
+	}
+	return rv.Interface(), nil
+}
+
+func toBytes(v any) ([]byte, error) {
+	switch tv := v.(type) {
+	case []byte:
+		return tv, nil
+	case string:
+		if !strings.HasPrefix(tv, "0x") {
+			return nil, errors.New("bytes must be 0x prefixed hex strings")
+		}
+		return hexutil.Decode(tv)
+	}
+	return nil, fmt.Errorf("cannot convert %T to bytes", v)
+}
@@ -0,0 +1,201 @@
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+func mustNewType(t *testing.T, typ string, components ...abi.ArgumentMarshaling) abi.Type {
+	abiType, err := abi.NewType(typ, "", components)
+	require.NoError(t, err)
+	return abiType
+}
+
+func TestWrapValue(t *testing.T) {
+	t.Parallel()
+	addr := common.HexToAddress("0x2a3e2a3E2a3E2a3e2a3e2A3E2A3e2A3e2A3e2a3E")
+	hash := common.HexToHash("0x01")
+
+	tests := []struct {
+		name     string
+		input    any
+		expected values.Value
+	}{
+		{"big int", big.NewInt(-42), values.NewBigInt(*big.NewInt(-42))},
+		{"address", addr, values.NewString(addr.Hex())},
+		{"hash", hash, values.NewBytes(hash.Bytes())},
+		{"string", "foo", values.NewString("foo")},
+		{"bool", true, values.NewBool(true)},
+		{"bytes", []byte{1, 2}, values.NewBytes([]byte{1, 2})},
+		{"int8", int8(-8), values.NewInt64(-8)},
+		{"int64", int64(-64), values.NewInt64(-64)},
+		{"uint8", uint8(8), values.NewBigInt(*big.NewInt(8))},
+		{"uint64", uint64(64), values.NewBigInt(*big.NewInt(64))},
+		{"fixed bytes", [4]byte{1, 2, 3, 4}, values.NewBytes([]byte{1, 2, 3, 4})},
+		{
+			"slice",
+			[]*big.Int{big.NewInt(1), big.NewInt(2)},
+			&values.List{Underlying: []values.Value{values.NewBigInt(*big.NewInt(1)), values.NewBigInt(*big.NewInt(2))}},
+		},
+		{
+			"array",
+			[2]common.Address{addr, addr},
+			&values.List{Underlying: []values.Value{values.NewString(addr.Hex()), values.NewString(addr.Hex())}},
+		},
+		{
+			"tuple",
+			struct {
+				Receiver common.Address
+				Amount   *big.Int
+				Data     [2]byte
+			}{addr, big.NewInt(5), [2]byte{0xab, 0xcd}},
+			&values.Map{Underlying: map[string]values.Value{
+				"Receiver": values.NewString(addr.Hex()),
+				"Amount":   values.NewBigInt(*big.NewInt(5)),
+				"Data":     values.NewBytes([]byte{0xab, 0xcd}),
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			v, err := WrapValue(test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, v)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		_, err := WrapValue(1.5)
+		require.ErrorContains(t, err, "could not wrap into value")
+		_, err = WrapValue([]float64{1.5})
+		require.ErrorContains(t, err, "could not wrap into value")
+	})
+}
+
+func TestConvertArg(t *testing.T) {
+	t.Parallel()
+	addr := common.HexToAddress("0x2a3e2a3E2a3E2a3e2a3e2A3E2A3e2A3e2A3e2a3E")
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	minInt24 := big.NewInt(-1 << 23)
+	tupleType := mustNewType(t, "tuple",
+		abi.ArgumentMarshaling{Name: "receiver", Type: "address"},
+		abi.ArgumentMarshaling{Name: "amount", Type: "uint256"},
+	)
+
+	tests := []struct {
+		name        string
+		typ         abi.Type
+		input       any
+		expected    any
+		expectedErr string
+	}{
+		// Addresses.
+		{name: "address from hex", typ: mustNewType(t, "address"), input: addr.Hex(), expected: addr},
+		{name: "address from address", typ: mustNewType(t, "address"), input: addr, expected: addr},
+		{name: "address from bytes", typ: mustNewType(t, "address"), input: addr.Bytes(), expected: addr},
+		{name: "invalid address", typ: mustNewType(t, "address"), input: "0x1234", expectedErr: "invalid address"},
+		{name: "invalid address length", typ: mustNewType(t, "address"), input: []byte{1}, expectedErr: "invalid address length 1"},
+		{name: "address from bool", typ: mustNewType(t, "address"), input: true, expectedErr: "cannot convert bool to address"},
+
+		// Integers of every width.
+		{name: "uint8", typ: mustNewType(t, "uint8"), input: int64(255), expected: uint8(255)},
+		{name: "uint8 overflow", typ: mustNewType(t, "uint8"), input: int64(256), expectedErr: "256 overflows uint8"},
+		{name: "uint16 from string", typ: mustNewType(t, "uint16"), input: "65535", expected: uint16(65535)},
+		{name: "uint32 from hex string", typ: mustNewType(t, "uint32"), input: "0xff", expected: uint32(255)},
+		{name: "uint64 from uint64", typ: mustNewType(t, "uint64"), input: uint64(1 << 63), expected: uint64(1 << 63)},
+		{name: "uint24 is a big int", typ: mustNewType(t, "uint24"), input: 1<<24 - 1, expected: big.NewInt(1<<24 - 1)},
+		{name: "uint24 overflow", typ: mustNewType(t, "uint24"), input: 1 << 24, expectedErr: "overflows uint24"},
+		{name: "uint256 max", typ: mustNewType(t, "uint256"), input: maxUint256.String(), expected: maxUint256},
+		{name: "uint256 overflow", typ: mustNewType(t, "uint256"), input: new(big.Int).Add(maxUint256, big.NewInt(1)), expectedErr: "overflows uint256"},
+		{name: "negative uint", typ: mustNewType(t, "uint256"), input: -1, expectedErr: "-1 is negative"},
+		{name: "int8 min", typ: mustNewType(t, "int8"), input: -128, expected: int8(-128)},
+		{name: "int8 overflow", typ: mustNewType(t, "int8"), input: 128, expectedErr: "128 overflows int8"},
+		{name: "int24 min", typ: mustNewType(t, "int24"), input: minInt24, expected: minInt24},
+		{name: "int24 max", typ: mustNewType(t, "int24"), input: 1<<23 - 1, expected: big.NewInt(1<<23 - 1)},
+		{name: "int24 overflow", typ: mustNewType(t, "int24"), input: 1 << 23, expectedErr: "overflows int24"},
+		{name: "int24 underflow", typ: mustNewType(t, "int24"), input: -1<<23 - 1, expectedErr: "overflows int24"},
+		{name: "int64 from big int", typ: mustNewType(t, "int64"), input: *big.NewInt(-64), expected: int64(-64)},
+		{name: "int256 from decimal", typ: mustNewType(t, "int256"), input: decimal.NewFromInt(-5), expected: big.NewInt(-5)},
+		{name: "non integral decimal", typ: mustNewType(t, "int256"), input: decimal.RequireFromString("1.5"), expectedErr: "1.5 is not an integer"},
+		{name: "invalid integer string", typ: mustNewType(t, "int256"), input: "one", expectedErr: `invalid integer "one"`},
+		{name: "integer from bool", typ: mustNewType(t, "int256"), input: true, expectedErr: "cannot convert bool to an integer"},
+
+		// Bools and strings.
+		{name: "bool", typ: mustNewType(t, "bool"), input: true, expected: true},
+		{name: "bool from string", typ: mustNewType(t, "bool"), input: "true", expectedErr: "cannot convert string to bool"},
+		{name: "string", typ: mustNewType(t, "string"), input: "foo", expected: "foo"},
+
+		// Bytes and fixed bytes.
+		{name: "bytes from hex", typ: mustNewType(t, "bytes"), input: "0x0102", expected: []byte{1, 2}},
+		{name: "bytes from bytes", typ: mustNewType(t, "bytes"), input: []byte{1, 2}, expected: []byte{1, 2}},
+		{name: "bytes without 0x prefix", typ: mustNewType(t, "bytes"), input: "0102", expectedErr: "bytes must be 0x prefixed hex strings"},
+		{name: "bytes4", typ: mustNewType(t, "bytes4"), input: "0x01020304", expected: [4]byte{1, 2, 3, 4}},
+		{name: "bytes32 from bytes", typ: mustNewType(t, "bytes32"), input: common.HexToHash("0x01").Bytes(), expected: [32]byte(common.HexToHash("0x01"))},
+		{name: "bytes4 wrong length", typ: mustNewType(t, "bytes4"), input: "0x0102", expectedErr: "expected 4 bytes, got 2"},
+		{name: "bytes from int", typ: mustNewType(t, "bytes"), input: 1, expectedErr: "cannot convert int to bytes"},
+
+		// Slices and arrays.
+		{name: "uint256 slice", typ: mustNewType(t, "uint256[]"), input: []any{1, "2"}, expected: []*big.Int{big.NewInt(1), big.NewInt(2)}},
+		{name: "empty slice", typ: mustNewType(t, "address[]"), input: []any{}, expected: []common.Address{}},
+		{name: "address array", typ: mustNewType(t, "address[2]"), input: []any{addr.Hex(), addr}, expected: [2]common.Address{addr, addr}},
+		{name: "nested slice", typ: mustNewType(t, "uint8[][]"), input: []any{[]any{1}, []any{2, 3}}, expected: [][]uint8{{1}, {2, 3}}},
+		{name: "array wrong length", typ: mustNewType(t, "address[2]"), input: []any{addr}, expectedErr: "expected 2 elements, got 1"},
+		{name: "invalid element", typ: mustNewType(t, "uint8[]"), input: []any{1, 256}, expectedErr: "element 1: 256 overflows uint8"},
+		{name: "slice from string", typ: mustNewType(t, "uint8[]"), input: "1,2", expectedErr: "cannot convert string to uint8[]"},
+
+		// Tuples.
+		{
+			name:  "tuple by abi names",
+			typ:   tupleType,
+			input: map[string]any{"receiver": addr.Hex(), "amount": "5"},
+			expected: struct {
+				Receiver common.Address `json:"receiver"`
+				Amount   *big.Int       `json:"amount"`
+			}{addr, big.NewInt(5)},
+		},
+		{
+			name:  "tuple by go field names",
+			typ:   tupleType,
+			input: map[string]any{"Receiver": addr.Hex(), "Amount": 5},
+			expected: struct {
+				Receiver common.Address `json:"receiver"`
+				Amount   *big.Int       `json:"amount"`
+			}{addr, big.NewInt(5)},
+		},
+		{name: "tuple missing field", typ: tupleType, input: map[string]any{"receiver": addr.Hex()}, expectedErr: "missing tuple field amount"},
+		{name: "tuple invalid field", typ: tupleType, input: map[string]any{"receiver": "0x12", "amount": 5}, expectedErr: "field receiver: invalid address"},
+		{name: "tuple from list", typ: tupleType, input: []any{addr.Hex(), 5}, expectedErr: "cannot convert []interface {} to (address,uint256)"},
+
+		// Unsupported types.
+		{name: "function", typ: mustNewType(t, "function"), input: []byte{1}, expectedErr: "unsupported argument type function"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			converted, err := ConvertArg(test.typ, test.input)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, converted)
+			// The converted value must be accepted by the ABI encoder.
+			_, err = abi.Arguments{{Type: test.typ}}.Pack(converted)
+			require.NoError(t, err)
+		})
+	}
+}
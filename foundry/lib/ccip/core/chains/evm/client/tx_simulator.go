@@ -2,8 +2,10 @@ package client
 
 import (
 	"context"
+	"strings"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -28,6 +30,74 @@ func simulateTransactionDefault(ctx context.Context, client simulatorClient, msg
 	return client.CallContext(ctx, &result, "eth_estimateGas", toCallArg(msg), "pending")
 }
 
+// CallSimulation is the outcome of a call simulated with SimulateCall.
+type CallSimulation struct {
+	// Reverted is true if the call reverted, RevertReason is then its decoded reason, if any.
+	Reverted     bool
+	RevertReason string
+	// Result is the data returned by the call.
+	Result []byte
+	// GasEstimate is the gas estimated for the call, only set if it didn't revert.
+	GasEstimate uint64
+}
+
+// SimulateCall simulates msg on the latest block with eth_call, and estimates its gas if it succeeds.
+// A revert is reported in the returned CallSimulation, an error is only returned if the simulation couldn't be run,
+// e.g. when the RPC failed or rate limited the call.
+func SimulateCall(ctx context.Context, client simulatorClient, msg ethereum.CallMsg) (CallSimulation, error) {
+	var sim CallSimulation
+
+	var result hexutil.Bytes
+	err := client.CallContext(ctx, &result, "eth_call", toCallArg(msg), "latest")
+	if err != nil {
+		jErr := ExtractRPCErrorOrNil(err)
+		if jErr == nil || !isRevert(jErr) {
+			return sim, err
+		}
+		sim.Reverted = true
+		sim.RevertReason = RevertReason(jErr)
+		return sim, nil
+	}
+	sim.Result = result
+
+	var gas hexutil.Uint64
+	if err = client.CallContext(ctx, &gas, "eth_estimateGas", toCallArg(msg), "latest"); err != nil {
+		return sim, err
+	}
+	sim.GasEstimate = uint64(gas)
+	return sim, nil
+}
+
+// isRevert returns true if jErr is returned for a reverted call, i.e. it carries revert data or
+// the execution reverted without any reason.
+func isRevert(jErr *JsonError) bool {
+	if data, ok := jErr.Data.(string); ok {
+		if b, err := hexutil.Decode(strings.TrimPrefix(data, "Reverted ")); err == nil && len(b) > 0 {
+			return true
+		}
+	}
+	return strings.Contains(strings.ToLower(jErr.Message), "execution reverted")
+}
+
+// RevertReason returns the reason of a reverted call: the Error(string) encoded in the error data when present,
+// otherwise the error message, followed by the raw data of custom errors.
+func RevertReason(jErr *JsonError) string {
+	data, _ := jErr.Data.(string)
+	// Some RPCs (e.g. parity) prefix the data.
+	data = strings.TrimPrefix(data, "Reverted ")
+	b, err := hexutil.Decode(data)
+	if err != nil || len(b) == 0 {
+		return jErr.Message
+	}
+	if reason, err := abi.UnpackRevert(b); err == nil {
+		return reason
+	}
+	if jErr.Message == "" {
+		return data
+	}
+	return jErr.Message + ": " + data
+}
+
 func toCallArg(msg ethereum.CallMsg) interface{} {
 	arg := map[string]interface{}{
 		"from": msg.From,
@@ -111,3 +111,121 @@ func TestSimulateTx_Default(t *testing.T) {
 		require.Equal(t, false, sendErr.IsOutOfCounters())
 	})
 }
+
+func TestSimulateCall(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := testutils.NewAddress()
+	toAddress := testutils.NewAddress()
+	ctx := testutils.Context(t)
+	msg := ethereum.CallMsg{
+		From: fromAddress,
+		To:   &toAddress,
+		Data: []byte{1, 2, 3},
+	}
+
+	t.Run("returns the result and gas estimate if the call succeeds", func(t *testing.T) {
+		wsURL := testutils.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = headResult
+				return
+			case "eth_unsubscribe":
+				resp.Result = "true"
+				return
+			case "eth_call":
+				resp.Result = `"0x0102"`
+			case "eth_estimateGas":
+				resp.Result = `"0x100"`
+			}
+			return
+		}).WSURL().String()
+
+		ethClient := mustNewChainClient(t, wsURL)
+		require.NoError(t, ethClient.Dial(ctx))
+
+		sim, err := client.SimulateCall(ctx, ethClient, msg)
+		require.NoError(t, err)
+		require.Equal(t, client.CallSimulation{Result: []byte{1, 2}, GasEstimate: 256}, sim)
+	})
+
+	t.Run("returns the revert reason if the call reverts", func(t *testing.T) {
+		wsURL := testutils.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = headResult
+				return
+			case "eth_unsubscribe":
+				resp.Result = "true"
+				return
+			case "eth_call":
+				resp.Error.Code = 3
+				resp.Error.Message = "execution reverted: nope"
+			case "eth_estimateGas":
+				t.Error("gas shouldn't be estimated for reverted calls")
+			}
+			return
+		}).WSURL().String()
+
+		ethClient := mustNewChainClient(t, wsURL)
+		require.NoError(t, ethClient.Dial(ctx))
+
+		sim, err := client.SimulateCall(ctx, ethClient, msg)
+		require.NoError(t, err)
+		require.Equal(t, client.CallSimulation{Reverted: true, RevertReason: "execution reverted: nope"}, sim)
+	})
+
+	t.Run("returns an error if the RPC fails", func(t *testing.T) {
+		wsURL := testutils.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = headResult
+				return
+			case "eth_unsubscribe":
+				resp.Result = "true"
+				return
+			case "eth_call":
+				resp.Error.Code = -32000
+				resp.Error.Message = "header not found"
+			case "eth_estimateGas":
+				t.Error("gas shouldn't be estimated for failed calls")
+			}
+			return
+		}).WSURL().String()
+
+		ethClient := mustNewChainClient(t, wsURL)
+		require.NoError(t, ethClient.Dial(ctx))
+
+		_, err := client.SimulateCall(ctx, ethClient, msg)
+		require.ErrorContains(t, err, "header not found")
+	})
+}
+
+func TestRevertReason(t *testing.T) {
+	t.Parallel()
+
+	// Error("nope")
+	errorData := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6e6f706500000000000000000000000000000000000000000000000000000000"
+
+	testCases := []struct {
+		name   string
+		jErr   client.JsonError
+		reason string
+	}{
+		{"message only", client.JsonError{Code: 3, Message: "execution reverted"}, "execution reverted"},
+		{"Error(string) data", client.JsonError{Code: 3, Message: "execution reverted", Data: errorData}, "nope"},
+		{"prefixed Error(string) data", client.JsonError{Code: -32015, Message: "VM execution error.", Data: "Reverted " + errorData}, "nope"},
+		{"custom error data", client.JsonError{Code: 3, Message: "execution reverted", Data: "0xdeadbeef"}, "execution reverted: 0xdeadbeef"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.reason, client.RevertReason(&tc.jErr))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
@@ -36,6 +37,19 @@ func (g *gasEstimatorConfig) BlockHistory() BlockHistory {
 	return &blockHistoryConfig{c: g.c.BlockHistory, blockDelay: g.blockDelay, bumpThreshold: g.c.BumpThreshold}
 }
 
+func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
+	return &feeHistoryConfig{c: g.c.FeeHistory}
+}
+
+// FeeTier returns the tier of the urgency, a tier without adjustments if the urgency is unknown.
+func (g *gasEstimatorConfig) FeeTier(urgency feetypes.Urgency) FeeTier {
+	tier := &feeTierConfig{bumpThreshold: g.c.BumpThreshold, priceMax: g.c.PriceMax}
+	if c := g.c.FeeTiers.Tier(urgency); c != nil {
+		tier.c = *c
+	}
+	return tier
+}
+
 func (g *gasEstimatorConfig) EIP1559DynamicFees() bool {
 	return *g.c.EIP1559DynamicFees
 }
@@ -172,3 +186,59 @@ func (b *blockHistoryConfig) TransactionPercentile() uint16 {
 func (b *blockHistoryConfig) BlockDelay() uint16 {
 	return *b.blockDelay
 }
+
+type feeHistoryConfig struct {
+	c toml.FeeHistoryEstimator
+}
+
+func (f *feeHistoryConfig) BlockHistorySize() uint16 {
+	return *f.c.BlockHistorySize
+}
+
+func (f *feeHistoryConfig) PredictionBlocks() uint16 {
+	return *f.c.PredictionBlocks
+}
+
+func (f *feeHistoryConfig) Urgency() feetypes.Urgency {
+	return *f.c.Urgency
+}
+
+// RewardPercentile returns the percentile of the rewards paid in the past blocks to use as tip cap for the urgency.
+func (f *feeHistoryConfig) RewardPercentile(urgency feetypes.Urgency) uint16 {
+	switch urgency {
+	case feetypes.UrgencyLow:
+		return *f.c.LowRewardPercentile
+	case feetypes.UrgencyHigh:
+		return *f.c.HighRewardPercentile
+	default:
+		return *f.c.MediumRewardPercentile
+	}
+}
+
+type feeTierConfig struct {
+	c             toml.FeeTier
+	bumpThreshold *uint32
+	priceMax      *assets.Wei
+}
+
+func (t *feeTierConfig) PriceMultiplier() float32 {
+	if t.c.PriceMultiplier == nil {
+		return 1
+	}
+	f, _ := t.c.PriceMultiplier.BigFloat().Float32()
+	return f
+}
+
+func (t *feeTierConfig) BumpThreshold() uint64 {
+	if t.c.BumpThreshold != nil {
+		return uint64(*t.c.BumpThreshold)
+	}
+	return uint64(*t.bumpThreshold)
+}
+
+func (t *feeTierConfig) PriceMax() *assets.Wei {
+	if t.c.PriceMax != nil {
+		return t.c.PriceMax
+	}
+	return t.priceMax
+}
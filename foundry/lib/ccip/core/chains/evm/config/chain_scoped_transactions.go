@@ -1,8 +1,10 @@
 package config
 
 import (
+	"net/url"
 	"time"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -33,3 +35,31 @@ func (t *transactionsConfig) MaxInFlight() uint32 {
 func (t *transactionsConfig) MaxQueued() uint64 {
 	return uint64(*t.c.MaxQueued)
 }
+
+func (t *transactionsConfig) PrivateRelay() txmgrtypes.PrivateRelayConfig {
+	return &privateRelayConfig{c: t.c.PrivateRelay}
+}
+
+type privateRelayConfig struct {
+	c toml.PrivateRelay
+}
+
+func (p *privateRelayConfig) Enabled() bool {
+	return *p.c.Enabled
+}
+
+func (p *privateRelayConfig) URL() *url.URL {
+	return p.c.URL.URL()
+}
+
+func (p *privateRelayConfig) Method() string {
+	return *p.c.Method
+}
+
+func (p *privateRelayConfig) FallbackBlocks() uint32 {
+	return *p.c.FallbackBlocks
+}
+
+func (p *privateRelayConfig) FallbackAttempts() uint32 {
+	return *p.c.FallbackAttempts
+}
@@ -9,6 +9,8 @@ import (
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 
 	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -84,11 +86,14 @@ type Transactions interface {
 	ReaperThreshold() time.Duration
 	MaxInFlight() uint32
 	MaxQueued() uint64
+	PrivateRelay() txmgrtypes.PrivateRelayConfig
 }
 
 //go:generate mockery --quiet --name GasEstimator --output ./mocks/ --case=underscore
 type GasEstimator interface {
 	BlockHistory() BlockHistory
+	FeeHistory() FeeHistory
+	FeeTier(urgency feetypes.Urgency) FeeTier
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -129,6 +134,21 @@ type BlockHistory interface {
 	TransactionPercentile() uint16
 }
 
+type FeeHistory interface {
+	BlockHistorySize() uint16
+	PredictionBlocks() uint16
+	Urgency() feetypes.Urgency
+	RewardPercentile(urgency feetypes.Urgency) uint16
+}
+
+// FeeTier adjusts the fees of the transactions of an urgency. The BumpThreshold and PriceMax of the GasEstimator
+// are used when not overridden.
+type FeeTier interface {
+	PriceMultiplier() float32
+	BumpThreshold() uint64
+	PriceMax() *assets.Wei
+}
+
 type ChainWriter interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
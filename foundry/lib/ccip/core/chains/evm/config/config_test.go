@@ -8,12 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	configurl "github.com/smartcontractkit/chainlink-common/pkg/config"
 
 	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -265,6 +267,56 @@ func TestChainScopedConfig_BlockHistory(t *testing.T) {
 	assert.Equal(t, uint16(4), bh.EIP1559FeeCapBufferBlocks())
 }
 
+func TestChainScopedConfig_PrivateRelay(t *testing.T) {
+	t.Parallel()
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+
+	pr := cfg.EVM().Transactions().PrivateRelay()
+	assert.False(t, pr.Enabled())
+	assert.Nil(t, pr.URL())
+	assert.Equal(t, "eth_sendPrivateTransaction", pr.Method())
+	assert.Equal(t, uint32(25), pr.FallbackBlocks())
+	assert.Equal(t, uint32(3), pr.FallbackAttempts())
+}
+
+func TestChainScopedConfig_FeeHistory(t *testing.T) {
+	t.Parallel()
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+
+	fh := cfg.EVM().GasEstimator().FeeHistory()
+	assert.Equal(t, uint16(20), fh.BlockHistorySize())
+	assert.Equal(t, uint16(3), fh.PredictionBlocks())
+	assert.Equal(t, feetypes.UrgencyMedium, fh.Urgency())
+	assert.Equal(t, uint16(10), fh.RewardPercentile(feetypes.UrgencyLow))
+	assert.Equal(t, uint16(50), fh.RewardPercentile(feetypes.UrgencyMedium))
+	assert.Equal(t, uint16(90), fh.RewardPercentile(feetypes.UrgencyHigh))
+}
+
+func TestChainScopedConfig_FeeTier(t *testing.T) {
+	t.Parallel()
+	gcfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].GasEstimator.FeeTiers.High = toml.FeeTier{
+			PriceMultiplier: ptr(decimal.RequireFromString("1.5")),
+			BumpThreshold:   ptr[uint32](1),
+			PriceMax:        assets.GWei(1000),
+		}
+	})
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	ge := cfg.EVM().GasEstimator()
+
+	low := ge.FeeTier(feetypes.UrgencyLow)
+	assert.Equal(t, float32(1), low.PriceMultiplier())
+	assert.Equal(t, ge.BumpThreshold(), low.BumpThreshold())
+	assert.Equal(t, ge.PriceMax(), low.PriceMax())
+
+	high := ge.FeeTier(feetypes.UrgencyHigh)
+	assert.Equal(t, float32(1.5), high.PriceMultiplier())
+	assert.Equal(t, uint64(1), high.BumpThreshold())
+	assert.Equal(t, assets.GWei(1000), high.PriceMax())
+}
+
 func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	t.Parallel()
 	gcfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
@@ -459,6 +511,96 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			assert.NoError(t, cfg.Validate())
 		})
 	})
+
+	t.Run("fee-history-estimator", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			cfg := configWithChains(t, 0, &toml.Chain{
+				GasEstimator: toml.GasEstimator{
+					Mode: ptr("FeeHistory"),
+					FeeHistory: toml.FeeHistoryEstimator{
+						Urgency:             ptr(feetypes.UrgencyHigh),
+						LowRewardPercentile: ptr[uint16](50),
+					},
+				},
+			})
+			assert.NoError(t, cfg.Validate())
+		})
+		t.Run("invalid", func(t *testing.T) {
+			cfg := configWithChains(t, 0, &toml.Chain{
+				GasEstimator: toml.GasEstimator{
+					Mode: ptr("FeeHistory"),
+					FeeHistory: toml.FeeHistoryEstimator{
+						BlockHistorySize:       ptr[uint16](0),
+						Urgency:                ptr(feetypes.Urgency("Urgent")),
+						MediumRewardPercentile: ptr[uint16](5),
+						HighRewardPercentile:   ptr[uint16](101),
+					},
+				},
+			})
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "FeeHistory.BlockHistorySize: invalid value (0)")
+			assert.Contains(t, err.Error(), "Urgency: invalid value (Urgent)")
+			assert.Contains(t, err.Error(), "MediumRewardPercentile: invalid value (5)")
+			assert.Contains(t, err.Error(), "HighRewardPercentile: invalid value (101)")
+		})
+	})
+
+	t.Run("fee-tiers", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			cfg := configWithChains(t, 0, &toml.Chain{
+				GasEstimator: toml.GasEstimator{
+					FeeTiers: toml.FeeTiers{
+						High: toml.FeeTier{PriceMultiplier: ptr(decimal.RequireFromString("2")), PriceMax: assets.GWei(1000)},
+					},
+				},
+			})
+			assert.NoError(t, cfg.Validate())
+		})
+		t.Run("invalid", func(t *testing.T) {
+			cfg := configWithChains(t, 0, &toml.Chain{
+				GasEstimator: toml.GasEstimator{
+					FeeTiers: toml.FeeTiers{
+						Low:  toml.FeeTier{PriceMultiplier: ptr(decimal.Zero)},
+						High: toml.FeeTier{PriceMax: assets.NewWeiI(0)},
+					},
+				},
+			})
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "FeeTiers.Low.PriceMultiplier: invalid value (0): must be greater than 0")
+			assert.Contains(t, err.Error(), "FeeTiers.High.PriceMax: invalid value (0): must be greater than or equal to PriceMin")
+		})
+	})
+
+	t.Run("private-relay", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			cfg := configWithChains(t, 0, &toml.Chain{
+				Transactions: toml.Transactions{
+					PrivateRelay: toml.PrivateRelay{
+						Enabled: ptr(true),
+						URL:     configurl.MustParseURL("https://relay.test"),
+						Method:  ptr(toml.PrivateRelayMethodSendBundle),
+					},
+				},
+			})
+			assert.NoError(t, cfg.Validate())
+		})
+		t.Run("invalid", func(t *testing.T) {
+			cfg := configWithChains(t, 0, &toml.Chain{
+				Transactions: toml.Transactions{
+					PrivateRelay: toml.PrivateRelay{
+						Enabled: ptr(true),
+						Method:  ptr("eth_sendRawTransaction"),
+					},
+				},
+			})
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "URL: missing: required when private relay is enabled")
+			assert.Contains(t, err.Error(), "Method: invalid value (eth_sendRawTransaction)")
+		})
+	})
 }
 
 func TestNodePoolConfig(t *testing.T) {
@@ -9,6 +9,8 @@ import (
 	config "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 )
 
 // GasEstimator is an autogenerated mock type for the GasEstimator type
@@ -148,6 +150,46 @@ func (_m *GasEstimator) FeeCapDefault() *assets.Wei {
 	return r0
 }
 
+// FeeHistory provides a mock function with given fields:
+func (_m *GasEstimator) FeeHistory() config.FeeHistory {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeHistory")
+	}
+
+	var r0 config.FeeHistory
+	if rf, ok := ret.Get(0).(func() config.FeeHistory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.FeeHistory)
+		}
+	}
+
+	return r0
+}
+
+// FeeTier provides a mock function with given fields: urgency
+func (_m *GasEstimator) FeeTier(urgency types.Urgency) config.FeeTier {
+	ret := _m.Called(urgency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeTier")
+	}
+
+	var r0 config.FeeTier
+	if rf, ok := ret.Get(0).(func(types.Urgency) config.FeeTier); ok {
+		r0 = rf(urgency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.FeeTier)
+		}
+	}
+
+	return r0
+}
+
 // LimitDefault provides a mock function with given fields:
 func (_m *GasEstimator) LimitDefault() uint64 {
 	ret := _m.Called()
@@ -18,6 +18,7 @@ import (
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/common/config"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
@@ -411,6 +412,8 @@ type Transactions struct {
 	ReaperInterval       *commonconfig.Duration
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
+
+	PrivateRelay PrivateRelay `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -432,6 +435,58 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	t.PrivateRelay.setFrom(&f.PrivateRelay)
+}
+
+const (
+	// PrivateRelayMethodSendPrivateTransaction submits single txs.
+	PrivateRelayMethodSendPrivateTransaction = "eth_sendPrivateTransaction"
+	// PrivateRelayMethodSendBundle submits single tx bundles targeting the next block.
+	PrivateRelayMethodSendBundle = "eth_sendBundle"
+)
+
+// PrivateRelayMethods are the supported PrivateRelay.Method values.
+var PrivateRelayMethods = []string{PrivateRelayMethodSendPrivateTransaction, PrivateRelayMethodSendBundle}
+
+// PrivateRelay sends the signed txs to a private relay instead of the public mempool.
+type PrivateRelay struct {
+	Enabled          *bool
+	URL              *commonconfig.URL
+	Method           *string
+	FallbackBlocks   *uint32
+	FallbackAttempts *uint32
+}
+
+func (p *PrivateRelay) ValidateConfig() (err error) {
+	if p.Enabled == nil || !*p.Enabled {
+		return
+	}
+	if p.URL == nil || p.URL.IsZero() {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "URL", Msg: "required when private relay is enabled"})
+	}
+	if p.Method != nil && !slices.Contains(PrivateRelayMethods, *p.Method) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Method", Value: *p.Method,
+			Msg: fmt.Sprintf("must be one of %v", PrivateRelayMethods)})
+	}
+	return
+}
+
+func (p *PrivateRelay) setFrom(f *PrivateRelay) {
+	if v := f.Enabled; v != nil {
+		p.Enabled = v
+	}
+	if v := f.URL; v != nil {
+		p.URL = v
+	}
+	if v := f.Method; v != nil {
+		p.Method = v
+	}
+	if v := f.FallbackBlocks; v != nil {
+		p.FallbackBlocks = v
+	}
+	if v := f.FallbackAttempts; v != nil {
+		p.FallbackAttempts = v
+	}
 }
 
 type OCR2 struct {
@@ -501,6 +556,8 @@ type GasEstimator struct {
 	TipCapMin     *assets.Wei
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
+	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	FeeTiers     FeeTiers              `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -537,6 +594,16 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeHistory" && *e.FeeHistory.BlockHistorySize <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FeeHistory.BlockHistorySize", Value: *e.FeeHistory.BlockHistorySize,
+			Msg: "must be greater than or equal to 1 with FeeHistory Mode"})
+	}
+	for _, urgency := range feetypes.Urgencies {
+		if tier := e.FeeTiers.Tier(urgency); tier.PriceMax != nil && tier.PriceMax.Cmp(e.PriceMin) < 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("FeeTiers.%s.PriceMax", urgency), Value: tier.PriceMax,
+				Msg: "must be greater than or equal to PriceMin"})
+		}
+	}
 
 	return
 }
@@ -592,6 +659,8 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	}
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
+	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.FeeTiers.setFrom(&f.FeeTiers)
 }
 
 type GasLimitJobType struct {
@@ -654,6 +723,117 @@ func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
 	}
 }
 
+type FeeHistoryEstimator struct {
+	BlockHistorySize       *uint16
+	PredictionBlocks       *uint16
+	Urgency                *feetypes.Urgency
+	LowRewardPercentile    *uint16
+	MediumRewardPercentile *uint16
+	HighRewardPercentile   *uint16
+}
+
+func (e *FeeHistoryEstimator) ValidateConfig() (err error) {
+	if e.Urgency != nil && !e.Urgency.IsValid() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Urgency", Value: *e.Urgency,
+			Msg: fmt.Sprintf("must be one of %v", feetypes.Urgencies)})
+	}
+	percentiles := []struct {
+		name  string
+		value *uint16
+	}{
+		{"LowRewardPercentile", e.LowRewardPercentile},
+		{"MediumRewardPercentile", e.MediumRewardPercentile},
+		{"HighRewardPercentile", e.HighRewardPercentile},
+	}
+	for i, p := range percentiles {
+		if p.value == nil {
+			continue
+		}
+		if *p.value > 100 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: p.name, Value: *p.value,
+				Msg: "must be in range 0-100"})
+		}
+		if i > 0 && percentiles[i-1].value != nil && *p.value < *percentiles[i-1].value {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: p.name, Value: *p.value,
+				Msg: fmt.Sprintf("must be greater than or equal to %s", percentiles[i-1].name)})
+		}
+	}
+	return
+}
+
+func (e *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
+	if v := f.BlockHistorySize; v != nil {
+		e.BlockHistorySize = v
+	}
+	if v := f.PredictionBlocks; v != nil {
+		e.PredictionBlocks = v
+	}
+	if v := f.Urgency; v != nil {
+		e.Urgency = v
+	}
+	if v := f.LowRewardPercentile; v != nil {
+		e.LowRewardPercentile = v
+	}
+	if v := f.MediumRewardPercentile; v != nil {
+		e.MediumRewardPercentile = v
+	}
+	if v := f.HighRewardPercentile; v != nil {
+		e.HighRewardPercentile = v
+	}
+}
+
+// FeeTiers adjust the fees of the transactions requesting an urgency.
+type FeeTiers struct {
+	Low    FeeTier `toml:",omitempty"`
+	Medium FeeTier `toml:",omitempty"`
+	High   FeeTier `toml:",omitempty"`
+}
+
+// Tier returns the tier of the urgency, or nil if the urgency is unknown.
+func (t *FeeTiers) Tier(urgency feetypes.Urgency) *FeeTier {
+	switch urgency {
+	case feetypes.UrgencyLow:
+		return &t.Low
+	case feetypes.UrgencyMedium:
+		return &t.Medium
+	case feetypes.UrgencyHigh:
+		return &t.High
+	}
+	return nil
+}
+
+func (t *FeeTiers) setFrom(f *FeeTiers) {
+	t.Low.setFrom(&f.Low)
+	t.Medium.setFrom(&f.Medium)
+	t.High.setFrom(&f.High)
+}
+
+type FeeTier struct {
+	PriceMultiplier *decimal.Decimal
+	BumpThreshold   *uint32
+	PriceMax        *assets.Wei
+}
+
+func (t *FeeTier) ValidateConfig() (err error) {
+	if t.PriceMultiplier != nil && !t.PriceMultiplier.IsPositive() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PriceMultiplier", Value: t.PriceMultiplier,
+			Msg: "must be greater than 0"})
+	}
+	return
+}
+
+func (t *FeeTier) setFrom(f *FeeTier) {
+	if v := f.PriceMultiplier; v != nil {
+		t.PriceMultiplier = v
+	}
+	if v := f.BumpThreshold; v != nil {
+		t.BumpThreshold = v
+	}
+	if v := f.PriceMax; v != nil {
+		t.PriceMax = v
+	}
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
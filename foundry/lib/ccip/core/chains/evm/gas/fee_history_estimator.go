@@ -0,0 +1,366 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var _ EvmEstimator = &FeeHistoryEstimator{}
+
+type feeHistoryEstimatorConfig interface {
+	EIP1559DynamicFees() bool
+	BumpThreshold() uint64
+	TipCapMin() *assets.Wei
+	PriceMin() *assets.Wei
+	bumpConfig
+}
+
+// feeHistory is the result of eth_feeHistory, baseFeePerGas includes the base fee of the next block.
+type feeHistory struct {
+	OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+}
+
+// feeHistoryPrices are the prices estimated from the fee history.
+type feeHistoryPrices struct {
+	// nextBaseFee is the base fee of the next block.
+	nextBaseFee *assets.Wei
+	// predictedBaseFee is the base fee predicted PredictionBlocks ahead from the trend of the past blocks.
+	predictedBaseFee *assets.Wei
+	// tipCaps are the tip caps by urgency, missing when no reward was paid in the past blocks.
+	tipCaps map[feetypes.Urgency]*assets.Wei
+}
+
+// FeeHistoryEstimator is an Estimator which uses eth_feeHistory to price transactions from the rewards paid
+// in the past blocks and the base fee trend, without downloading the blocks.
+type FeeHistoryEstimator struct {
+	services.StateMachine
+
+	cfg        feeHistoryEstimatorConfig
+	fhCfg      FeeHistoryConfig
+	client     rpcClient
+	pollPeriod time.Duration
+	logger     logger.SugaredLogger
+
+	pricesMu sync.RWMutex
+	prices   *feeHistoryPrices
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         services.StopChan
+	chDone         chan struct{}
+}
+
+// NewFeeHistoryEstimator returns a new Estimator which uses the fee history of the chain.
+func NewFeeHistoryEstimator(lggr logger.Logger, client rpcClient, cfg feeHistoryEstimatorConfig, fhCfg FeeHistoryConfig) EvmEstimator {
+	return &FeeHistoryEstimator{
+		client:         client,
+		pollPeriod:     10 * time.Second,
+		logger:         logger.Sugared(logger.Named(lggr, "FeeHistoryEstimator")),
+		cfg:            cfg,
+		fhCfg:          fhCfg,
+		chForceRefetch: make(chan (chan struct{})),
+		chInitialised:  make(chan struct{}),
+		chStop:         make(chan struct{}),
+		chDone:         make(chan struct{}),
+	}
+}
+
+func (f *FeeHistoryEstimator) Name() string {
+	return f.logger.Name()
+}
+
+func (f *FeeHistoryEstimator) Start(context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		go f.run()
+		<-f.chInitialised
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		close(f.chStop)
+		<-f.chDone
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.Healthy()}
+}
+
+func (f *FeeHistoryEstimator) run() {
+	defer close(f.chDone)
+
+	t := f.refreshPrices()
+	close(f.chInitialised)
+
+	for {
+		select {
+		case <-f.chStop:
+			return
+		case ch := <-f.chForceRefetch:
+			t.Stop()
+			t = f.refreshPrices()
+			close(ch)
+		case <-t.C:
+			t = f.refreshPrices()
+		}
+	}
+}
+
+func (f *FeeHistoryEstimator) refreshPrices() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(f.pollPeriod))
+
+	ctx, cancel := f.chStop.CtxCancel(evmclient.ContextWithDefaultTimeout())
+	defer cancel()
+
+	percentiles, urgencyPercentiles := f.rewardPercentiles()
+	var history feeHistory
+	if err := f.client.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint(f.fhCfg.BlockHistorySize()), "latest", percentiles); err != nil {
+		f.logger.Warnf("Failed to refresh prices, got error: %s", err)
+		return
+	}
+	prices, err := calculateFeeHistoryPrices(history, urgencyPercentiles, f.fhCfg.PredictionBlocks())
+	if err != nil {
+		f.logger.Warnw("Failed to calculate prices from the fee history", "err", err, "oldestBlock", history.OldestBlock)
+		return
+	}
+
+	f.logger.Debugw("refreshPrices", "oldestBlock", history.OldestBlock, "nextBaseFee", prices.nextBaseFee, "predictedBaseFee", prices.predictedBaseFee, "tipCaps", prices.tipCaps)
+
+	f.pricesMu.Lock()
+	defer f.pricesMu.Unlock()
+	f.prices = prices
+	return
+}
+
+// rewardPercentiles returns the percentiles to request the rewards of, and the index of the percentile of each urgency.
+// eth_feeHistory requires the percentiles to be strictly increasing, urgencies can share a percentile.
+func (f *FeeHistoryEstimator) rewardPercentiles() (percentiles []float64, urgencyPercentiles map[feetypes.Urgency]int) {
+	urgencyPercentiles = make(map[feetypes.Urgency]int, len(feetypes.Urgencies))
+	for _, urgency := range feetypes.Urgencies {
+		percentile := float64(f.fhCfg.RewardPercentile(urgency))
+		i, found := slices.BinarySearch(percentiles, percentile)
+		if !found {
+			percentiles = slices.Insert(percentiles, i, percentile)
+		}
+	}
+	for _, urgency := range feetypes.Urgencies {
+		i, _ := slices.BinarySearch(percentiles, float64(f.fhCfg.RewardPercentile(urgency)))
+		urgencyPercentiles[urgency] = i
+	}
+	return
+}
+
+// calculateFeeHistoryPrices predicts the base fee from the average gas used ratio of the past blocks, following the
+// EIP-1559 base fee adjustment of up to 12.5% per block, and takes the median reward of the non-empty blocks as tip cap.
+// The predicted base fee never goes below the base fee of the next block, a falling trend may not last.
+func calculateFeeHistoryPrices(history feeHistory, urgencyPercentiles map[feetypes.Urgency]int, predictionBlocks uint16) (*feeHistoryPrices, error) {
+	if len(history.BaseFeePerGas) == 0 || history.BaseFeePerGas[len(history.BaseFeePerGas)-1] == nil {
+		return nil, pkgerrors.New("fee history has no base fee, is the chain EIP-1559 compatible?")
+	}
+	nextBaseFee := (*big.Int)(history.BaseFeePerGas[len(history.BaseFeePerGas)-1])
+
+	var gasUsedRatio float64
+	for _, ratio := range history.GasUsedRatio {
+		gasUsedRatio += ratio
+	}
+	// A block at its gas target leaves the base fee unchanged, a full block raises it by 12.5%.
+	trend := 1.0
+	if len(history.GasUsedRatio) > 0 {
+		gasUsedRatio /= float64(len(history.GasUsedRatio))
+		trend = min(max(1+(gasUsedRatio-0.5)/4, 0.875), 1.125)
+	}
+	predicted := new(big.Float).SetInt(nextBaseFee)
+	for i := uint16(0); i < predictionBlocks; i++ {
+		predicted.Mul(predicted, big.NewFloat(trend))
+	}
+	predictedBaseFee, _ := predicted.Int(nil)
+	if predictedBaseFee.Cmp(nextBaseFee) < 0 {
+		predictedBaseFee = nextBaseFee
+	}
+
+	tipCaps := make(map[feetypes.Urgency]*assets.Wei, len(urgencyPercentiles))
+	for urgency, idx := range urgencyPercentiles {
+		var rewards []*big.Int
+		for i, blockRewards := range history.Reward {
+			// Empty blocks report zero rewards, they don't tell anything about the tips needed to be included.
+			if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+				continue
+			}
+			if idx >= len(blockRewards) || blockRewards[idx] == nil {
+				return nil, fmt.Errorf("fee history has %d rewards for block %d, expected at least %d", len(blockRewards), i, idx+1)
+			}
+			rewards = append(rewards, (*big.Int)(blockRewards[idx]))
+		}
+		if len(rewards) == 0 {
+			continue
+		}
+		sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+		tipCaps[urgency] = assets.NewWei(rewards[len(rewards)/2])
+	}
+
+	return &feeHistoryPrices{
+		nextBaseFee:      assets.NewWei(nextBaseFee),
+		predictedBaseFee: assets.NewWei(predictedBaseFee),
+		tipCaps:          tipCaps,
+	}, nil
+}
+
+// Uses the force refetch chan to trigger a price update and blocks until complete
+func (f *FeeHistoryEstimator) forceRefresh(ctx context.Context) (err error) {
+	ch := make(chan struct{})
+	select {
+	case f.chForceRefetch <- ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) OnNewLongestChain(context.Context, *evmtypes.Head) {}
+
+func (f *FeeHistoryEstimator) getPrices() *feeHistoryPrices {
+	f.pricesMu.RLock()
+	defer f.pricesMu.RUnlock()
+	return f.prices
+}
+
+// getTipCap returns the tip cap of the configured urgency, TipCapDefault when no reward was paid in the past blocks.
+func (f *FeeHistoryEstimator) getTipCap(prices *feeHistoryPrices) *assets.Wei {
+	urgency := f.fhCfg.Urgency()
+	tipCap, ok := prices.tipCaps[urgency]
+	if !ok {
+		f.logger.Warnw("No reward paid in the fee history, using EVM.GasEstimator.TipCapDefault as fallback", "urgency", urgency)
+		tipCap = f.cfg.TipCapDefault()
+	}
+	return assets.WeiMax(tipCap, f.cfg.TipCapMin())
+}
+
+// GetLegacyGas returns the base fee predicted PredictionBlocks ahead plus the tip cap, as a legacy transaction pays its whole gas price.
+func (f *FeeHistoryEstimator) GetLegacyGas(_ context.Context, _ []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, _ ...feetypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	chainSpecificGasLimit = gasLimit
+	ok := f.IfStarted(func() {
+		prices := f.getPrices()
+		if prices == nil {
+			err = pkgerrors.New("failed to estimate gas; fee history not fetched yet")
+			return
+		}
+		gasPrice = f.legacyGasPrice(prices, maxGasPriceWei)
+	})
+	if !ok {
+		return nil, 0, pkgerrors.New("estimator is not started")
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) legacyGasPrice(prices *feeHistoryPrices, maxGasPriceWei *assets.Wei) *assets.Wei {
+	gasPrice := assets.WeiMax(prices.predictedBaseFee.Add(f.getTipCap(prices)), f.cfg.PriceMin())
+	return capGasPrice(gasPrice, maxGasPriceWei, f.cfg.PriceMax())
+}
+
+// BumpLegacyGas refreshes the fee history and bumps the original gas price, or takes the refreshed one if it's higher.
+func (f *FeeHistoryEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	var currentGasPrice *assets.Wei
+	ok := f.IfStarted(func() {
+		if prices := f.refreshedPrices(ctx); prices != nil {
+			currentGasPrice = f.legacyGasPrice(prices, maxGasPriceWei)
+		}
+	})
+	if !ok {
+		return nil, 0, pkgerrors.New("estimator is not started")
+	}
+	bumpedGasPrice, err = BumpLegacyGasPriceOnly(f.cfg, f.logger, currentGasPrice, originalGasPrice, maxGasPriceWei)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bumpedGasPrice, gasLimit, nil
+}
+
+// refreshedPrices refreshes the fee history before bumping, the last prices are used if it fails.
+func (f *FeeHistoryEstimator) refreshedPrices(ctx context.Context) *feeHistoryPrices {
+	if err := f.forceRefresh(ctx); err != nil {
+		f.logger.Warnw("Failed to refresh the fee history before bumping, using the last prices", "err", err)
+	}
+	return f.getPrices()
+}
+
+// GetDynamicFee returns the tip cap of the configured urgency and a fee cap covering the base fee predicted PredictionBlocks ahead.
+func (f *FeeHistoryEstimator) GetDynamicFee(_ context.Context, maxGasPriceWei *assets.Wei) (fee DynamicFee, err error) {
+	if !f.cfg.EIP1559DynamicFees() {
+		return fee, pkgerrors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	ok := f.IfStarted(func() {
+		prices := f.getPrices()
+		if prices == nil {
+			err = pkgerrors.New("failed to estimate gas; fee history not fetched yet")
+			return
+		}
+		maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.cfg.PriceMax())
+		fee.TipCap = f.getTipCap(prices)
+		if fee.TipCap.Cmp(maxGasPrice) > 0 {
+			f.logger.Warnw("Tip cap exceeds the max gas price, capping it", "tipCap", fee.TipCap, "maxGasPrice", maxGasPrice)
+			fee.TipCap = maxGasPrice
+		}
+		if f.cfg.BumpThreshold() == 0 {
+			// just use the max gas price if gas bumping is disabled
+			fee.FeeCap = maxGasPrice
+			return
+		}
+		fee.FeeCap = prices.predictedBaseFee.Add(fee.TipCap)
+		if fee.FeeCap.Cmp(maxGasPrice) > 0 {
+			fee.FeeCap = maxGasPrice
+		}
+	})
+	if !ok {
+		return fee, pkgerrors.New("estimator is not started")
+	}
+	return
+}
+
+// BumpDynamicFee refreshes the fee history and bumps the original fee. Unlike GetDynamicFee, the bumped fee cap
+// assumes the worst case base fee increase over PredictionBlocks, as the original fee wasn't enough to be included.
+func (f *FeeHistoryEstimator) BumpDynamicFee(ctx context.Context, originalFee DynamicFee, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, err error) {
+	var currentTipCap, currentBaseFee *assets.Wei
+	ok := f.IfStarted(func() {
+		if prices := f.refreshedPrices(ctx); prices != nil {
+			currentTipCap = f.getTipCap(prices)
+			currentBaseFee = prices.nextBaseFee
+		}
+	})
+	if !ok {
+		return bumped, pkgerrors.New("estimator is not started")
+	}
+	return BumpDynamicFeeOnly(f.cfg, f.fhCfg.PredictionBlocks(), f.logger, currentTipCap, currentBaseFee, originalFee, maxGasPriceWei)
+}
@@ -0,0 +1,283 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// feeHistory is 4 blocks at their gas limit but the last one, which is empty, with the base fee of the next block
+// doubled. The rewards are requested at the 10th, 50th and 90th percentiles.
+const feeHistory = `{
+	"oldestBlock": "0x1",
+	"baseFeePerGas": ["0x64", "0x64", "0x64", "0x64", "0xc8"],
+	"gasUsedRatio": [1, 1, 1, 0],
+	"reward": [["0x1", "0x5", "0x9"], ["0x2", "0x6", "0xa"], ["0x3", "0x7", "0xb"], ["0x0", "0x0", "0x0"]]
+}`
+
+// emptyFeeHistory is 4 empty blocks.
+const emptyFeeHistory = `{
+	"oldestBlock": "0x1",
+	"baseFeePerGas": ["0x64", "0x64", "0x64", "0x64", "0xc8"],
+	"gasUsedRatio": [0, 0, 0, 0],
+	"reward": [["0x0", "0x0", "0x0"], ["0x0", "0x0", "0x0"], ["0x0", "0x0", "0x0"], ["0x0", "0x0", "0x0"]]
+}`
+
+func mockFeeHistory(t *testing.T, client *mocks.RPCClient, history string, percentiles []float64) {
+	client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, "latest", percentiles).Return(nil).Run(func(args mock.Arguments) {
+		require.NoError(t, json.Unmarshal([]byte(history), args.Get(1)))
+	})
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	percentiles := []float64{10, 50, 90}
+
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	const gasLimit uint64 = 80000
+
+	newCfg := func() *gas.MockGasEstimatorConfig {
+		return &gas.MockGasEstimatorConfig{
+			EIP1559DynamicFeesF: true,
+			BumpPercentF:        10,
+			BumpMinF:            assets.NewWeiI(1),
+			BumpThresholdF:      3,
+			TipCapDefaultF:      assets.NewWeiI(4),
+			TipCapMinF:          assets.NewWeiI(1),
+			PriceMinF:           assets.NewWeiI(1),
+			PriceMaxF:           maxGasPrice,
+		}
+	}
+	newFhCfg := func() *gas.MockFeeHistoryConfig {
+		return &gas.MockFeeHistoryConfig{
+			BlockHistorySizeF: 4,
+			PredictionBlocksF: 2,
+			UrgencyF:          feetypes.UrgencyMedium,
+			RewardPercentilesF: map[feetypes.Urgency]uint16{
+				feetypes.UrgencyLow:    10,
+				feetypes.UrgencyMedium: 50,
+				feetypes.UrgencyHigh:   90,
+			},
+		}
+	}
+
+	t.Run("calling GetDynamicFee on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		_, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("calling GetDynamicFee on started estimator returns the predicted base fee plus the tip cap of the urgency", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		// The average gas used ratio of 0.75 raises the base fee by 6.25% per block: 200 * 1.0625^2 = 225.
+		// The empty block is ignored, the median of the medium rewards is 6.
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(231), TipCap: assets.NewWeiI(6)}, fee)
+	})
+
+	t.Run("tip cap follows the configured urgency", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		fhCfg := newFhCfg()
+		fhCfg.UrgencyF = feetypes.UrgencyHigh
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg)
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(235), TipCap: assets.NewWeiI(10)}, fee)
+	})
+
+	t.Run("urgencies sharing a percentile request it once", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, `{
+			"oldestBlock": "0x1",
+			"baseFeePerGas": ["0x64", "0xc8"],
+			"gasUsedRatio": [0.5],
+			"reward": [["0x5", "0x9"]]
+		}`, []float64{50, 90})
+
+		fhCfg := newFhCfg()
+		fhCfg.RewardPercentilesF[feetypes.UrgencyLow] = 50
+		fhCfg.UrgencyF = feetypes.UrgencyLow
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), fhCfg)
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(205), TipCap: assets.NewWeiI(5)}, fee)
+	})
+
+	t.Run("falling base fee and empty blocks use the next base fee and TipCapDefault", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, emptyFeeHistory, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(204), TipCap: assets.NewWeiI(4)}, fee)
+	})
+
+	t.Run("fee cap is the max gas price if gas bumping is disabled", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		cfg := newCfg()
+		cfg.BumpThresholdF = 0
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, newFhCfg())
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: maxGasPrice, TipCap: assets.NewWeiI(6)}, fee)
+	})
+
+	t.Run("fee cap and tip cap are capped by the user specified max gas price", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		fee, err := o.GetDynamicFee(testutils.Context(t), assets.NewWeiI(100))
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(100), TipCap: assets.NewWeiI(6)}, fee)
+
+		fee, err = o.GetDynamicFee(testutils.Context(t), assets.NewWeiI(5))
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(5), TipCap: assets.NewWeiI(5)}, fee)
+	})
+
+	t.Run("calling GetDynamicFee with EIP1559 disabled returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		cfg := newCfg()
+		cfg.EIP1559DynamicFeesF = false
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, newFhCfg())
+		_, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "Can't get dynamic fee, EIP1559 is disabled")
+	})
+
+	t.Run("calling GetDynamicFee on started estimator if initial call failed returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, "latest", percentiles).Return(pkgerrors.New("kaboom"))
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		_, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "failed to estimate gas; fee history not fetched yet")
+	})
+
+	t.Run("calling GetDynamicFee on a chain without base fee returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, `{"oldestBlock": "0x1", "baseFeePerGas": [], "gasUsedRatio": [], "reward": []}`, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		_, err := o.GetDynamicFee(testutils.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "failed to estimate gas; fee history not fetched yet")
+	})
+
+	t.Run("calling GetLegacyGas on started estimator returns the predicted base fee plus the tip cap", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(testutils.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(231), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		gasPrice, _, err = o.GetLegacyGas(testutils.Context(t), calldata, gasLimit, assets.NewWeiI(100))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+	})
+
+	t.Run("calling GetLegacyGas returns at least PriceMin", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		cfg := newCfg()
+		cfg.PriceMinF = assets.NewWeiI(300)
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, newFhCfg())
+		servicetest.RunHealthy(t, o)
+		gasPrice, _, err := o.GetLegacyGas(testutils.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(300), gasPrice)
+	})
+
+	t.Run("calling BumpDynamicFee on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(300), TipCap: assets.NewWeiI(6)}
+		_, err := o.BumpDynamicFee(testutils.Context(t), fee, maxGasPrice, nil)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("calling BumpDynamicFee refreshes the fee history and bumps the original fee", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(300), TipCap: assets.NewWeiI(6)}
+		bumped, err := o.BumpDynamicFee(testutils.Context(t), fee, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(330), TipCap: assets.NewWeiI(7)}, bumped)
+	})
+
+	t.Run("calling BumpDynamicFee takes the current fee if it's higher than the bumped one", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		fee := gas.DynamicFee{FeeCap: assets.NewWeiI(100), TipCap: assets.NewWeiI(2)}
+		bumped, err := o.BumpDynamicFee(testutils.Context(t), fee, maxGasPrice, nil)
+		require.NoError(t, err)
+		// The worst case base fee over 2 blocks: 200 * 1.125^2 = 253.
+		assert.Equal(t, gas.DynamicFee{FeeCap: assets.NewWeiI(259), TipCap: assets.NewWeiI(6)}, bumped)
+	})
+
+	t.Run("calling BumpLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		_, _, err := o.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(42), gasLimit, maxGasPrice, nil)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("calling BumpLegacyGas refreshes the fee history and bumps the original gas price", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(t, client, feeHistory, percentiles)
+
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), client, newCfg(), newFhCfg())
+		servicetest.RunHealthy(t, o)
+		gasPrice, chainSpecificGasLimit, err := o.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(300), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(330), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		gasPrice, _, err = o.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(100), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(231), gasPrice)
+	})
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/common/config"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
@@ -110,6 +112,47 @@ func (m *MockBlockHistoryConfig) TransactionPercentile() uint16 {
 	return m.TransactionPercentileF
 }
 
+type MockFeeHistoryConfig struct {
+	BlockHistorySizeF  uint16
+	PredictionBlocksF  uint16
+	UrgencyF           feetypes.Urgency
+	RewardPercentilesF map[feetypes.Urgency]uint16
+}
+
+func (m *MockFeeHistoryConfig) BlockHistorySize() uint16 {
+	return m.BlockHistorySizeF
+}
+
+func (m *MockFeeHistoryConfig) PredictionBlocks() uint16 {
+	return m.PredictionBlocksF
+}
+
+func (m *MockFeeHistoryConfig) Urgency() feetypes.Urgency {
+	return m.UrgencyF
+}
+
+func (m *MockFeeHistoryConfig) RewardPercentile(urgency feetypes.Urgency) uint16 {
+	return m.RewardPercentilesF[urgency]
+}
+
+type MockFeeTierConfig struct {
+	PriceMultiplierF float32
+	BumpThresholdF   uint64
+	PriceMaxF        *assets.Wei
+}
+
+func (m *MockFeeTierConfig) PriceMultiplier() float32 {
+	return m.PriceMultiplierF
+}
+
+func (m *MockFeeTierConfig) BumpThreshold() uint64 {
+	return m.BumpThresholdF
+}
+
+func (m *MockFeeTierConfig) PriceMax() *assets.Wei {
+	return m.PriceMaxF
+}
+
 type MockConfig struct {
 	ChainTypeF          string
 	FinalityTagEnabledF bool
@@ -145,6 +188,7 @@ type MockGasEstimatorConfig struct {
 	FeeCapDefaultF      *assets.Wei
 	LimitMaxF           uint64
 	ModeF               string
+	FeeTiersF           map[feetypes.Urgency]*MockFeeTierConfig
 }
 
 func NewMockGasConfig() *MockGasEstimatorConfig {
@@ -202,3 +246,10 @@ func (m *MockGasEstimatorConfig) LimitMax() uint64 {
 func (m *MockGasEstimatorConfig) Mode() string {
 	return m.ModeF
 }
+
+func (m *MockGasEstimatorConfig) FeeTier(urgency feetypes.Urgency) evmconfig.FeeTier {
+	if tier, ok := m.FeeTiersF[urgency]; ok {
+		return tier
+	}
+	return &MockFeeTierConfig{PriceMultiplierF: 1, BumpThresholdF: m.BumpThresholdF, PriceMaxF: m.PriceMaxF}
+}
@@ -25,9 +25,9 @@ type EvmFeeEstimator struct {
 	mock.Mock
 }
 
-// BumpFee provides a mock function with given fields: ctx, originalFee, feeLimit, maxFeePrice, attempts
-func (_m *EvmFeeEstimator) BumpFee(ctx context.Context, originalFee gas.EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []gas.EvmPriorAttempt) (gas.EvmFee, uint64, error) {
-	ret := _m.Called(ctx, originalFee, feeLimit, maxFeePrice, attempts)
+// BumpFee provides a mock function with given fields: ctx, originalFee, feeLimit, maxFeePrice, urgency, attempts
+func (_m *EvmFeeEstimator) BumpFee(ctx context.Context, originalFee gas.EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, urgency types.Urgency, attempts []gas.EvmPriorAttempt) (gas.EvmFee, uint64, error) {
+	ret := _m.Called(ctx, originalFee, feeLimit, maxFeePrice, urgency, attempts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BumpFee")
@@ -36,23 +36,23 @@ func (_m *EvmFeeEstimator) BumpFee(ctx context.Context, originalFee gas.EvmFee,
 	var r0 gas.EvmFee
 	var r1 uint64
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, []gas.EvmPriorAttempt) (gas.EvmFee, uint64, error)); ok {
-		return rf(ctx, originalFee, feeLimit, maxFeePrice, attempts)
+	if rf, ok := ret.Get(0).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, types.Urgency, []gas.EvmPriorAttempt) (gas.EvmFee, uint64, error)); ok {
+		return rf(ctx, originalFee, feeLimit, maxFeePrice, urgency, attempts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, []gas.EvmPriorAttempt) gas.EvmFee); ok {
-		r0 = rf(ctx, originalFee, feeLimit, maxFeePrice, attempts)
+	if rf, ok := ret.Get(0).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, types.Urgency, []gas.EvmPriorAttempt) gas.EvmFee); ok {
+		r0 = rf(ctx, originalFee, feeLimit, maxFeePrice, urgency, attempts)
 	} else {
 		r0 = ret.Get(0).(gas.EvmFee)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, []gas.EvmPriorAttempt) uint64); ok {
-		r1 = rf(ctx, originalFee, feeLimit, maxFeePrice, attempts)
+	if rf, ok := ret.Get(1).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, types.Urgency, []gas.EvmPriorAttempt) uint64); ok {
+		r1 = rf(ctx, originalFee, feeLimit, maxFeePrice, urgency, attempts)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, []gas.EvmPriorAttempt) error); ok {
-		r2 = rf(ctx, originalFee, feeLimit, maxFeePrice, attempts)
+	if rf, ok := ret.Get(2).(func(context.Context, gas.EvmFee, uint64, *assets.Wei, types.Urgency, []gas.EvmPriorAttempt) error); ok {
+		r2 = rf(ctx, originalFee, feeLimit, maxFeePrice, urgency, attempts)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -78,14 +78,14 @@ func (_m *EvmFeeEstimator) Close() error {
 	return r0
 }
 
-// GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, opts
-func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...types.Opt) (gas.EvmFee, uint64, error) {
+// GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, urgency, opts
+func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, urgency types.Urgency, opts ...types.Opt) (gas.EvmFee, uint64, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx, calldata, feeLimit, maxFeePrice)
+	_ca = append(_ca, ctx, calldata, feeLimit, maxFeePrice, urgency)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
@@ -96,23 +96,23 @@ func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit
 	var r0 gas.EvmFee
 	var r1 uint64
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, ...types.Opt) (gas.EvmFee, uint64, error)); ok {
-		return rf(ctx, calldata, feeLimit, maxFeePrice, opts...)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, types.Urgency, ...types.Opt) (gas.EvmFee, uint64, error)); ok {
+		return rf(ctx, calldata, feeLimit, maxFeePrice, urgency, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, ...types.Opt) gas.EvmFee); ok {
-		r0 = rf(ctx, calldata, feeLimit, maxFeePrice, opts...)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, types.Urgency, ...types.Opt) gas.EvmFee); ok {
+		r0 = rf(ctx, calldata, feeLimit, maxFeePrice, urgency, opts...)
 	} else {
 		r0 = ret.Get(0).(gas.EvmFee)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint64, *assets.Wei, ...types.Opt) uint64); ok {
-		r1 = rf(ctx, calldata, feeLimit, maxFeePrice, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint64, *assets.Wei, types.Urgency, ...types.Opt) uint64); ok {
+		r1 = rf(ctx, calldata, feeLimit, maxFeePrice, urgency, opts...)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, []byte, uint64, *assets.Wei, ...types.Opt) error); ok {
-		r2 = rf(ctx, calldata, feeLimit, maxFeePrice, opts...)
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, uint64, *assets.Wei, types.Urgency, ...types.Opt) error); ok {
+		r2 = rf(ctx, calldata, feeLimit, maxFeePrice, urgency, opts...)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -34,8 +35,9 @@ type EvmFeeEstimator interface {
 
 	// L1Oracle returns the L1 gas price oracle only if the chain has one, e.g. OP stack L2s and Arbitrum.
 	L1Oracle() rollups.L1Oracle
-	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (fee EvmFee, chainSpecificFeeLimit uint64, err error)
-	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
+	// GetFee and BumpFee apply the fee tier of the urgency to the estimated fee, it's left as is when the urgency is empty.
+	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, urgency feetypes.Urgency, opts ...feetypes.Opt) (fee EvmFee, chainSpecificFeeLimit uint64, err error)
+	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, urgency feetypes.Urgency, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (*big.Int, error)
@@ -82,6 +84,10 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config, ge
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewBlockHistoryEstimator(lggr, ethClient, cfg, geCfg, bh, *ethClient.ConfiguredChainID())
 		}
+	case "FeeHistory":
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			return NewFeeHistoryEstimator(lggr, ethClient, geCfg, geCfg.FeeHistory())
+		}
 	case "FixedPrice":
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, bh, lggr)
@@ -244,7 +250,26 @@ func (e *WrappedEvmEstimator) L1Oracle() rollups.L1Oracle {
 	return e.l1Oracle
 }
 
-func (e *WrappedEvmEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (fee EvmFee, chainSpecificFeeLimit uint64, err error) {
+// GetFee estimates the fee, capped by the PriceMax of the fee tier of the urgency and multiplied by its PriceMultiplier.
+func (e *WrappedEvmEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, urgency feetypes.Urgency, opts ...feetypes.Opt) (fee EvmFee, chainSpecificFeeLimit uint64, err error) {
+	if urgency == "" {
+		return e.getFee(ctx, calldata, feeLimit, maxFeePrice, opts...)
+	}
+	tier := e.geCfg.FeeTier(urgency)
+	maxFeePrice = tierMaxFeePrice(tier, maxFeePrice)
+	fee, chainSpecificFeeLimit, err = e.getFee(ctx, calldata, feeLimit, maxFeePrice, opts...)
+	if err != nil {
+		return
+	}
+	if multiplier := tier.PriceMultiplier(); multiplier != 1 {
+		fee.Legacy = applyPriceMultiplier(fee.Legacy, multiplier, maxFeePrice)
+		fee.DynamicFeeCap = applyPriceMultiplier(fee.DynamicFeeCap, multiplier, maxFeePrice)
+		fee.DynamicTipCap = applyPriceMultiplier(fee.DynamicTipCap, multiplier, maxFeePrice)
+	}
+	return
+}
+
+func (e *WrappedEvmEstimator) getFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (fee EvmFee, chainSpecificFeeLimit uint64, err error) {
 	// get dynamic fee
 	if e.EIP1559Enabled {
 		var dynamicFee DynamicFee
@@ -269,7 +294,7 @@ func (e *WrappedEvmEstimator) GetFee(ctx context.Context, calldata []byte, feeLi
 }
 
 func (e *WrappedEvmEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (*big.Int, error) {
-	fees, gasLimit, err := e.GetFee(ctx, calldata, feeLimit, maxFeePrice, opts...)
+	fees, gasLimit, err := e.GetFee(ctx, calldata, feeLimit, maxFeePrice, "", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -286,7 +311,13 @@ func (e *WrappedEvmEstimator) GetMaxCost(ctx context.Context, amount assets.Eth,
 	return amountWithFees, nil
 }
 
-func (e *WrappedEvmEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error) {
+// BumpFee bumps the original fee, capped by the PriceMax of the fee tier of the urgency. The original fee already
+// had the PriceMultiplier of the tier applied.
+func (e *WrappedEvmEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, urgency feetypes.Urgency, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error) {
+	if urgency != "" {
+		maxFeePrice = tierMaxFeePrice(e.geCfg.FeeTier(urgency), maxFeePrice)
+	}
+
 	// validate only 1 fee type is present
 	if (!originalFee.ValidDynamic() && originalFee.Legacy == nil) || (originalFee.ValidDynamic() && originalFee.Legacy != nil) {
 		err = pkgerrors.New("only one dynamic or legacy fee can be defined")
@@ -320,6 +351,27 @@ func (e *WrappedEvmEstimator) BumpFee(ctx context.Context, originalFee EvmFee, f
 	return
 }
 
+// tierMaxFeePrice returns the lowest of the max fee price and the PriceMax of the tier.
+func tierMaxFeePrice(tier evmconfig.FeeTier, maxFeePrice *assets.Wei) *assets.Wei {
+	tierPriceMax := tier.PriceMax()
+	if tierPriceMax != nil && (maxFeePrice == nil || tierPriceMax.Cmp(maxFeePrice) < 0) {
+		return tierPriceMax
+	}
+	return maxFeePrice
+}
+
+// applyPriceMultiplier multiplies the price, up to the max fee price.
+func applyPriceMultiplier(price *assets.Wei, multiplier float32, maxFeePrice *assets.Wei) *assets.Wei {
+	if price == nil {
+		return nil
+	}
+	multiplied := assets.NewWei(decimal.NewFromBigInt(price.ToInt(), 0).Mul(decimal.NewFromFloat32(multiplier)).BigInt())
+	if maxFeePrice != nil && multiplied.Cmp(maxFeePrice) > 0 {
+		return maxFeePrice
+	}
+	return multiplied
+}
+
 // Config defines an interface for configuration in the gas package
 //
 //go:generate mockery --quiet --name Config --output ./mocks/ --case=underscore
@@ -343,12 +395,17 @@ type GasEstimatorConfig interface {
 	PriceMin() *assets.Wei
 	PriceMax() *assets.Wei
 	Mode() string
+	FeeTier(urgency feetypes.Urgency) evmconfig.FeeTier
 }
 
 type BlockHistoryConfig interface {
 	evmconfig.BlockHistory
 }
 
+type FeeHistoryConfig interface {
+	evmconfig.FeeHistory
+}
+
 // Int64ToHex converts an int64 into go-ethereum's hex representation
 func Int64ToHex(n int64) string {
 	return hexutil.EncodeBig(big.NewInt(n))
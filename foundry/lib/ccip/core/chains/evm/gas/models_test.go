@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
@@ -67,7 +68,7 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		// expect legacy fee data
 		dynamicFees := false
 		estimator := gas.NewWrappedEvmEstimator(lggr, getRootEst, dynamicFees, nil, geCfg)
-		fee, max, err := estimator.GetFee(ctx, nil, 0, nil)
+		fee, max, err := estimator.GetFee(ctx, nil, 0, nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, uint64(float32(gasLimit)*limitMultiplier), max)
 		assert.True(t, legacyFee.Equal(fee.Legacy))
@@ -77,7 +78,7 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		// expect dynamic fee data
 		dynamicFees = true
 		estimator = gas.NewWrappedEvmEstimator(lggr, getRootEst, dynamicFees, nil, geCfg)
-		fee, max, err = estimator.GetFee(ctx, nil, gasLimit, nil)
+		fee, max, err = estimator.GetFee(ctx, nil, gasLimit, nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, uint64(float32(gasLimit)*limitMultiplier), max)
 		assert.True(t, dynamicFee.FeeCap.Equal(fee.DynamicFeeCap))
@@ -92,7 +93,7 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		estimator := gas.NewWrappedEvmEstimator(lggr, getRootEst, dynamicFees, nil, geCfg)
 
 		// expect legacy fee data
-		fee, max, err := estimator.BumpFee(ctx, gas.EvmFee{Legacy: assets.NewWeiI(0)}, 0, nil, nil)
+		fee, max, err := estimator.BumpFee(ctx, gas.EvmFee{Legacy: assets.NewWeiI(0)}, 0, nil, "", nil)
 		require.NoError(t, err)
 		assert.Equal(t, uint64(float32(gasLimit)*limitMultiplier), max)
 		assert.True(t, legacyFee.Equal(fee.Legacy))
@@ -103,7 +104,7 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		fee, max, err = estimator.BumpFee(ctx, gas.EvmFee{
 			DynamicFeeCap: assets.NewWeiI(0),
 			DynamicTipCap: assets.NewWeiI(0),
-		}, gasLimit, nil, nil)
+		}, gasLimit, nil, "", nil)
 		require.NoError(t, err)
 		assert.Equal(t, uint64(float32(gasLimit)*limitMultiplier), max)
 		assert.True(t, dynamicFee.FeeCap.Equal(fee.DynamicFeeCap))
@@ -111,13 +112,13 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		assert.Nil(t, fee.Legacy)
 
 		// expect error
-		_, _, err = estimator.BumpFee(ctx, gas.EvmFee{}, 0, nil, nil)
+		_, _, err = estimator.BumpFee(ctx, gas.EvmFee{}, 0, nil, "", nil)
 		assert.Error(t, err)
 		_, _, err = estimator.BumpFee(ctx, gas.EvmFee{
 			Legacy:        legacyFee,
 			DynamicFeeCap: dynamicFee.FeeCap,
 			DynamicTipCap: dynamicFee.TipCap,
-		}, 0, nil, nil)
+		}, 0, nil, "", nil)
 		assert.Error(t, err)
 	})
 
@@ -228,3 +229,85 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		require.NotNil(t, report[mockEstimatorName])
 	})
 }
+
+func TestWrappedEvmEstimator_FeeTiers(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	gasLimit := uint64(10)
+	legacyFee := assets.NewWeiI(10)
+	dynamicFee := gas.DynamicFee{
+		FeeCap: assets.NewWeiI(20),
+		TipCap: assets.NewWeiI(2),
+	}
+
+	geCfg := gas.NewMockGasConfig()
+	geCfg.LimitMultiplierF = 1
+	geCfg.PriceMaxF = assets.NewWeiI(100)
+	geCfg.FeeTiersF = map[feetypes.Urgency]*gas.MockFeeTierConfig{
+		feetypes.UrgencyLow:  {PriceMultiplierF: 0.5, PriceMaxF: assets.NewWeiI(100)},
+		feetypes.UrgencyHigh: {PriceMultiplierF: 2, PriceMaxF: assets.NewWeiI(30)},
+	}
+
+	t.Run("GetFee without urgency is not adjusted", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, assets.NewWeiI(100)).Return(legacyFee, gasLimit, nil).Once()
+		estimator := gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, false, nil, geCfg)
+
+		fee, _, err := estimator.GetFee(ctx, nil, gasLimit, assets.NewWeiI(100), "")
+		require.NoError(t, err)
+		assert.Equal(t, legacyFee, fee.Legacy)
+	})
+
+	t.Run("GetFee applies the multiplier of the tier", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, assets.NewWeiI(100)).Return(legacyFee, gasLimit, nil).Once()
+		estimator := gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, false, nil, geCfg)
+
+		fee, _, err := estimator.GetFee(ctx, nil, gasLimit, assets.NewWeiI(100), feetypes.UrgencyLow)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(5), fee.Legacy)
+	})
+
+	t.Run("GetFee caps the multiplied fee to the PriceMax of the tier", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetDynamicFee", mock.Anything, assets.NewWeiI(30)).Return(dynamicFee, nil).Once()
+		estimator := gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, true, nil, geCfg)
+
+		fee, _, err := estimator.GetFee(ctx, nil, gasLimit, assets.NewWeiI(100), feetypes.UrgencyHigh)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30), fee.DynamicFeeCap)
+		assert.Equal(t, assets.NewWeiI(4), fee.DynamicTipCap)
+		assert.Nil(t, fee.Legacy)
+	})
+
+	t.Run("GetFee keeps a key specific max fee price lower than the PriceMax of the tier", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, assets.NewWeiI(15)).Return(legacyFee, gasLimit, nil).Once()
+		estimator := gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, false, nil, geCfg)
+
+		fee, _, err := estimator.GetFee(ctx, nil, gasLimit, assets.NewWeiI(15), feetypes.UrgencyHigh)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(15), fee.Legacy)
+	})
+
+	t.Run("GetFee uses the chain defaults for an unconfigured tier", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, assets.NewWeiI(100)).Return(legacyFee, gasLimit, nil).Once()
+		estimator := gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, false, nil, geCfg)
+
+		fee, _, err := estimator.GetFee(ctx, nil, gasLimit, assets.NewWeiI(100), feetypes.UrgencyMedium)
+		require.NoError(t, err)
+		assert.Equal(t, legacyFee, fee.Legacy)
+	})
+
+	t.Run("BumpFee is capped to the PriceMax of the tier", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("BumpLegacyGas", mock.Anything, legacyFee, gasLimit, assets.NewWeiI(30), mock.Anything).Return(assets.NewWeiI(30), gasLimit, nil).Once()
+		estimator := gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, false, nil, geCfg)
+
+		fee, _, err := estimator.BumpFee(ctx, gas.EvmFee{Legacy: legacyFee}, gasLimit, assets.NewWeiI(100), feetypes.UrgencyHigh, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30), fee.Legacy)
+	})
+}
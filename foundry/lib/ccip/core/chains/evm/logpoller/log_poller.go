@@ -38,6 +38,7 @@ type LogPoller interface {
 	Healthy() error
 	Replay(ctx context.Context, fromBlock int64) error
 	ReplayAsync(fromBlock int64)
+	ReplayInProgress() bool
 	RegisterFilter(ctx context.Context, filter Filter) error
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
@@ -130,6 +131,8 @@ type logPoller struct {
 	// LogPoller keeps running in infinite loop, so whenever the invalid state is removed from the database it should
 	// recover automatically without needing to restart the LogPoller.
 	finalityViolated *atomic.Bool
+	// replaysInProgress counts the replays backfilling or polling the requested blocks.
+	replaysInProgress *atomic.Int32
 }
 
 type Opts struct {
@@ -174,6 +177,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, opts Opts) *logPoller
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 		finalityViolated:         new(atomic.Bool),
+		replaysInProgress:        new(atomic.Int32),
 	}
 }
 
@@ -421,7 +425,9 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) (err error) {
 		return err
 	}
 	if fromBlock <= savedFinalizedBlockNumber {
+		lp.replaysInProgress.Add(1)
 		err = lp.backfill(ctx, fromBlock, savedFinalizedBlockNumber)
+		lp.replaysInProgress.Add(-1)
 		if err != nil {
 			return err
 		}
@@ -474,6 +480,12 @@ func (lp *logPoller) recvReplayComplete() {
 	lp.wg.Done()
 }
 
+// ReplayInProgress returns true while a replay is backfilling or polling the requested blocks, logs of the replayed
+// blocks may be missing until it completes.
+func (lp *logPoller) ReplayInProgress() bool {
+	return lp.replaysInProgress.Load() > 0
+}
+
 // Asynchronous wrapper for Replay()
 func (lp *logPoller) ReplayAsync(fromBlock int64) {
 	lp.wg.Add(1)
@@ -659,6 +671,8 @@ func (lp *logPoller) backgroundWorkerRun() {
 }
 
 func (lp *logPoller) handleReplayRequest(fromBlockReq int64, filtersLoaded bool) {
+	lp.replaysInProgress.Add(1)
+	defer lp.replaysInProgress.Add(-1)
 	fromBlock, err := lp.GetReplayFromBlock(lp.ctx, fromBlockReq)
 	if err == nil {
 		if !filtersLoaded {
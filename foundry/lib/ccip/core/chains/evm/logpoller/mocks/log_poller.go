@@ -726,6 +726,24 @@ func (_m *LogPoller) ReplayAsync(fromBlock int64) {
 	_m.Called(fromBlock)
 }
 
+// ReplayInProgress provides a mock function with given fields:
+func (_m *LogPoller) ReplayInProgress() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayInProgress")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *LogPoller) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -19,9 +19,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -516,6 +518,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 			assert.Equal(t, txmgrcommon.TxFatalError, ethTx.State)
 			assert.True(t, ethTx.Error.Valid)
 			assert.Equal(t, "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }", ethTx.Error.String)
+			assert.Equal(t, null.StringFrom("oh no, it reverted"), ethTx.RevertReason)
 		})
 	})
 }
@@ -619,7 +622,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testi
 	chStartEstimate := make(chan struct{})
 	chBlock := make(chan struct{})
 
-	estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, ccfg.EVM().GasEstimator().PriceMaxKey(fromAddress)).Return(gas.EvmFee{Legacy: assets.GWei(32)}, uint64(500), nil).Run(func(_ mock.Arguments) {
+	estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, ccfg.EVM().GasEstimator().PriceMaxKey(fromAddress), mock.Anything).Return(gas.EvmFee{Legacy: assets.GWei(32)}, uint64(500), nil).Run(func(_ mock.Arguments) {
 		close(chStartEstimate)
 		<-chBlock
 	}).Once()
@@ -707,6 +710,177 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_PrivateRelay(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	relayed := make(chan string, 1)
+	relay := testutils.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		if assert.Equal(t, "eth_sendPrivateTransaction", method) && assert.True(t, params.IsArray()) {
+			relayed <- params.Array()[0].Get("tx").String()
+			resp.Result = `"0x0000000000000000000000000000000000000000000000000000000000000000"`
+		}
+		return
+	})
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PrivateRelay.Enabled = ptr(true)
+		c.EVM[0].Transactions.PrivateRelay.URL = commonconfig.MustParseURL(relay.WSURL().String())
+	})
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	// the public SendTransactionReturnCode must not be called
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	txRequest := txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          big.Int(assets.NewEthValue(242)),
+		FeeLimit:       1231,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}
+	etx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txRequest, &cltest.FixtureChainID)
+
+	retryable, err := eb.ProcessUnstartedTxs(testutils.Context(t), fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	etx, err = txStore.FindTxWithAttempts(testutils.Context(t), etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	require.Len(t, etx.TxAttempts, 1)
+	assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+
+	signedTx, err := txmgr.GetGethSignedTx(etx.TxAttempts[0].SignedRawTx)
+	require.NoError(t, err)
+	rawTx, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(rawTx), <-relayed)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_PrivateRelayFallback(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	relayCalls := make(chan struct{}, 3)
+	relay := testutils.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		relayCalls <- struct{}{}
+		resp.Error.Code = -32000
+		resp.Error.Message = "nonce too high"
+		return
+	})
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PrivateRelay.Enabled = ptr(true)
+		c.EVM[0].Transactions.PrivateRelay.URL = commonconfig.MustParseURL(relay.WSURL().String())
+		c.EVM[0].Transactions.PrivateRelay.FallbackAttempts = ptr[uint32](2)
+	})
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	etx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          big.Int(assets.NewEthValue(242)),
+		FeeLimit:       1231,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, &cltest.FixtureChainID)
+
+	// the relay keeps rejecting the tx, it stays in_progress until the fallback is reached
+	for i := 0; i < 2; i++ {
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.Error(t, err)
+		assert.True(t, retryable)
+		<-relayCalls
+	}
+
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+	assert.Empty(t, relayCalls)
+
+	etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_PrivateRelayFallback_UnknownError(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	relayCalls := make(chan struct{}, 3)
+	relay := testutils.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		relayCalls <- struct{}{}
+		resp.Error.Code = -32601
+		resp.Error.Message = fmt.Sprintf("the method %s does not exist/is not available", method)
+		return
+	})
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PrivateRelay.Enabled = ptr(true)
+		c.EVM[0].Transactions.PrivateRelay.URL = commonconfig.MustParseURL(relay.WSURL().String())
+		c.EVM[0].Transactions.PrivateRelay.FallbackAttempts = ptr[uint32](2)
+	})
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	etx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          big.Int(assets.NewEthValue(242)),
+		FeeLimit:       1231,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, &cltest.FixtureChainID)
+
+	// the relay error is not a chain error, the tx was not accepted since the nonce did not move
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Twice()
+	for i := 0; i < 2; i++ {
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.Error(t, err)
+		assert.True(t, retryable)
+		<-relayCalls
+	}
+
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0)
+	}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+	assert.Empty(t, relayCalls)
+
+	etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
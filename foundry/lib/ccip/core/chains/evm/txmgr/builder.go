@@ -55,7 +55,8 @@ func NewTxm(
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
 	evmConfirmer := NewEvmConfirmer(txStore, txmClient, txmCfg, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr)
 	var evmResender *Resender
-	if txConfig.ResendAfterThreshold() > 0 {
+	// txs sent through the private relay must not leak to the public mempool, the confirmer re-submits them instead
+	if txConfig.ResendAfterThreshold() > 0 && !txConfig.PrivateRelay().Enabled() {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
 	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker)
@@ -11,13 +11,16 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
@@ -26,6 +29,9 @@ var _ TxmClient = (*evmTxmClient)(nil)
 
 type evmTxmClient struct {
 	client client.Client
+
+	relaysMu sync.Mutex
+	relays   map[string]*rpc.Client // private relay clients by url
 }
 
 func NewEvmTxmClient(c client.Client) *evmTxmClient {
@@ -102,6 +108,78 @@ func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, at
 	return c.client.SendTransactionReturnCode(ctx, signedTx, etx.FromAddress)
 }
 
+// sendPrivateTransactionParams are the params of eth_sendPrivateTransaction
+type sendPrivateTransactionParams struct {
+	Tx hexutil.Bytes `json:"tx"`
+}
+
+// sendBundleParams are the params of eth_sendBundle
+type sendBundleParams struct {
+	Txs         []hexutil.Bytes `json:"txs"`
+	BlockNumber hexutil.Uint64  `json:"blockNumber"`
+}
+
+func (c *evmTxmClient) SendPrivateTransactionReturnCode(ctx context.Context, relay txmgrtypes.PrivateRelayConfig, etx Tx, attempt TxAttempt, lggr logger.SugaredLogger) (commonclient.SendTxReturnCode, error) {
+	signedTx, err := GetGethSignedTx(attempt.SignedRawTx)
+	if err != nil {
+		lggr.Criticalw("Fatal error signing transaction", "err", err, "etx", etx)
+		return commonclient.Fatal, err
+	}
+	rawTx, err := signedTx.MarshalBinary()
+	if err != nil {
+		lggr.Criticalw("Fatal error encoding transaction", "err", err, "etx", etx)
+		return commonclient.Fatal, err
+	}
+	relayClient, err := c.relayClient(ctx, relay)
+	if err != nil {
+		return commonclient.Retryable, err
+	}
+
+	var params interface{}
+	switch relay.Method() {
+	case toml.PrivateRelayMethodSendBundle:
+		// bundles are only valid for their target block, the confirmer re-submits them on every head
+		latest, err := c.client.LatestBlockHeight(ctx)
+		if err != nil {
+			return commonclient.Retryable, fmt.Errorf("failed to fetch the target block of the bundle: %w", err)
+		}
+		params = sendBundleParams{Txs: []hexutil.Bytes{rawTx}, BlockNumber: hexutil.Uint64(latest.Uint64() + 1)}
+	default:
+		params = sendPrivateTransactionParams{Tx: rawTx}
+	}
+
+	var result interface{}
+	err = relayClient.CallContext(ctx, &result, relay.Method(), params)
+	if err == nil {
+		return commonclient.Successful, nil
+	}
+	lggr.Warnw(fmt.Sprintf("Private relay rejected transaction %x", signedTx.Hash()), "err", err, "etx", etx)
+	// relays are fronts for block builders, their rejections are classified like the ones of the nodes
+	return client.ClassifySendError(err, lggr, signedTx, etx.FromAddress, c.client.IsL2()), err
+}
+
+// relayClient returns the rpc client of the relay, dialing it on first use
+func (c *evmTxmClient) relayClient(ctx context.Context, relay txmgrtypes.PrivateRelayConfig) (*rpc.Client, error) {
+	u := relay.URL()
+	if u == nil {
+		return nil, errors.New("private relay url is not set")
+	}
+	c.relaysMu.Lock()
+	defer c.relaysMu.Unlock()
+	if rc, ok := c.relays[u.String()]; ok {
+		return rc, nil
+	}
+	rc, err := rpc.DialContext(ctx, u.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial private relay %s: %w", u.Redacted(), err)
+	}
+	if c.relays == nil {
+		c.relays = make(map[string]*rpc.Client)
+	}
+	c.relays[u.String()] = rc
+	return rc, nil
+}
+
 func (c *evmTxmClient) PendingNonceAt(ctx context.Context, fromAddress common.Address) (n evmtypes.Nonce, err error) {
 	nextNonce, err := c.client.PendingNonceAt(ctx, fromAddress)
 	if err != nil {
@@ -6,8 +6,10 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/smartcontractkit/chainlink/v2/common/config"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 )
 
 // ChainConfig encompasses config used by txmgr package
@@ -33,6 +35,7 @@ type FeeConfig interface {
 	PriceMax() *assets.Wei
 	PriceMin() *assets.Wei
 	PriceMaxKey(gethcommon.Address) *assets.Wei
+	FeeTier(feetypes.Urgency) evmconfig.FeeTier
 }
 
 type DatabaseConfig interface {
@@ -78,3 +81,7 @@ func NewEvmTxmFeeConfig(c FeeConfig) *evmTxmFeeConfig {
 func (c evmTxmFeeConfig) MaxFeePrice() string { return c.PriceMax().String() }
 
 func (c evmTxmFeeConfig) FeePriceDefault() string { return c.PriceDefault().String() }
+
+func (c evmTxmFeeConfig) FeeTierBumpThreshold(urgency feetypes.Urgency) uint64 {
+	return c.FeeTier(urgency).BumpThreshold()
+}
@@ -19,12 +19,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -1621,6 +1624,88 @@ func TestEthConfirmer_FindTxsRequiringRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_FindTxsRequiringRebroadcast_FeeTiers(t *testing.T) {
+	t.Parallel()
+
+	currentHead := int64(30)
+
+	setup := func(t *testing.T, overrideFn func(c *chainlink.Config, s *chainlink.Secrets)) (*txmgr.Confirmer, evmconfig.ChainScopedConfig, gethCommon.Address, func(nonce int64, urgency feetypes.Urgency, broadcastBeforeBlockNum int64) txmgr.Tx) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewGeneralConfig(t, overrideFn)
+		txStore := cltest.NewTestTxStore(t, db)
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		ec := newEthConfirmer(t, txStore, ethClient, evmcfg, ethKeyStore, nil)
+
+		mustInsertUnconfirmedTx := func(nonce int64, urgency feetypes.Urgency, broadcastBeforeBlockNum int64) txmgr.Tx {
+			etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, nonce, fromAddress)
+			_, err := db.Exec(`UPDATE evm.tx_attempts SET broadcast_before_block_num=$1 WHERE eth_tx_id=$2`, broadcastBeforeBlockNum, etx.ID)
+			require.NoError(t, err)
+			if urgency != "" {
+				_, err = db.Exec(`UPDATE evm.txes SET urgency=$1 WHERE id=$2`, urgency, etx.ID)
+				require.NoError(t, err)
+			}
+			return etx
+		}
+		return ec, evmcfg, fromAddress, mustInsertUnconfirmedTx
+	}
+
+	t.Run("tier thresholds lower than the default one", func(t *testing.T) {
+		ec, evmcfg, fromAddress, mustInsertUnconfirmedTx := setup(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].GasEstimator.FeeTiers.Low.BumpThreshold = ptr[uint32](0)
+			c.EVM[0].GasEstimator.FeeTiers.High.BumpThreshold = ptr[uint32](2)
+		})
+		gasBumpThreshold := int64(10)
+
+		// bumped with the default threshold
+		etx0 := mustInsertUnconfirmedTx(0, "", 20)
+		// not bumped yet with the default threshold
+		mustInsertUnconfirmedTx(1, "", 25)
+		// bumped with the threshold of the High tier
+		etx2 := mustInsertUnconfirmedTx(2, feetypes.UrgencyHigh, 28)
+		// not bumped yet with the threshold of the High tier
+		mustInsertUnconfirmedTx(3, feetypes.UrgencyHigh, 29)
+		// never bumped with the Low tier
+		mustInsertUnconfirmedTx(4, feetypes.UrgencyLow, 1)
+		// bumped with the Medium tier, which falls back to the chain BumpThreshold
+		etx5 := mustInsertUnconfirmedTx(5, feetypes.UrgencyMedium, currentHead-int64(evmcfg.EVM().GasEstimator().BumpThreshold()))
+
+		etxs, err := ec.FindTxsRequiringRebroadcast(testutils.Context(t), logger.Test(t), fromAddress, currentHead, gasBumpThreshold, 0, 0, &cltest.FixtureChainID)
+		require.NoError(t, err)
+
+		require.Len(t, etxs, 3)
+		assert.Equal(t, etx0.ID, etxs[0].ID)
+		assert.Equal(t, etx2.ID, etxs[1].ID)
+		assert.Equal(t, feetypes.UrgencyHigh, etxs[1].Urgency)
+		assert.Equal(t, etx5.ID, etxs[2].ID)
+	})
+
+	t.Run("tier threshold higher than the default one", func(t *testing.T) {
+		ec, evmcfg, fromAddress, mustInsertUnconfirmedTx := setup(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].GasEstimator.FeeTiers.Low.BumpThreshold = ptr[uint32](20)
+		})
+		// The default threshold is the lowest one, so it is the one queried
+		gasBumpThreshold := int64(evmcfg.EVM().GasEstimator().BumpThreshold())
+		require.Less(t, gasBumpThreshold, int64(20))
+
+		// bumped with the default threshold
+		etx0 := mustInsertUnconfirmedTx(0, "", currentHead-gasBumpThreshold)
+		// not bumped yet with the threshold of the Low tier
+		mustInsertUnconfirmedTx(1, feetypes.UrgencyLow, currentHead-gasBumpThreshold)
+		// bumped with the threshold of the Low tier
+		etx2 := mustInsertUnconfirmedTx(2, feetypes.UrgencyLow, currentHead-20)
+
+		etxs, err := ec.FindTxsRequiringRebroadcast(testutils.Context(t), logger.Test(t), fromAddress, currentHead, gasBumpThreshold, 0, 0, &cltest.FixtureChainID)
+		require.NoError(t, err)
+
+		require.Len(t, etxs, 2)
+		assert.Equal(t, etx0.ID, etxs[0].ID)
+		assert.Equal(t, etx2.ID, etxs[1].ID)
+	})
+}
+
 func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing.T) {
 	t.Parallel()
 	lggr := logger.Test(t)
@@ -2517,6 +2602,56 @@ func TestEthConfirmer_RebroadcastWhereNecessary_TerminallyUnderpriced_ThenGoesTh
 	})
 }
 
+func TestEthConfirmer_ResubmitPrivateTransactions(t *testing.T) {
+	t.Parallel()
+	db := pgtest.NewSqlxDB(t)
+	relayed := make(chan string, 1)
+	relay := testutils.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		if assert.Equal(t, "eth_sendPrivateTransaction", method) && assert.True(t, params.IsArray()) {
+			relayed <- params.Array()[0].Get("tx").String()
+			resp.Result = `"0x0000000000000000000000000000000000000000000000000000000000000000"`
+		}
+		return
+	})
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PrivateRelay.Enabled = ptr(true)
+		c.EVM[0].Transactions.PrivateRelay.URL = commonconfig.MustParseURL(relay.WSURL().String())
+		c.EVM[0].Transactions.PrivateRelay.FallbackBlocks = ptr[uint32](10)
+	})
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+
+	// the public SendTransactionReturnCode must only be called past the fallback
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ec := newEthConfirmer(t, txStore, ethClient, evmtest.NewChainScopedConfig(t, cfg), ethKeyStore, nil)
+
+	firstBroadcast := int64(20)
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+	attempt := etx.TxAttempts[0]
+	var dbAttempt txmgr.DbEthTxAttempt
+	require.NoError(t, db.Get(&dbAttempt, `UPDATE evm.tx_attempts SET broadcast_before_block_num=$1 WHERE id=$2 RETURNING *`, firstBroadcast, attempt.ID))
+	signedTx, err := txmgr.GetGethSignedTx(attempt.SignedRawTx)
+	require.NoError(t, err)
+	rawTx, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+
+	t.Run("re-submits to the private relay within the fallback window", func(t *testing.T) {
+		require.NoError(t, ec.ResubmitPrivateTransactions(ctx, firstBroadcast+9))
+		assert.Equal(t, hexutil.Encode(rawTx), <-relayed)
+	})
+
+	t.Run("sends publicly past the fallback window", func(t *testing.T) {
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Hash() == signedTx.Hash()
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		require.NoError(t, ec.ResubmitPrivateTransactions(ctx, firstBroadcast+10))
+		assert.Empty(t, relayed)
+	})
+}
+
 func TestEthConfirmer_RebroadcastWhereNecessary_WhenOutOfEth(t *testing.T) {
 	t.Parallel()
 	db := pgtest.NewSqlxDB(t)
@@ -3104,8 +3239,54 @@ func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 		}
 	})
 
-	t.Run("does not mark callback complete if callback fails", func(t *testing.T) {
+	t.Run("processes cancelled eth_txes with an error", func(t *testing.T) {
+		type data struct {
+			value any
+			error
+		}
+		ch := make(chan data)
 		nonce := evmtypes.Nonce(5)
+		ec := newEthConfirmer(t, txStore, ethClient, evmcfg, ethKeyStore, func(id uuid.UUID, value interface{}, err error) error {
+			ch <- data{value, err}
+			return nil
+		})
+
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'suspended' WHERE id = $1`, run.ID)
+
+		// the receipt is the one of the self transfer that replaced the tx
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, int64(nonce), 1, fromAddress)
+		mustInsertEthReceipt(t, txStore, head.Number-minConfirmations, head.Hash, etx.TxAttempts[0].Hash)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET meta = '{"Cancelled": true}', pipeline_task_run_id = $1, min_confirmations = $2, signal_callback = TRUE WHERE id = $3`, &tr.ID, minConfirmations, etx.ID)
+
+		done := make(chan struct{})
+		t.Cleanup(func() { <-done })
+		go func() {
+			defer close(done)
+			err2 := ec.ResumePendingTaskRuns(testutils.Context(t), &head)
+			if !assert.NoError(t, err2) {
+				return
+			}
+			// Retrieve Tx to check if callback completed flag was set to true
+			updateTx, err3 := txStore.FindTxWithSequence(testutils.Context(t), fromAddress, nonce)
+			if assert.NoError(t, err3) {
+				assert.Equal(t, true, updateTx.CallbackCompleted)
+			}
+		}()
+
+		select {
+		case data := <-ch:
+			assert.ErrorIs(t, data.error, txmgrcommon.ErrTxCancelled)
+			assert.Nil(t, data.value)
+
+		case <-testutils.AfterWaitTimeout(t):
+			t.Fatal("no value received")
+		}
+	})
+
+	t.Run("does not mark callback complete if callback fails", func(t *testing.T) {
+		nonce := evmtypes.Nonce(6)
 		ec := newEthConfirmer(t, txStore, ethClient, evmcfg, ethKeyStore, func(uuid.UUID, interface{}, error) error {
 			return errors.New("error")
 		})
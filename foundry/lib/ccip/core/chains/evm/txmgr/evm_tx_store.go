@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -54,6 +55,7 @@ type TxStoreWebApi interface {
 	Transactions(ctx context.Context, offset, limit int) ([]Tx, int, error)
 	TxAttempts(ctx context.Context, offset, limit int) ([]TxAttempt, int, error)
 	TransactionsWithAttempts(ctx context.Context, offset, limit int) ([]Tx, int, error)
+	DroppedTransactions(ctx context.Context, offset, limit int) ([]Tx, int, error)
 	FindTxAttempt(ctx context.Context, hash common.Hash) (*TxAttempt, error)
 	FindTxWithAttempts(ctx context.Context, etxID int64) (etx Tx, err error)
 }
@@ -130,6 +132,7 @@ type dbReceiptPlus struct {
 	ID           uuid.UUID        `db:"pipeline_task_run_id"`
 	Receipt      evmtypes.Receipt `db:"receipt"`
 	FailOnRevert bool             `db:"FailOnRevert"`
+	Cancelled    bool             `db:"Cancelled"`
 }
 
 func fromDBReceipts(rs []dbReceipt) []*evmtypes.Receipt {
@@ -147,6 +150,7 @@ func fromDBReceiptsPlus(rs []dbReceiptPlus) []ReceiptPlus {
 			ID:           rs[i].ID,
 			Receipt:      &rs[i].Receipt,
 			FailOnRevert: rs[i].FailOnRevert,
+			Cancelled:    rs[i].Cancelled,
 		}
 	}
 	return receipts
@@ -196,6 +200,10 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	// Urgency of the fee tier, NULL when the tx is untiered
+	Urgency nullv4.String
+	// RevertReason of a tx dropped by the simulation transmit checker
+	RevertReason nullv4.String
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -207,6 +215,7 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.Value = assets.Eth(tx.Value)
 	db.GasLimit = tx.FeeLimit
 	db.Error = tx.Error
+	db.RevertReason = tx.RevertReason
 	db.BroadcastAt = tx.BroadcastAt
 	db.CreatedAt = tx.CreatedAt
 	db.State = tx.State
@@ -218,6 +227,7 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Urgency = nullv4.NewString(string(tx.Urgency), tx.Urgency != "")
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -241,6 +251,7 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.Value = *db.Value.ToInt()
 	tx.FeeLimit = db.GasLimit
 	tx.Error = db.Error
+	tx.RevertReason = db.RevertReason
 	tx.BroadcastAt = db.BroadcastAt
 	tx.CreatedAt = db.CreatedAt
 	tx.State = db.State
@@ -253,6 +264,7 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Urgency = feetypes.Urgency(db.Urgency.ValueOrZero())
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -465,6 +477,23 @@ func (o *evmTxStore) TransactionsWithAttempts(ctx context.Context, offset, limit
 	return
 }
 
+// DroppedTransactions returns the eth transactions dropped before broadcast because they reverted during simulation,
+// sorted by id descending
+func (o *evmTxStore) DroppedTransactions(ctx context.Context, offset, limit int) (txs []Tx, count int, err error) {
+	sql := `SELECT count(*) FROM evm.txes WHERE state = 'fatal_error' AND revert_reason IS NOT NULL`
+	if err = o.q.GetContext(ctx, &count, sql); err != nil {
+		return
+	}
+
+	sql = `SELECT * FROM evm.txes WHERE state = 'fatal_error' AND revert_reason IS NOT NULL ORDER BY id desc LIMIT $1 OFFSET $2`
+	var dbTxs []DbEthTx
+	if err = o.q.SelectContext(ctx, &dbTxs, sql, limit, offset); err != nil {
+		return
+	}
+	txs = dbEthTxsToEvmEthTxs(dbTxs)
+	return
+}
+
 // TxAttempts returns the last tx attempts sorted by created_at descending.
 func (o *evmTxStore) TxAttempts(ctx context.Context, offset, limit int) (txs []TxAttempt, count int, err error) {
 	sql := `SELECT count(*) FROM evm.tx_attempts`
@@ -507,6 +536,16 @@ func (o *evmTxStore) FindTxAttemptsByTxIDs(ctx context.Context, ids []int64) ([]
 	return dbEthTxAttemptsToEthTxAttempts(dbTxAttempts), nil
 }
 
+// FindReceiptByTxHash returns the receipt of the attempt with the given hash, the one of the latest block if the
+// attempt was included in several blocks because of reorgs, or sql.ErrNoRows.
+func (o *evmTxStore) FindReceiptByTxHash(ctx context.Context, hash common.Hash) (*evmtypes.Receipt, error) {
+	var r dbReceipt
+	if err := o.q.GetContext(ctx, &r, `SELECT * FROM evm.receipts WHERE tx_hash = $1 ORDER BY block_number DESC LIMIT 1`, hash); err != nil {
+		return nil, err
+	}
+	return DbReceiptToEvmReceipt(&r), nil
+}
+
 func (o *evmTxStore) FindTxByHash(ctx context.Context, hash common.Hash) (*Tx, error) {
 	var dbEtx DbEthTx
 	err := o.Transaction(ctx, true, func(orm *evmTxStore) error {
@@ -527,8 +566,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, urgency, revert_reason) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :urgency, :revert_reason
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -988,7 +1027,8 @@ func (o *evmTxStore) FindTxesPendingCallback(ctx context.Context, blockNum int64
 	ctx, cancel = o.mergeContexts(ctx)
 	defer cancel()
 	err = o.q.SelectContext(ctx, &rs, `
-	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert" FROM evm.txes
+	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert",
+	COALESCE((evm.txes.meta->>'Cancelled')::boolean, false) "Cancelled" FROM evm.txes
 	INNER JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id
 	INNER JOIN evm.receipts ON evm.tx_attempts.hash = evm.receipts.tx_hash
 	WHERE evm.txes.pipeline_task_run_id IS NOT NULL AND evm.txes.signal_callback = TRUE AND evm.txes.callback_completed = FALSE
@@ -1561,12 +1601,70 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 		}
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, revert_reason=$3, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$4 RETURNING *`, etx.State, etx.Error, etx.RevertReason, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 		dbEtx.ToTx(etx)
 		return err
 	})
 }
 
+// UpdateTxUnstartedPayload replaces the destination, value, payload and gas limit of an eth tx that has yet to be broadcast
+func (o *evmTxStore) UpdateTxUnstartedPayload(ctx context.Context, etx *Tx) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	if etx.State != txmgr.TxUnstarted {
+		return pkgerrors.Errorf("can only update payload of unstarted transaction, transaction is currently %s", etx.State)
+	}
+	var dbEtx DbEthTx
+	dbEtx.FromTx(etx)
+	err := o.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET to_address=$1, value=$2, encoded_payload=$3, gas_limit=$4 WHERE id=$5 AND state='unstarted' RETURNING *`, dbEtx.ToAddress, dbEtx.Value, dbEtx.EncodedPayload, dbEtx.GasLimit, dbEtx.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgerrors.Errorf("UpdateTxUnstartedPayload failed: transaction %d is no longer unstarted", etx.ID)
+	}
+	if err != nil {
+		return pkgerrors.Wrap(err, "UpdateTxUnstartedPayload failed to update eth_tx")
+	}
+	dbEtx.ToTx(etx)
+	return nil
+}
+
+// UpdateTxUnconfirmedForReplacement replaces the destination, value, payload, gas limit and meta of an unconfirmed eth tx and inserts
+// the in_progress attempt that replaces its broadcast attempts at the same nonce
+func (o *evmTxStore) UpdateTxUnconfirmedForReplacement(ctx context.Context, etx *Tx, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	if etx.State != txmgr.TxUnconfirmed {
+		return pkgerrors.Errorf("can only replace unconfirmed transaction, transaction is currently %s", etx.State)
+	}
+	if attempt.State != txmgrtypes.TxAttemptInProgress {
+		return errors.New("attempt state must be in_progress")
+	}
+	return o.Transaction(ctx, false, func(orm *evmTxStore) error {
+		var dbEtx DbEthTx
+		dbEtx.FromTx(etx)
+		err := orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET to_address=$1, value=$2, encoded_payload=$3, gas_limit=$4, meta=$5 WHERE id=$6 AND state='unconfirmed' RETURNING *`, dbEtx.ToAddress, dbEtx.Value, dbEtx.EncodedPayload, dbEtx.GasLimit, dbEtx.Meta, dbEtx.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkgerrors.Errorf("UpdateTxUnconfirmedForReplacement failed: transaction %d is no longer unconfirmed", etx.ID)
+		}
+		if err != nil {
+			return pkgerrors.Wrap(err, "UpdateTxUnconfirmedForReplacement failed to update eth_tx")
+		}
+		dbEtx.ToTx(etx)
+		var dbAttempt DbEthTxAttempt
+		dbAttempt.FromTxAttempt(attempt)
+		query, args, err := orm.q.BindNamed(insertIntoEthTxAttemptsQuery, &dbAttempt)
+		if err != nil {
+			return pkgerrors.Wrap(err, "UpdateTxUnconfirmedForReplacement failed to BindNamed")
+		}
+		if err = orm.q.GetContext(ctx, &dbAttempt, query, args...); err != nil {
+			return pkgerrors.Wrap(err, "UpdateTxUnconfirmedForReplacement failed to insert replacement attempt")
+		}
+		dbAttempt.ToTxAttempt(attempt)
+		return nil
+	})
+}
+
 // Updates eth attempt from in_progress to broadcast. Also updates the eth tx to unconfirmed.
 func (o *evmTxStore) UpdateTxAttemptInProgressToBroadcast(ctx context.Context, etx *Tx, attempt TxAttempt, NewAttemptState txmgrtypes.TxAttemptState) error {
 	var cancel context.CancelFunc
@@ -1788,12 +1886,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, urgency)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, nullv4.NewString(string(txRequest.Urgency), txRequest.Urgency != ""))
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
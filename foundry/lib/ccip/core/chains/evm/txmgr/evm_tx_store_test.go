@@ -235,6 +235,35 @@ func TestORM_FindTxAttemptConfirmedByTxIDs(t *testing.T) {
 	assert.Equal(t, confirmedAttempts[0].Hash, attempt.Hash, "confirmed Recieipt Hash should match the attempt hash")
 }
 
+func TestORM_FindReceiptByTxHash(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	ctx := testutils.Context(t)
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+	hash := etx.TxAttempts[0].Hash
+
+	t.Run("returns sql.ErrNoRows without receipt", func(t *testing.T) {
+		_, err := txStore.FindReceiptByTxHash(ctx, hash)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("returns the receipt of the latest block", func(t *testing.T) {
+		mustInsertEthReceipt(t, txStore, 2, utils.NewHash(), hash)
+		latest := mustInsertEthReceipt(t, txStore, 3, utils.NewHash(), hash)
+
+		receipt, err := txStore.FindReceiptByTxHash(ctx, hash)
+		require.NoError(t, err)
+		assert.Equal(t, latest.BlockHash, receipt.BlockHash)
+		assert.Equal(t, hash, receipt.TxHash)
+	})
+}
+
 func TestORM_FindTxAttemptsRequiringResend(t *testing.T) {
 	t.Parallel()
 
@@ -695,11 +724,23 @@ func TestORM_FindTxesPendingCallback(t *testing.T) {
 	etx5 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 7, 1, fromAddress)
 	pgtest.MustExec(t, db, `UPDATE evm.txes SET min_confirmations = $1 WHERE id = $2`, minConfirmations, etx5.ID)
 
+	// Suspended run of a cancelled tx. Should be flagged as cancelled
+	run6 := cltest.MustInsertPipelineRun(t, db)
+	tr6 := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run6.ID)
+	pgtest.MustExec(t, db, `UPDATE pipeline_runs SET state = 'suspended' WHERE id = $1`, run6.ID)
+	etx6 := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 8, 1, fromAddress)
+	attempt6 := etx6.TxAttempts[0]
+	mustInsertEthReceipt(t, txStore, head.Number-minConfirmations, head.Hash, attempt6.Hash)
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET meta = '{"Cancelled": true}', pipeline_task_run_id = $1, min_confirmations = $2, signal_callback = TRUE WHERE id = $3`, &tr6.ID, minConfirmations, etx6.ID)
+
 	// Search evm.txes table for tx requiring callback
 	receiptsPlus, err := txStore.FindTxesPendingCallback(testutils.Context(t), head.Number, ethClient.ConfiguredChainID())
 	require.NoError(t, err)
-	assert.Len(t, receiptsPlus, 1)
-	assert.Equal(t, tr1.ID, receiptsPlus[0].ID)
+	require.Len(t, receiptsPlus, 2)
+	assert.ElementsMatch(t, []uuid.UUID{tr1.ID, tr6.ID}, []uuid.UUID{receiptsPlus[0].ID, receiptsPlus[1].ID})
+	for _, receiptPlus := range receiptsPlus {
+		assert.Equal(t, receiptPlus.ID == tr6.ID, receiptPlus.Cancelled)
+	}
 }
 
 func Test_FindTxWithIdempotencyKey(t *testing.T) {
@@ -0,0 +1,170 @@
+package txmgr
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	pkgerrors "github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/ping_pong_demo"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/keystone/generated/forwarder"
+)
+
+const (
+	// RevertReasonUnknown names reverts whose data doesn't match any registered error.
+	RevertReasonUnknown = "Unknown"
+	// RevertReasonError names reverts with a require/revert message, i.e. Error(string).
+	RevertReasonError = "Error"
+	// RevertReasonPanic names reverts caused by a failed assertion or arithmetic error, i.e. Panic(uint256).
+	RevertReasonPanic = "Panic"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// DefaultRevertDecoder decodes the custom errors of the contracts the node transmits to.
+	DefaultRevertDecoder = newDefaultRevertDecoder()
+)
+
+func newDefaultRevertDecoder() *RevertDecoder {
+	d := NewRevertDecoder()
+	// Registration order settles selector clashes, e.g. of the shared ownership errors.
+	for _, c := range []struct{ name, abi string }{
+		{"EVM2EVMOffRamp", evm_2_evm_offramp.EVM2EVMOffRampABI},
+		{"CommitStore", commit_store.CommitStoreABI},
+		{"KeystoneForwarder", forwarder.KeystoneForwarderABI},
+		{"Router", router.RouterABI},
+		// the only custom error of the ping pong demo is the one it inherits from CCIPReceiver
+		{"CCIPReceiver", ping_pong_demo.PingPongDemoABI},
+	} {
+		if err := d.RegisterABI(c.name, c.abi); err != nil {
+			panic(err)
+		}
+	}
+	return d
+}
+
+// RevertReason is the decoded reason of a reverted call.
+type RevertReason struct {
+	// Name identifies the error, e.g. "EVM2EVMOffRamp.ExecutionError". It has a bounded set of values and is safe to
+	// use as a metric label.
+	Name string
+	// Reason is the error with its decoded arguments, e.g. "EVM2EVMOffRamp.ExecutionError(CCIPReceiver.InvalidRouter(0x...))".
+	Reason string
+}
+
+func (r RevertReason) String() string {
+	return r.Reason
+}
+
+type contractError struct {
+	contract string
+	abi.Error
+}
+
+// RevertDecoder decodes revert data against the custom errors of registered contract ABIs.
+type RevertDecoder struct {
+	errors map[[4]byte]contractError
+}
+
+// NewRevertDecoder returns a RevertDecoder without any registered ABI, it only decodes Error(string) and Panic(uint256).
+func NewRevertDecoder() *RevertDecoder {
+	return &RevertDecoder{errors: make(map[[4]byte]contractError)}
+}
+
+// RegisterABI adds the custom errors declared in the abiJSON of contract to the decoder.
+// Errors sharing a selector with an already registered one are decoded as the latter, the contract name aside.
+func (d *RevertDecoder) RegisterABI(contract, abiJSON string) error {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to parse %s ABI", contract)
+	}
+	for _, e := range parsed.Errors {
+		var selector [4]byte
+		copy(selector[:], e.ID[:4])
+		if _, exists := d.errors[selector]; exists {
+			continue
+		}
+		d.errors[selector] = contractError{contract: contract, Error: e}
+	}
+	return nil
+}
+
+// DecodeRPCError decodes the revert data of a call rejected with jErr.
+// The reason falls back to the message of jErr when the data can't be decoded.
+func (d *RevertDecoder) DecodeRPCError(jErr *evmclient.JsonError) RevertReason {
+	data, _ := jErr.Data.(string)
+	// Some RPCs (e.g. parity) prefix the data.
+	b, err := hexutil.Decode(strings.TrimPrefix(data, "Reverted "))
+	if err != nil || len(b) < 4 {
+		return RevertReason{Name: RevertReasonUnknown, Reason: evmclient.RevertReason(jErr)}
+	}
+	r := d.Decode(b)
+	if r.Name == RevertReasonUnknown {
+		r.Reason = evmclient.RevertReason(jErr)
+	}
+	return r
+}
+
+// Decode decodes the revert data b. Errors wrapping the revert data of a nested call as their single bytes argument,
+// e.g. the ExecutionError of the offramp, are decoded recursively.
+func (d *RevertDecoder) Decode(b []byte) RevertReason {
+	if len(b) < 4 {
+		return RevertReason{Name: RevertReasonUnknown, Reason: hexutil.Encode(b)}
+	}
+	switch {
+	case bytes.Equal(b[:4], errorSelector), bytes.Equal(b[:4], panicSelector):
+		name := RevertReasonError
+		if bytes.Equal(b[:4], panicSelector) {
+			name = RevertReasonPanic
+		}
+		reason, err := abi.UnpackRevert(b)
+		if err != nil {
+			return RevertReason{Name: RevertReasonUnknown, Reason: hexutil.Encode(b)}
+		}
+		return RevertReason{Name: name, Reason: fmt.Sprintf("%s(%s)", name, reason)}
+	}
+
+	var selector [4]byte
+	copy(selector[:], b[:4])
+	e, ok := d.errors[selector]
+	if !ok {
+		return RevertReason{Name: RevertReasonUnknown, Reason: hexutil.Encode(b)}
+	}
+	name := e.contract + "." + e.Name
+	args, err := e.Inputs.Unpack(b[4:])
+	if err != nil {
+		return RevertReason{Name: name, Reason: fmt.Sprintf("%s(%s)", name, hexutil.Encode(b[4:]))}
+	}
+
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = d.formatArg(arg, len(args) == 1)
+	}
+	return RevertReason{Name: name, Reason: fmt.Sprintf("%s(%s)", name, strings.Join(formatted, ", "))}
+}
+
+func (d *RevertDecoder) formatArg(arg interface{}, nested bool) string {
+	switch a := arg.(type) {
+	case []byte:
+		if nested && len(a) >= 4 {
+			return d.Decode(a).Reason
+		}
+		return hexutil.Encode(a)
+	case [32]byte:
+		return hexutil.Encode(a[:])
+	case string:
+		return fmt.Sprintf("%q", a)
+	default:
+		return fmt.Sprint(a)
+	}
+}
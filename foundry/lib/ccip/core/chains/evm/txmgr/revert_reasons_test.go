@@ -0,0 +1,96 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+func encodeRevert(t *testing.T, sig string, types []string, args ...interface{}) []byte {
+	var arguments abi.Arguments
+	for _, typ := range types {
+		abiType, err := abi.NewType(typ, "", nil)
+		require.NoError(t, err)
+		arguments = append(arguments, abi.Argument{Type: abiType})
+	}
+	packed, err := arguments.Pack(args...)
+	require.NoError(t, err)
+	return append(crypto.Keccak256([]byte(sig))[:4], packed...)
+}
+
+func TestRevertDecoder(t *testing.T) {
+	t.Parallel()
+
+	d := txmgr.DefaultRevertDecoder
+	router := common.HexToAddress("0xff0Aac13eab788cb9a2D662D3FB661Aa5f58FA21")
+	invalidRouter := encodeRevert(t, "InvalidRouter(address)", []string{"address"}, router)
+
+	t.Run("custom error", func(t *testing.T) {
+		r := d.Decode(invalidRouter)
+		assert.Equal(t, "CCIPReceiver.InvalidRouter", r.Name)
+		assert.Equal(t, "CCIPReceiver.InvalidRouter("+router.Hex()+")", r.Reason)
+	})
+
+	t.Run("router error", func(t *testing.T) {
+		r := d.Decode(encodeRevert(t, "UnsupportedDestinationChain(uint64)", []string{"uint64"}, uint64(42)))
+		assert.Equal(t, "Router.UnsupportedDestinationChain", r.Name)
+		assert.Equal(t, "Router.UnsupportedDestinationChain(42)", r.Reason)
+	})
+
+	t.Run("nested custom error", func(t *testing.T) {
+		r := d.Decode(encodeRevert(t, "ExecutionError(bytes)", []string{"bytes"}, invalidRouter))
+		assert.Equal(t, "EVM2EVMOffRamp.ExecutionError", r.Name)
+		assert.Equal(t, "EVM2EVMOffRamp.ExecutionError(CCIPReceiver.InvalidRouter("+router.Hex()+"))", r.Reason)
+	})
+
+	t.Run("error string", func(t *testing.T) {
+		r := d.Decode(encodeRevert(t, "Error(string)", []string{"string"}, "not enough funds"))
+		assert.Equal(t, txmgr.RevertReasonError, r.Name)
+		assert.Equal(t, "Error(not enough funds)", r.Reason)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		r := d.Decode(hexutil.MustDecode("0x4e487b710000000000000000000000000000000000000000000000000000000000000011"))
+		assert.Equal(t, txmgr.RevertReasonPanic, r.Name)
+		assert.Equal(t, "Panic(arithmetic underflow or overflow)", r.Reason)
+	})
+
+	t.Run("unknown error", func(t *testing.T) {
+		r := d.Decode([]byte{1, 2, 3, 4, 5})
+		assert.Equal(t, txmgr.RevertReasonUnknown, r.Name)
+		assert.Equal(t, "0x0102030405", r.Reason)
+	})
+
+	t.Run("unregistered ABI", func(t *testing.T) {
+		r := txmgr.NewRevertDecoder().Decode(invalidRouter)
+		assert.Equal(t, txmgr.RevertReasonUnknown, r.Name)
+	})
+
+	t.Run("registered ABI", func(t *testing.T) {
+		decoder := txmgr.NewRevertDecoder()
+		require.Error(t, decoder.RegisterABI("Broken", "not an ABI"))
+		require.NoError(t, decoder.RegisterABI("Receiver", `[{"type":"error","name":"InvalidRouter","inputs":[{"name":"router","type":"address"}]}]`))
+		assert.Equal(t, "Receiver.InvalidRouter", decoder.Decode(invalidRouter).Name)
+	})
+
+	t.Run("rpc error", func(t *testing.T) {
+		r := d.DecodeRPCError(&evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "Reverted " + hexutil.Encode(invalidRouter)})
+		assert.Equal(t, "CCIPReceiver.InvalidRouter", r.Name)
+
+		r = d.DecodeRPCError(&evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x01020304"})
+		assert.Equal(t, txmgr.RevertReasonUnknown, r.Name)
+		assert.Equal(t, "execution reverted: 0x01020304", r.Reason)
+
+		r = d.DecodeRPCError(&evmclient.JsonError{Code: 42, Message: "oh no, it reverted", Data: []byte{42, 166, 34}})
+		assert.Equal(t, txmgr.RevertReasonUnknown, r.Name)
+		assert.Equal(t, "oh no, it reverted", r.Reason)
+	})
+}
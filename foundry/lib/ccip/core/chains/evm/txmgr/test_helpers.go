@@ -1,12 +1,15 @@
 package txmgr
 
 import (
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
@@ -66,6 +69,14 @@ func (g *TestGasEstimatorConfig) BlockHistory() evmconfig.BlockHistory {
 	return &TestBlockHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
+	return &TestFeeHistoryConfig{}
+}
+
+func (g *TestGasEstimatorConfig) FeeTier(feetypes.Urgency) evmconfig.FeeTier {
+	return &TestFeeTierConfig{bumpThreshold: g.bumpThreshold}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -113,6 +124,23 @@ func (b *TestBlockHistoryConfig) BlockHistorySize() uint16          { return 42
 func (b *TestBlockHistoryConfig) EIP1559FeeCapBufferBlocks() uint16 { return 42 }
 func (b *TestBlockHistoryConfig) TransactionPercentile() uint16     { return 42 }
 
+type TestFeeHistoryConfig struct {
+	evmconfig.FeeHistory
+}
+
+func (f *TestFeeHistoryConfig) BlockHistorySize() uint16                 { return 42 }
+func (f *TestFeeHistoryConfig) PredictionBlocks() uint16                 { return 42 }
+func (f *TestFeeHistoryConfig) Urgency() feetypes.Urgency                { return feetypes.UrgencyMedium }
+func (f *TestFeeHistoryConfig) RewardPercentile(feetypes.Urgency) uint16 { return 42 }
+
+type TestFeeTierConfig struct {
+	bumpThreshold uint64
+}
+
+func (t *TestFeeTierConfig) PriceMultiplier() float32 { return 1 }
+func (t *TestFeeTierConfig) BumpThreshold() uint64    { return t.bumpThreshold }
+func (t *TestFeeTierConfig) PriceMax() *assets.Wei    { return assets.NewWeiI(42) }
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e *TestEvmConfig
@@ -124,6 +152,17 @@ func (t *transactionsConfig) MaxQueued() uint64                   { return t.e.M
 func (t *transactionsConfig) ReaperInterval() time.Duration       { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration      { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration { return t.e.ResendAfterThreshold }
+func (*transactionsConfig) PrivateRelay() txmgrtypes.PrivateRelayConfig {
+	return &TestPrivateRelayConfig{}
+}
+
+type TestPrivateRelayConfig struct{}
+
+func (*TestPrivateRelayConfig) Enabled() bool            { return false }
+func (*TestPrivateRelayConfig) URL() *url.URL            { return nil }
+func (*TestPrivateRelayConfig) Method() string           { return "" }
+func (*TestPrivateRelayConfig) FallbackBlocks() uint32   { return 0 }
+func (*TestPrivateRelayConfig) FallbackAttempts() uint32 { return 0 }
 
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
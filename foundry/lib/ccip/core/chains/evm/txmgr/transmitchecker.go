@@ -10,6 +10,8 @@ import (
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	bigmath "github.com/smartcontractkit/chainlink-common/pkg/utils/big_math"
@@ -31,6 +33,13 @@ type (
 	TransmitCheckerSpec = txmgrtypes.TransmitCheckerSpec[common.Address]
 )
 
+var (
+	promSimulationRevertCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_simulation_reverted_transactions",
+		Help: "Number of transactions dropped before broadcast because they reverted during simulation, by decoded revert reason",
+	}, []string{"chainID", "reason"})
+)
+
 var (
 	// NoChecker is a TransmitChecker that always determines a transaction should be submitted.
 	NoChecker TransmitChecker = noChecker{}
@@ -50,7 +59,7 @@ type CheckerFactory struct {
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		return &SimulateChecker{Client: c.Client}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -117,9 +126,12 @@ func (noChecker) Check(
 	return nil
 }
 
-// SimulateChecker simulates transactions, producing an error if they revert on chain.
+// SimulateChecker simulates transactions, producing a txmgr.RevertError with the decoded revert reason if they
+// revert on chain.
 type SimulateChecker struct {
 	Client evmclient.Client
+	// Decoder decodes the revert data, DefaultRevertDecoder is used if nil.
+	Decoder *RevertDecoder
 }
 
 // Check satisfies the TransmitChecker interface.
@@ -148,9 +160,19 @@ func (s *SimulateChecker) Check(
 	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
+			decoder := s.Decoder
+			if decoder == nil {
+				decoder = DefaultRevertDecoder
+			}
+			reason := decoder.DecodeRPCError(jErr)
+			promSimulationRevertCount.WithLabelValues(tx.ChainID.String(), reason.Name).Inc()
 			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-			return pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String())
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String(),
+				"revertReason", reason.Reason)
+			return &txmgr.RevertError{
+				Reason: reason.Reason,
+				Err:    pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String()),
+			}
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
 			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
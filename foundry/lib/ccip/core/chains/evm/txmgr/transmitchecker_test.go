@@ -10,9 +10,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -158,6 +161,28 @@ func TestTransmitCheckers(t *testing.T) {
 			err := checker.Check(ctx, log, tx, attempt)
 			expErrMsg := "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }"
 			require.EqualError(t, err, expErrMsg)
+			var revertErr *txmgrcommon.RevertError
+			require.ErrorAs(t, err, &revertErr)
+			assert.Equal(t, "oh no, it reverted", revertErr.Reason)
+		})
+
+		t.Run("revert with custom error", func(t *testing.T) {
+			router := common.HexToAddress("0x1")
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    hexutil.Encode(append(crypto.Keccak256([]byte("InvalidRouter(address)"))[:4], common.LeftPadBytes(router.Bytes(), 32)...)),
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.MatchedBy(func(callarg map[string]interface{}) bool {
+					return fmt.Sprintf("%s", callarg["value"]) == "0x282" // 642
+				}), "latest").Return(&jerr).Once()
+
+			err := checker.Check(ctx, log, tx, attempt)
+			var revertErr *txmgrcommon.RevertError
+			require.ErrorAs(t, err, &revertErr)
+			assert.Equal(t, "CCIPReceiver.InvalidRouter("+router.Hex()+")", revertErr.Reason)
 		})
 
 		t.Run("non revert error", func(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
@@ -178,6 +180,40 @@ func TestTxm_CreateTransaction(t *testing.T) {
 		assert.Contains(t, err.Error(), "Txm#CreateTransaction: cannot create transaction; too many unstarted transactions in the queue (1/1). WARNING: Hitting EVM.Transactions.MaxQueued")
 	})
 
+	t.Run("with invalid urgency does not insert eth_tx", func(t *testing.T) {
+		evmConfig.MaxQueued = uint64(3)
+		_, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Urgency:        feetypes.Urgency("Urgent"),
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `Txm#CreateTransaction: invalid urgency "Urgent"`)
+	})
+
+	t.Run("persists the urgency of the eth_tx", func(t *testing.T) {
+		evmConfig.MaxQueued = uint64(3)
+		etx, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Urgency:        feetypes.UrgencyHigh,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, feetypes.UrgencyHigh, etx.Urgency)
+
+		var dbEtx txmgr.DbEthTx
+		require.NoError(t, db.Get(&dbEtx, `SELECT * FROM evm.txes WHERE id = $1`, etx.ID))
+		assert.Equal(t, string(feetypes.UrgencyHigh), dbEtx.Urgency.String)
+
+		pgtest.MustExec(t, db, `DELETE FROM evm.txes WHERE id = $1`, etx.ID)
+	})
+
 	t.Run("doesn't insert eth_tx if a matching tx already exists for that pipeline_task_run_id", func(t *testing.T) {
 		evmConfig.MaxQueued = uint64(3)
 		id := uuid.New()
@@ -587,6 +623,179 @@ func TestTxm_Reset(t *testing.T) {
 	})
 }
 
+func TestTxm_CancelAndReplace(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+
+	// no enabled addresses, so that the Broadcaster and Confirmer leave the txes alone
+	kst := ksmocks.NewEth(t)
+	kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return([]common.Address{}, nil)
+	kst.On("SubscribeToKeyChanges", mock.Anything).Return(make(chan struct{}), func() {})
+	kst.On("SignTx", mock.Anything, fromAddress, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ common.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+			return tx, nil
+		}).Maybe()
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil).Maybe()
+	ethClient.On("BatchCallContextAll", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	estimator := gas.NewEstimator(logger.Test(t), ethClient, cfg.EVM(), cfg.EVM().GasEstimator())
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, cfg.EVM(), cfg.EVM().GasEstimator(), cfg.EVM().Transactions(), cfg.Database(), cfg.Database().Listener(), kst)
+	require.NoError(t, err)
+	type resume struct {
+		id  uuid.UUID
+		err error
+	}
+	resumes := make(chan resume, 1)
+	txm.RegisterResumeCallback(func(id uuid.UUID, _ interface{}, err error) error {
+		resumes <- resume{id, err}
+		return nil
+	})
+
+	t.Run("returns error if not started", func(t *testing.T) {
+		_, err := txm.Cancel(ctx, 1)
+		assert.EqualError(t, err, "not started")
+		_, err = txm.Replace(ctx, 1, []byte{1}, nil)
+		assert.EqualError(t, err, "not started")
+	})
+
+	servicetest.Run(t, txm)
+
+	t.Run("cancels unstarted tx", func(t *testing.T) {
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID)
+
+		cancelled, err := txm.Cancel(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, cancelled.State)
+		assert.Equal(t, "cancelled", cancelled.Error.String)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		assert.Equal(t, "cancelled", etx.Error.String)
+	})
+
+	t.Run("resumes the pipeline run of a cancelled unstarted tx with an error", func(t *testing.T) {
+		pgtest.MustExec(t, db, `SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+		pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET pipeline_task_run_id = $1, signal_callback = TRUE WHERE id = $2`, &tr.ID, etx.ID)
+
+		_, err := txm.Cancel(ctx, etx.ID)
+		require.NoError(t, err)
+		select {
+		case r := <-resumes:
+			assert.Equal(t, tr.ID, r.id)
+			assert.ErrorIs(t, r.err, txmgrcommon.ErrTxCancelled)
+		default:
+			t.Fatal("pipeline run was not resumed")
+		}
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		assert.True(t, etx.CallbackCompleted)
+	})
+
+	t.Run("replaces payload of unstarted tx", func(t *testing.T) {
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID)
+
+		replaced, err := txm.Replace(ctx, etx.ID, []byte{4, 5, 6}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{4, 5, 6}, replaced.EncodedPayload)
+		assert.Equal(t, etx.FeeLimit, replaced.FeeLimit)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+		assert.Equal(t, []byte{4, 5, 6}, etx.EncodedPayload)
+	})
+
+	t.Run("cancels unconfirmed tx with a self transfer at the same nonce", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+
+		cancelled, err := txm.Cancel(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, cancelled.State)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Equal(t, int64(0), etx.Value.Int64())
+		assert.Empty(t, etx.EncodedPayload)
+		meta, err := etx.GetMeta()
+		require.NoError(t, err)
+		require.NotNil(t, meta.Cancelled)
+		assert.True(t, *meta.Cancelled)
+		require.Len(t, etx.TxAttempts, 2)
+		replacement := etx.TxAttempts[0]
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, replacement.State)
+		assert.True(t, replacement.TxFee.Legacy.Cmp(etx.TxAttempts[1].TxFee.Legacy) > 0)
+
+		decoded, err := txmgr.GetGethSignedTx(replacement.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), decoded.Nonce())
+		assert.Equal(t, fromAddress, *decoded.To())
+		assert.Empty(t, decoded.Data())
+
+		t.Run("returns error while the replacement attempt is in progress", func(t *testing.T) {
+			_, err := txm.Cancel(ctx, etx.ID)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "has an attempt in progress")
+		})
+	})
+
+	t.Run("replaces payload of unconfirmed tx at the same nonce", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		toAddress := etx.ToAddress
+
+		gasLimit := etx.FeeLimit + 10_000
+		replaced, err := txm.Replace(ctx, etx.ID, []byte{4, 5, 6}, &gasLimit)
+		require.NoError(t, err)
+		require.Len(t, replaced.TxAttempts, 2)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, toAddress, etx.ToAddress)
+		assert.Equal(t, []byte{4, 5, 6}, etx.EncodedPayload)
+		assert.Equal(t, gasLimit, etx.FeeLimit)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, etx.TxAttempts[0].State)
+
+		decoded, err := txmgr.GetGethSignedTx(etx.TxAttempts[0].SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), decoded.Nonce())
+		assert.Equal(t, []byte{4, 5, 6}, decoded.Data())
+		assert.Equal(t, gasLimit, decoded.Gas())
+	})
+
+	t.Run("returns error for confirmed tx", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 2, 1, fromAddress)
+
+		_, err := txm.Cancel(ctx, etx.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("cannot cancel tx %d in state confirmed", etx.ID))
+		_, err = txm.Replace(ctx, etx.ID, []byte{4, 5, 6}, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("cannot replace tx %d in state confirmed", etx.ID))
+	})
+
+	t.Run("returns error for unknown tx", func(t *testing.T) {
+		_, err := txm.Cancel(ctx, math.MaxInt64)
+		require.Error(t, err)
+	})
+}
+
 func newTxStore(t *testing.T, db *sqlx.DB) txmgr.EvmTxStore {
 	return txmgr.NewTxStore(db, logger.Test(t))
 }
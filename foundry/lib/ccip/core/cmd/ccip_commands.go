@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initCCIPSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "message",
+			Usage: "Commands for tracking CCIP messages",
+			Subcommands: []cli.Command{
+				{
+					Name:   "status",
+					Usage:  "Show the lifecycle status of a CCIP message by its message ID",
+					Action: s.ShowCCIPMessageStatus,
+				},
+				{
+					Name:   "manual-execution",
+					Usage:  "Build the offRamp manuallyExecute calldata of a stuck CCIP message, by message ID or source transaction hash",
+					Action: s.BuildCCIPManualExecution,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "gas-limit",
+							Usage: "override the gas limit of the message's execution",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "chain-health",
+			Usage: "Commands for managing the chain healthchecks of CCIP jobs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "clear",
+					Usage:  "Clear the sticky unhealthy status of a CCIP job's lane, if its chain health policy allows it",
+					Action: s.ClearCCIPStickyUnhealthy,
+				},
+			},
+		},
+	}
+}
+
+type CCIPMessagePresenter struct {
+	presenters.CCIPMessageResource
+}
+
+// RenderTable implements TableRenderer
+func (p *CCIPMessagePresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Message ID", "Status", "Source Chain Selector", "Dest Chain Selector", "Sequence Number"})
+	table.Append([]string{
+		p.ID,
+		p.Status,
+		p.SourceChainSelector,
+		p.DestChainSelector,
+		strconv.FormatUint(p.SequenceNumber, 10),
+	})
+	render("CCIP Message", table)
+
+	table = rt.newTable([]string{"Stage", "Tx Hash", "Merkle Root"})
+	table.Append([]string{"sent", p.SendTxHash, ""})
+	table.Append([]string{"committed", p.CommitTxHash.String, p.MerkleRoot.String})
+	table.Append([]string{"executed", p.ExecutionTxHash.String, ""})
+	render("Lifecycle", table)
+	return nil
+}
+
+// ShowCCIPMessageStatus returns the tracked status of the given CCIP message.
+func (s *Shell) ShowCCIPMessageStatus(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the message to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/messages/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPMessagePresenter{})
+}
+
+type CCIPManualExecutionPresenter struct {
+	presenters.CCIPManualExecutionResource
+}
+
+func (p *CCIPManualExecutionPresenter) ToRow() []string {
+	tokenData := make([]string, len(p.OffchainTokenData))
+	for i, data := range p.OffchainTokenData {
+		tokenData[i] = data.String()
+	}
+	return []string{
+		p.ID,
+		p.OffRamp,
+		strconv.FormatUint(p.SequenceNumber, 10),
+		p.MerkleRoot,
+		"[" + strings.Join(tokenData, ", ") + "]",
+		p.Calldata.String(),
+	}
+}
+
+var ccipManualExecutionHeaders = []string{"Message ID", "OffRamp", "Sequence Number", "Merkle Root", "Offchain Token Data", "Calldata"}
+
+type CCIPManualExecutionPresenters []CCIPManualExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps CCIPManualExecutionPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("CCIP Manual Executions\n")); err != nil {
+		return err
+	}
+	renderList(ccipManualExecutionHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// BuildCCIPManualExecution returns the manuallyExecute calldata and offchain token data of the given CCIP message,
+// or of the messages sent by the given source chain transaction.
+func (s *Shell) BuildCCIPManualExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the message ID or source transaction hash of the messages to execute"))
+	}
+	query := url.Values{}
+	if gasLimit := c.String("gas-limit"); gasLimit != "" {
+		query.Set("gasLimit", gasLimit)
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/manual_executions/"+url.PathEscape(c.Args().First())+"?"+query.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPManualExecutionPresenters{})
+}
+
+// ClearCCIPStickyUnhealthy clears the sticky unhealthy status of the chain healthcheck of the given CCIP job.
+func (s *Shell) ClearCCIPStickyUnhealthy(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the CCIP job to clear"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/ccip/chain_health/"+url.PathEscape(c.Args().First())+"/clear", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(resp); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Sticky unhealthy status of job %v cleared\n", c.Args().First())
+	return nil
+}
@@ -0,0 +1,82 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestCCIPMessagePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id     = "0xabcd000000000000000000000000000000000000000000000000000000000000"
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.CCIPMessagePresenter{
+		CCIPMessageResource: presenters.CCIPMessageResource{
+			JAID:                presenters.NewJAID(id),
+			SourceChainSelector: "5009297550715157269",
+			DestChainSelector:   "16015286601757825753",
+			SequenceNumber:      42,
+			Status:              "committed",
+			MerkleRoot:          null.StringFrom("0xroot"),
+			SendTxHash:          "0xsend",
+			CommitTxHash:        null.StringFrom("0xcommit"),
+			SentAt:              time.Now(),
+			UpdatedAt:           time.Now(),
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, "committed")
+	assert.Contains(t, output, "16015286601757825753")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "0xsend")
+	assert.Contains(t, output, "0xcommit")
+	assert.Contains(t, output, "0xroot")
+}
+
+func TestCCIPManualExecutionPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id     = "0xabcd000000000000000000000000000000000000000000000000000000000000"
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.CCIPManualExecutionPresenters{{
+		CCIPManualExecutionResource: presenters.CCIPManualExecutionResource{
+			JAID:              presenters.NewJAID(id),
+			OffRamp:           "0x2222222222222222222222222222222222222222",
+			SequenceNumber:    42,
+			MerkleRoot:        "0xroot",
+			OffchainTokenData: []hexutil.Bytes{nil, {0x01, 0x02}},
+			Calldata:          hexutil.Bytes{0xde, 0xad, 0xbe, 0xef},
+		},
+	}}
+
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, "0x2222222222222222222222222222222222222222")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "0xroot")
+	assert.Contains(t, output, "[0x, 0x0102]")
+	assert.Contains(t, output, "0xdeadbeef")
+}
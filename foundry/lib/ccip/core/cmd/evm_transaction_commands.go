@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
@@ -15,6 +16,7 @@ import (
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -57,11 +59,44 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 					},
 				},
 			},
+			{
+				Name:   "dropped",
+				Usage:  "List the Ethereum Transactions dropped because they reverted during simulation, with their revert reason",
+				Action: s.IndexDroppedTransactions,
+				Flags: []cli.Flag{
+					cli.IntFlag{
+						Name:  "page",
+						Usage: "page of results to display",
+					},
+				},
+			},
 			{
 				Name:   "show",
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:      "cancel",
+				Usage:     "Cancel an unstarted or unconfirmed Ethereum Transaction",
+				ArgsUsage: "<ID or hash>",
+				Action:    s.CancelTransaction,
+			},
+			{
+				Name:      "replace",
+				Usage:     "Replace the payload of an unstarted or unconfirmed Ethereum Transaction",
+				ArgsUsage: "<ID or hash>",
+				Action:    s.ReplaceTransaction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "data",
+						Usage: "hex encoded replacement payload",
+					},
+					cli.Uint64Flag{
+						Name:  "gas-limit",
+						Usage: "OPTIONAL: replacement gas limit, the gas limit of the transaction is kept when unset",
+					},
+				},
+			},
 		},
 	}
 }
@@ -73,12 +108,13 @@ type EthTxPresenter struct {
 
 // RenderTable implements TableRenderer
 func (p *EthTxPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"From", "Nonce", "To", "State"})
+	table := rt.newTable([]string{"From", "Nonce", "To", "State", "Revert Reason"})
 	table.Append([]string{
 		p.From.Hex(),
 		p.Nonce,
 		p.To.Hex(),
 		fmt.Sprint(p.State),
+		p.RevertReason,
 	})
 
 	render(fmt.Sprintf("Ethereum Transaction %v", p.Hash.Hex()), table)
@@ -89,7 +125,7 @@ type EthTxPresenters []EthTxPresenter
 
 // RenderTable implements TableRenderer
 func (ps EthTxPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Hash", "Nonce", "From", "GasPrice", "SentAt", "State"})
+	table := rt.newTable([]string{"Hash", "Nonce", "From", "GasPrice", "SentAt", "State", "Revert Reason"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Hash.Hex(),
@@ -98,6 +134,7 @@ func (ps EthTxPresenters) RenderTable(rt RendererTable) error {
 			p.GasPrice,
 			p.SentAt,
 			fmt.Sprint(p.State),
+			p.RevertReason,
 		})
 	}
 
@@ -105,12 +142,37 @@ func (ps EthTxPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+type DroppedEthTxPresenters []EthTxPresenter
+
+// RenderTable implements TableRenderer
+func (ps DroppedEthTxPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "From", "To", "Chain ID", "Revert Reason"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.ID,
+			p.From.Hex(),
+			p.To.Hex(),
+			p.EVMChainID.String(),
+			p.RevertReason,
+		})
+	}
+
+	render("Dropped Ethereum Transactions", table)
+	return nil
+}
+
 // IndexTransactions returns the list of transactions in descending order,
 // taking an optional page parameter
 func (s *Shell) IndexTransactions(c *cli.Context) error {
 	return s.getPage("/v2/transactions/evm", c.Int("page"), &EthTxPresenters{})
 }
 
+// IndexDroppedTransactions returns the list of transactions dropped because they reverted during simulation in
+// descending order, taking an optional page parameter
+func (s *Shell) IndexDroppedTransactions(c *cli.Context) error {
+	return s.getPage("/v2/transactions/evm/dropped", c.Int("page"), &DroppedEthTxPresenters{})
+}
+
 // ShowTransaction returns the info for the given transaction hash
 func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	if !c.Args().Present() {
@@ -131,6 +193,66 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction cancels the transaction with the given ID or hash
+func (s *Shell) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID or the hash of the transaction"))
+	}
+	idOrHash := c.Args().First()
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+idOrHash+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
+// ReplaceTransaction replaces the payload, and optionally the gas limit, of the transaction with the given ID or hash
+func (s *Shell) ReplaceTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID or the hash of the transaction"))
+	}
+	if !c.IsSet("data") {
+		return s.errorOut(errors.New("must pass the replacement payload with --data"))
+	}
+	data, err := hexutil.Decode(c.String("data"))
+	if err != nil {
+		return s.errorOut(multierr.Combine(
+			errors.New("while parsing replacement payload"), err))
+	}
+
+	request := web.ReplaceTransactionRequest{Data: data}
+	if c.IsSet("gas-limit") {
+		gasLimit := c.Uint64("gas-limit")
+		request.GasLimit = &gasLimit
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	idOrHash := c.Args().First()
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+idOrHash+"/replace", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -76,6 +77,9 @@ func TestShell_ShowTransaction(t *testing.T) {
 	txStore := cltest.NewTestTxStore(t, db)
 	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
 	attempt := tx.TxAttempts[0]
+	revertReason := "CCIPReceiver.InvalidRouter(0x0000000000000000000000000000000000000001)"
+	_, err := db.Exec(`UPDATE evm.txes SET revert_reason = $1 WHERE id = $2`, revertReason, tx.ID)
+	require.NoError(t, err)
 
 	set := flag.NewFlagSet("test get tx", 0)
 	flagSetApplyFromAction(client.ShowTransaction, set, "")
@@ -87,6 +91,48 @@ func TestShell_ShowTransaction(t *testing.T) {
 
 	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
+	assert.Equal(t, revertReason, renderedTx.RevertReason)
+}
+
+func TestShell_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	db := app.GetSqlxDB()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	txStore := cltest.NewTestTxStore(t, db)
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+	attempt := tx.TxAttempts[0]
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	flagSetApplyFromAction(client.CancelTransaction, set, "")
+
+	require.NoError(t, set.Parse([]string{attempt.Hash.String()}))
+
+	c := cli.NewContext(nil, set, nil)
+	err := client.CancelTransaction(c)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot cancel tx")
+}
+
+func TestShell_ReplaceTransaction_InvalidData(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test replace tx", 0)
+	flagSetApplyFromAction(client.ReplaceTransaction, set, "")
+
+	require.NoError(t, set.Parse([]string{"--data", "not hex", utils.NewHash().String()}))
+
+	c := cli.NewContext(nil, set, nil)
+	err := client.ReplaceTransaction(c)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "while parsing replacement payload")
 }
 
 func TestShell_IndexTxAttempts(t *testing.T) {
@@ -69,6 +69,14 @@ func TestDoc(t *testing.T) {
 		require.Zero(t, *docDefaults.GasEstimator.LimitJobType.FM)
 		docDefaults.GasEstimator.LimitJobType = evmcfg.GasLimitJobType{}
 
+		// per-tier overrides are nilable
+		for _, tier := range []*evmcfg.FeeTier{&docDefaults.GasEstimator.FeeTiers.Low, &docDefaults.GasEstimator.FeeTiers.Medium, &docDefaults.GasEstimator.FeeTiers.High} {
+			require.Zero(t, *tier.BumpThreshold)
+			require.Equal(t, new(assets.Wei), tier.PriceMax)
+			tier.BumpThreshold = nil
+			tier.PriceMax = nil
+		}
+
 		// EIP1559FeeCapBufferBlocks doesn't have a constant default - it is derived from another field
 		require.Zero(t, *docDefaults.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks)
 		docDefaults.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks = nil
@@ -85,6 +93,10 @@ func TestDoc(t *testing.T) {
 		docDefaults.ChainWriter.FromAddress = nil
 		docDefaults.ChainWriter.ForwarderAddress = nil
 
+		// relay url w/o global value
+		require.Zero(t, *docDefaults.Transactions.PrivateRelay.URL)
+		docDefaults.Transactions.PrivateRelay.URL = nil
+
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
 
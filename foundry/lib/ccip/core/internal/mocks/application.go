@@ -9,6 +9,10 @@ import (
 
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
+	ccip "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+
+	ccipexec "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
+
 	chainlink "github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 
 	context "context"
@@ -25,6 +29,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	msgtracker "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
+
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
@@ -131,6 +137,86 @@ func (_m *Application) BridgeORM() bridges.ORM {
 	return r0
 }
 
+// CCIPChainHealthRegistry provides a mock function with given fields:
+func (_m *Application) CCIPChainHealthRegistry() *ccip.ChainHealthRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CCIPChainHealthRegistry")
+	}
+
+	var r0 *ccip.ChainHealthRegistry
+	if rf, ok := ret.Get(0).(func() *ccip.ChainHealthRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ccip.ChainHealthRegistry)
+		}
+	}
+
+	return r0
+}
+
+// CCIPDebugRegistry provides a mock function with given fields:
+func (_m *Application) CCIPDebugRegistry() *ccip.DebugRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CCIPDebugRegistry")
+	}
+
+	var r0 *ccip.DebugRegistry
+	if rf, ok := ret.Get(0).(func() *ccip.DebugRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ccip.DebugRegistry)
+		}
+	}
+
+	return r0
+}
+
+// CCIPManualExecutionRegistry provides a mock function with given fields:
+func (_m *Application) CCIPManualExecutionRegistry() *ccipexec.ManualExecutionRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CCIPManualExecutionRegistry")
+	}
+
+	var r0 *ccipexec.ManualExecutionRegistry
+	if rf, ok := ret.Get(0).(func() *ccipexec.ManualExecutionRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ccipexec.ManualExecutionRegistry)
+		}
+	}
+
+	return r0
+}
+
+// CCIPMessageORM provides a mock function with given fields:
+func (_m *Application) CCIPMessageORM() msgtracker.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CCIPMessageORM")
+	}
+
+	var r0 msgtracker.ORM
+	if rf, ok := ret.Get(0).(func() msgtracker.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(msgtracker.ORM)
+		}
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// stderr logs the failures of the harness and of the simulation, the nodes replace the default logger.
+var stderr = log.New(os.Stderr, "", log.LstdFlags)
+
+var errHarnessFailed = errors.New("the integration test harness failed, see the errors above")
+
+// harness implements testing.TB for the integration test harness the environment of the simulation is built on, so
+// that the simulation runs without go test. A failure of the harness stops the simulation like t.FailNow stops a test,
+// the cleanups run once the simulation is done.
+type harness struct {
+	// TB is nil, it only provides the private method of the interface.
+	testing.TB
+
+	verbose bool
+
+	mu       sync.Mutex
+	failed   bool
+	skipped  bool
+	cleanups []func()
+}
+
+func newHarness(verbose bool) *harness {
+	return &harness{verbose: verbose}
+}
+
+// run calls f on its own goroutine, so that FailNow can stop it with runtime.Goexit, then runs the cleanups.
+func (h *harness) run(f func() error) error {
+	var err error
+	completed := goexitSafe(func() { err = f() })
+	h.runCleanups()
+	if err != nil {
+		return err
+	}
+	if !completed || h.Failed() {
+		return errHarnessFailed
+	}
+	return nil
+}
+
+// goexitSafe calls f on its own goroutine and reports whether f returned, rather than being stopped by FailNow.
+func goexitSafe(f func()) (completed bool) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+		completed = true
+	}()
+	<-done
+	return completed
+}
+
+func (h *harness) runCleanups() {
+	for {
+		h.mu.Lock()
+		if len(h.cleanups) == 0 {
+			h.mu.Unlock()
+			return
+		}
+		cleanup := h.cleanups[len(h.cleanups)-1]
+		h.cleanups = h.cleanups[:len(h.cleanups)-1]
+		h.mu.Unlock()
+		goexitSafe(cleanup)
+	}
+}
+
+func (h *harness) Cleanup(f func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.cleanups = append(h.cleanups, f)
+}
+
+func (h *harness) Error(args ...any) {
+	stderr.Print(args...)
+	h.Fail()
+}
+
+func (h *harness) Errorf(format string, args ...any) {
+	stderr.Printf(format, args...)
+	h.Fail()
+}
+
+func (h *harness) Fail() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failed = true
+}
+
+func (h *harness) FailNow() {
+	h.Fail()
+	runtime.Goexit()
+}
+
+func (h *harness) Failed() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failed
+}
+
+func (h *harness) Fatal(args ...any) {
+	stderr.Print(args...)
+	h.FailNow()
+}
+
+func (h *harness) Fatalf(format string, args ...any) {
+	stderr.Printf(format, args...)
+	h.FailNow()
+}
+
+func (h *harness) Helper() {}
+
+func (h *harness) Log(args ...any) {
+	if h.verbose {
+		stderr.Print(args...)
+	}
+}
+
+func (h *harness) Logf(format string, args ...any) {
+	if h.verbose {
+		stderr.Printf(format, args...)
+	}
+}
+
+func (h *harness) Name() string {
+	return "ccipsim"
+}
+
+func (h *harness) Setenv(key, value string) {
+	prev, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		h.Fatalf("failed to set %s: %v", key, err)
+	}
+	h.Cleanup(func() {
+		if ok {
+			_ = os.Setenv(key, prev)
+		} else {
+			_ = os.Unsetenv(key)
+		}
+	})
+}
+
+// Skip fails the simulation, the harness only skips when a dependency of the nodes is missing, e.g. with -test.short.
+func (h *harness) Skip(args ...any) {
+	stderr.Print(args...)
+	h.SkipNow()
+}
+
+func (h *harness) Skipf(format string, args ...any) {
+	stderr.Printf(format, args...)
+	h.SkipNow()
+}
+
+func (h *harness) SkipNow() {
+	h.mu.Lock()
+	h.skipped = true
+	h.mu.Unlock()
+	h.FailNow()
+}
+
+func (h *harness) Skipped() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.skipped
+}
+
+func (h *harness) TempDir() string {
+	dir, err := os.MkdirTemp("", "ccipsim")
+	if err != nil {
+		h.Fatalf("failed to create temp dir: %v", err)
+	}
+	h.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
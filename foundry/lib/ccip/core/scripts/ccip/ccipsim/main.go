@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipsim"
+	integrationtesthelpers "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/testhelpers/integration"
+)
+
+func main() {
+	scenarioPath := flag.String("scenario", "", "path of the JSON scenario to simulate, the default scenario is simulated when empty")
+	reportPath := flag.String("report", "ccipsim-report.json", "path the JSON report is written to")
+	timeout := flag.Duration("timeout", 30*time.Minute, "timeout of the simulation, including the deployment of the lanes")
+	verbose := flag.Bool("v", false, "print the logs of the simulation and of the nodes")
+	flag.Parse()
+	// The harness checks testing.Short, which needs the testing flags to be registered and parsed.
+	testing.Init()
+	if err := flag.CommandLine.Parse(nil); err != nil {
+		stderr.Fatal(err)
+	}
+
+	scenario := ccipsim.DefaultScenario()
+	if *scenarioPath != "" {
+		var err error
+		if scenario, err = ccipsim.LoadScenario(*scenarioPath); err != nil {
+			stderr.Fatalf("invalid scenario: %v", err)
+		}
+	}
+	if err := simulate(scenario, *reportPath, *timeout, *verbose); err != nil {
+		stderr.Fatal(err)
+	}
+}
+
+func simulate(scenario ccipsim.Scenario, reportPath string, timeout time.Duration, verbose bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	h := newHarness(verbose)
+	var report ccipsim.Report
+	err := h.run(func() (err error) {
+		env := integrationtesthelpers.NewSimEnvironment(h)
+		report, err = ccipsim.Run(ctx, logger.TestLogger(h), scenario, env)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("simulation failed: %w", err)
+	}
+	fmt.Print(report)
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	if err = os.WriteFile(reportPath, b, 0600); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
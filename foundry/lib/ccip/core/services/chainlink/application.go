@@ -49,6 +49,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	externalp2p "github.com/smartcontractkit/chainlink/v2/core/services/p2p/wrapper"
@@ -100,6 +103,10 @@ type Application interface {
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
+	CCIPMessageORM() msgtracker.ORM
+	CCIPChainHealthRegistry() *ccip.ChainHealthRegistry
+	CCIPDebugRegistry() *ccip.DebugRegistry
+	CCIPManualExecutionRegistry() *ccipexec.ManualExecutionRegistry
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
@@ -133,6 +140,10 @@ type ChainlinkApplication struct {
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
+	ccipMessageORM           msgtracker.ORM
+	ccipChainHealthRegistry  *ccip.ChainHealthRegistry
+	ccipDebugRegistry        *ccip.DebugRegistry
+	ccipManualExecRegistry   *ccipexec.ManualExecutionRegistry
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -378,6 +389,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger,
 				registry,
 				legacyEVMChains,
+				sqlxDB,
 			),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
@@ -430,6 +442,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
+	ccipChainHealthRegistry := ccip.NewChainHealthRegistry()
+	ccipDebugRegistry := ccip.NewDebugRegistry()
+	ccipManualExecRegistry := ccipexec.NewManualExecutionRegistry()
 	if cfg.OCR2().Enabled() {
 		globalLogger.Debug("Off-chain reporting v2 enabled")
 
@@ -454,6 +469,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			opts.RelayerChainInteroperators,
 			mailMon,
 			registry,
+			ccipChainHealthRegistry,
+			ccipDebugRegistry,
+			ccipManualExecRegistry,
 		)
 		delegates[job.Bootstrap] = ocrbootstrap.NewDelegateBootstrap(
 			sqlxDB,
@@ -527,6 +545,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
+		ccipMessageORM:           msgtracker.NewORM(sqlxDB),
+		ccipChainHealthRegistry:  ccipChainHealthRegistry,
+		ccipDebugRegistry:        ccipDebugRegistry,
+		ccipManualExecRegistry:   ccipManualExecRegistry,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
@@ -723,6 +745,22 @@ func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
 
+func (app *ChainlinkApplication) CCIPMessageORM() msgtracker.ORM {
+	return app.ccipMessageORM
+}
+
+func (app *ChainlinkApplication) CCIPChainHealthRegistry() *ccip.ChainHealthRegistry {
+	return app.ccipChainHealthRegistry
+}
+
+func (app *ChainlinkApplication) CCIPDebugRegistry() *ccip.DebugRegistry {
+	return app.ccipDebugRegistry
+}
+
+func (app *ChainlinkApplication) CCIPManualExecutionRegistry() *ccipexec.ManualExecutionRegistry {
+	return app.ccipManualExecRegistry
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
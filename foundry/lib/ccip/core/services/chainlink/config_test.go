@@ -26,6 +26,7 @@ import (
 	solcfg "github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 	stkcfg "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/config"
 	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
@@ -536,6 +537,20 @@ func TestConfig_Marshal(t *testing.T) {
 						EIP1559FeeCapBufferBlocks: ptr[uint16](13),
 						TransactionPercentile:     ptr[uint16](15),
 					},
+
+					FeeHistory: evmcfg.FeeHistoryEstimator{
+						BlockHistorySize:       ptr[uint16](21),
+						PredictionBlocks:       ptr[uint16](4),
+						Urgency:                ptr(feetypes.UrgencyHigh),
+						LowRewardPercentile:    ptr[uint16](20),
+						MediumRewardPercentile: ptr[uint16](40),
+						HighRewardPercentile:   ptr[uint16](80),
+					},
+					FeeTiers: evmcfg.FeeTiers{
+						Low:    evmcfg.FeeTier{PriceMultiplier: ptr(decimal.RequireFromString("0.5")), BumpThreshold: ptr[uint32](10), PriceMax: assets.GWei(10)},
+						Medium: evmcfg.FeeTier{PriceMultiplier: ptr(decimal.RequireFromString("1")), BumpThreshold: ptr[uint32](6), PriceMax: assets.GWei(50)},
+						High:   evmcfg.FeeTier{PriceMultiplier: ptr(decimal.RequireFromString("1.5")), BumpThreshold: ptr[uint32](1), PriceMax: assets.GWei(100)},
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -568,6 +583,13 @@ func TestConfig_Marshal(t *testing.T) {
 					ReaperThreshold:      &minute,
 					ResendAfterThreshold: &hour,
 					ForwardersEnabled:    ptr(true),
+					PrivateRelay: evmcfg.PrivateRelay{
+						Enabled:          ptr(true),
+						URL:              mustURL("https://private.relay"),
+						Method:           ptr("eth_sendBundle"),
+						FallbackBlocks:   ptr[uint32](10),
+						FallbackAttempts: ptr[uint32](5),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -970,6 +992,13 @@ ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
 
+[EVM.Transactions.PrivateRelay]
+Enabled = true
+URL = 'https://private.relay'
+Method = 'eth_sendBundle'
+FallbackBlocks = 10
+FallbackAttempts = 5
+
 [EVM.BalanceMonitor]
 Enabled = true
 
@@ -1007,6 +1036,30 @@ CheckInclusionPercentile = 19
 EIP1559FeeCapBufferBlocks = 13
 TransactionPercentile = 15
 
+[EVM.GasEstimator.FeeHistory]
+BlockHistorySize = 21
+PredictionBlocks = 4
+Urgency = 'High'
+LowRewardPercentile = 20
+MediumRewardPercentile = 40
+HighRewardPercentile = 80
+
+[EVM.GasEstimator.FeeTiers]
+[EVM.GasEstimator.FeeTiers.Low]
+PriceMultiplier = '0.5'
+BumpThreshold = 10
+PriceMax = '10 gwei'
+
+[EVM.GasEstimator.FeeTiers.Medium]
+PriceMultiplier = '1'
+BumpThreshold = 6
+PriceMax = '50 gwei'
+
+[EVM.GasEstimator.FeeTiers.High]
+PriceMultiplier = '1.5'
+BumpThreshold = 1
+PriceMax = '100 gwei'
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -235,6 +235,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 			effectiveTransmitterAddress,
 			strategy,
 			checker,
+			"",
 			chain.ID(),
 			d.keyStore.Eth(),
 		)
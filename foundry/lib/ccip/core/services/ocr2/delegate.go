@@ -41,6 +41,7 @@ import (
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager"
@@ -137,6 +138,13 @@ type Delegate struct {
 
 	legacyChains         legacyevm.LegacyChainContainer // legacy: use relayers instead
 	capabilitiesRegistry types.CapabilitiesRegistry
+
+	// ccipChainHealthRegistry tracks the chain healthchecks of the CCIP jobs, it may be nil
+	ccipChainHealthRegistry *ccip.ChainHealthRegistry
+	// ccipDebugRegistry tracks the debug recorders of the CCIP jobs, it may be nil
+	ccipDebugRegistry *ccip.DebugRegistry
+	// ccipManualExecutionRegistry tracks the manual executors of the CCIP execution jobs, it may be nil
+	ccipManualExecutionRegistry *ccipexec.ManualExecutionRegistry
 }
 
 type DelegateConfig interface {
@@ -248,6 +256,9 @@ func NewDelegate(
 	relayers RelayGetter,
 	mailMon *mailbox.Monitor,
 	capabilitiesRegistry types.CapabilitiesRegistry,
+	ccipChainHealthRegistry *ccip.ChainHealthRegistry,
+	ccipDebugRegistry *ccip.DebugRegistry,
+	ccipManualExecutionRegistry *ccipexec.ManualExecutionRegistry,
 ) *Delegate {
 	return &Delegate{
 		db:                    db,
@@ -269,6 +280,10 @@ func NewDelegate(
 		isNewlyCreatedJob:     false,
 		mailMon:               mailMon,
 		capabilitiesRegistry:  capabilitiesRegistry,
+
+		ccipChainHealthRegistry:     ccipChainHealthRegistry,
+		ccipDebugRegistry:           ccipDebugRegistry,
+		ccipManualExecutionRegistry: ccipManualExecutionRegistry,
 	}
 }
 
@@ -1767,7 +1782,7 @@ func (d *Delegate) newServicesCCIPCommit(ctx context.Context, lggr logger.Sugare
 	logError := func(msg string) {
 		lggr.ErrorIf(d.jobORM.RecordError(jb.ID, msg), "unable to record error")
 	}
-	return ccipcommit.NewCommitServices(ctx, lggr, jb, d.legacyChains, d.isNewlyCreatedJob, d.pipelineRunner, oracleArgsNoPlugin, logError, qopts...)
+	return ccipcommit.NewCommitServices(ctx, lggr, jb, d.legacyChains, d.isNewlyCreatedJob, d.pipelineRunner, oracleArgsNoPlugin, logError, d.ccipChainHealthRegistry, d.ccipDebugRegistry, qopts...)
 }
 
 func (d *Delegate) newServicesCCIPExecution(ctx context.Context, lggr logger.SugaredLogger, jb job.Job, bootstrapPeers []commontypes.BootstrapperLocator, kb ocr2key.KeyBundle, ocrDB *db, lc ocrtypes.LocalConfig, transmitterID string, qopts ...pg.QOpt) ([]job.ServiceCtx, error) {
@@ -1821,7 +1836,7 @@ func (d *Delegate) newServicesCCIPExecution(ctx context.Context, lggr logger.Sug
 	logError := func(msg string) {
 		lggr.ErrorIf(d.jobORM.RecordError(jb.ID, msg), "unable to record error")
 	}
-	return ccipexec.NewExecutionServices(ctx, lggr, jb, d.legacyChains, d.isNewlyCreatedJob, oracleArgsNoPlugin, logError, qopts...)
+	return ccipexec.NewExecutionServices(ctx, lggr, jb, d.legacyChains, d.isNewlyCreatedJob, oracleArgsNoPlugin, logError, d.db, d.ccipChainHealthRegistry, d.ccipDebugRegistry, d.ccipManualExecutionRegistry, qopts...)
 }
 
 func (d *Delegate) newServicesLiquidityManager(ctx context.Context, lggr logger.SugaredLogger, jb job.Job, bootstrapPeers []commontypes.BootstrapperLocator, kb ocr2key.KeyBundle, ocrDB *db, lc ocrtypes.LocalConfig) ([]job.ServiceCtx, error) {
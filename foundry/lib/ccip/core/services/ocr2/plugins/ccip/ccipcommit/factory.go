@@ -91,6 +91,10 @@ func (rf *CommitReportingPluginFactory) NewReportingPlugin(config types.Reportin
 		return nil, types.ReportingPluginInfo{}, err
 	}
 
+	if err = validatePriceConsensus(rf.config.priceConsensus, config.F, config.N); err != nil {
+		return nil, types.ReportingPluginInfo{}, fmt.Errorf("invalid price consensus: %w", err)
+	}
+
 	lggr := rf.config.lggr.Named("CommitReportingPlugin")
 	return &CommitReportingPlugin{
 			sourceChainSelector:     rf.config.sourceChainSelector,
@@ -106,6 +110,8 @@ func (rf *CommitReportingPluginFactory) NewReportingPlugin(config types.Reportin
 			offchainConfig:          pluginOffChainConfig,
 			metricsCollector:        rf.config.metricsCollector,
 			chainHealthcheck:        rf.config.chainHealthcheck,
+			priceConsensus:          rf.config.priceConsensus,
+			debugRecorder:           rf.config.debugRecorder,
 		},
 		types.ReportingPluginInfo{
 			Name:          "CCIPCommit",
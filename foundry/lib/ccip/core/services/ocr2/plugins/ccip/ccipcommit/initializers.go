@@ -44,7 +44,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
-func NewCommitServices(ctx context.Context, lggr logger.Logger, jb job.Job, chainSet legacyevm.LegacyChainContainer, new bool, pr pipeline.Runner, argsNoPlugin libocr2.OCR2OracleArgs, logError func(string), qopts ...pg.QOpt) ([]job.ServiceCtx, error) {
+func NewCommitServices(ctx context.Context, lggr logger.Logger, jb job.Job, chainSet legacyevm.LegacyChainContainer, new bool, pr pipeline.Runner, argsNoPlugin libocr2.OCR2OracleArgs, logError func(string), chainHealthRegistry *ccip.ChainHealthRegistry, debugRegistry *ccip.DebugRegistry, qopts ...pg.QOpt) ([]job.ServiceCtx, error) {
 	pluginConfig, backfillArgs, chainHealthcheck, err := jobSpecToCommitPluginConfig(ctx, lggr, jb, pr, chainSet, qopts...)
 	if err != nil {
 		return nil, err
@@ -60,6 +60,10 @@ func NewCommitServices(ctx context.Context, lggr logger.Logger, jb job.Job, chai
 	if err != nil {
 		return nil, err
 	}
+	// Tracking the healthcheck lets admins clear its sticky unhealthy status while the job is running
+	trackedChainHealthcheck := chainHealthRegistry.Track(jb.ID, chainHealthcheck)
+	// The recorded rounds can be inspected through the API while the job is running
+	trackedDebugRecorder := debugRegistry.Track(jb.ID, pluginConfig.debugRecorder)
 	// If this is a brand-new job, then we make use of the start blocks. If not then we're rebooting and log poller will pick up where we left off.
 	if new {
 		return []job.ServiceCtx{
@@ -71,12 +75,14 @@ func NewCommitServices(ctx context.Context, lggr logger.Logger, jb job.Job, chai
 				backfillArgs.DestStartBlock,
 				job.NewServiceAdapter(oracle),
 			),
-			chainHealthcheck,
+			trackedChainHealthcheck,
+			trackedDebugRecorder,
 		}, nil
 	}
 	return []job.ServiceCtx{
 		job.NewServiceAdapter(oracle),
-		chainHealthcheck,
+		trackedChainHealthcheck,
+		trackedDebugRecorder,
 	}, nil
 }
 
@@ -148,36 +154,44 @@ func jobSpecToCommitPluginConfig(ctx context.Context, lggr logger.Logger, jb job
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("creating pipeline price getter: %w", err)
 		}
+	} else if params.pluginConfig.MedianPriceGetterConfig != nil {
+		// Use median price getter, it can read aggregators from any chain in the chainSet.
+		var chainIDs []uint64
+		for _, tokenCfg := range params.pluginConfig.MedianPriceGetterConfig.TokenPrices {
+			for _, aggCfg := range tokenCfg.AggregatorPrices {
+				chainIDs = append(chainIDs, aggCfg.ChainID)
+			}
+		}
+		priceGetterClients, err2 := newPriceGetterClients(lggr, chainSet, chainIDs)
+		if err2 != nil {
+			return nil, nil, nil, err2
+		}
+
+		priceGetter, err = pricegetter.NewMedianPriceGetter(commitLggr, *params.pluginConfig.MedianPriceGetterConfig, priceGetterClients)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("creating median price getter: %w", err)
+		}
 	} else {
 		// Use dynamic price getter.
 		if params.pluginConfig.PriceGetterConfig == nil {
 			return nil, nil, nil, fmt.Errorf("priceGetterConfig is nil")
 		}
 
-		// Build price getter clients for all chains specified in the aggregator configurations.
+		// Build price getter clients for all chains specified in the aggregator and TWAP configurations.
 		// Some lanes (e.g. Wemix/Kroma) requires other clients than source and destination, since they use feeds from other chains.
-		priceGetterClients := map[uint64]pricegetter.DynamicPriceGetterClient{}
-		for _, aggCfg := range params.pluginConfig.PriceGetterConfig.AggregatorPrices {
-			chainID := aggCfg.ChainID
-			// Retrieve the chain.
-			chain, _, err2 := ccipconfig.GetChainByChainID(chainSet, chainID)
-			if err2 != nil {
-				return nil, nil, nil, fmt.Errorf("retrieving chain for chainID %d: %w", chainID, err2)
-			}
-			caller := rpclib.NewDynamicLimitedBatchCaller(
-				lggr,
-				chain.Client(),
-				rpclib.DefaultRpcBatchSizeLimit,
-				rpclib.DefaultRpcBatchBackOffMultiplier,
-				rpclib.DefaultMaxParallelRpcCalls,
-			)
-			priceGetterClients[chainID] = pricegetter.NewDynamicPriceGetterClient(caller)
+		priceGetterClients, err2 := newPriceGetterClients(lggr, chainSet, dynamicPriceGetterChainIDs(*params.pluginConfig.PriceGetterConfig))
+		if err2 != nil {
+			return nil, nil, nil, err2
 		}
 
-		priceGetter, err = pricegetter.NewDynamicPriceGetter(*params.pluginConfig.PriceGetterConfig, priceGetterClients)
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("creating dynamic price getter: %w", err)
+		dynamicPriceGetter, err2 := pricegetter.NewDynamicPriceGetter(*params.pluginConfig.PriceGetterConfig, priceGetterClients)
+		if err2 != nil {
+			return nil, nil, nil, fmt.Errorf("creating dynamic price getter: %w", err2)
 		}
+		if err2 = dynamicPriceGetter.VerifyUniswapV3Pools(ctx); err2 != nil {
+			return nil, nil, nil, fmt.Errorf("verifying uniswap v3 pools: %w", err2)
+		}
+		priceGetter = dynamicPriceGetter
 	}
 
 	// Load all the readers relevant for this plugin.
@@ -257,13 +271,16 @@ func jobSpecToCommitPluginConfig(ctx context.Context, lggr logger.Logger, jb job
 		cache.NewChainHealthcheck(
 			// Adding more details to Logger to make healthcheck logs more informative
 			// It's safe because healthcheck logs only in case of unhealthy state
-			lggr.With(
+			lggr.Named(fmt.Sprintf("ChainHealthcheck.%d", jb.ID)).With(
 				"onramp", onrampAddress,
 				"commitStore", params.commitStoreAddress,
 				"offramp", params.pluginConfig.OffRamp,
 			),
 			onRampReader,
 			commitStoreReader,
+			params.pluginConfig.ChainHealth,
+			cache.ChainSignals{HeadTracker: params.sourceChain.HeadTracker(), LogPoller: params.sourceChain.LogPoller()},
+			cache.ChainSignals{HeadTracker: params.destChain.HeadTracker(), LogPoller: params.destChain.LogPoller()},
 		),
 		ccip.CommitPluginLabel,
 		params.sourceChain.ID().Int64(),
@@ -290,6 +307,8 @@ func jobSpecToCommitPluginConfig(ctx context.Context, lggr logger.Logger, jb job
 			priceRegistryProvider: ccipdataprovider.NewEvmPriceRegistry(params.destChain.LogPoller(), params.destChain.Client(), commitLggr, ccip.CommitPluginLabel),
 			metricsCollector:      metricsCollector,
 			chainHealthcheck:      chainHealthcheck,
+			priceConsensus:        params.pluginConfig.PriceConsensus,
+			debugRecorder:         ccip.NewDebugRecorder(params.pluginConfig.DebugRounds),
 		}, &ccipcommon.BackfillArgs{
 			SourceLP:         params.sourceChain.LogPoller(),
 			DestLP:           params.destChain.LogPoller(),
@@ -346,3 +365,47 @@ func extractJobSpecParams(jb job.Job, chainSet legacyevm.LegacyChainContainer) (
 		destChain:            destChain,
 	}, nil
 }
+
+// newPriceGetterClients builds a price getter client for each of the given chains.
+// dynamicPriceGetterChainIDs returns the de-duplicated IDs of the chains read by the dynamic price getter,
+// i.e. the chains of the aggregators and of the Uniswap V3 TWAP pools.
+func dynamicPriceGetterChainIDs(cfg ccipconfig.DynamicPriceGetterConfig) []uint64 {
+	seen := make(map[uint64]struct{})
+	var chainIDs []uint64
+	addChainID := func(chainID uint64) {
+		if _, exists := seen[chainID]; !exists {
+			seen[chainID] = struct{}{}
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+	for _, aggCfg := range cfg.AggregatorPrices {
+		addChainID(aggCfg.ChainID)
+	}
+	for _, twapCfg := range cfg.UniswapV3TWAPPrices {
+		addChainID(twapCfg.ChainID)
+	}
+	return chainIDs
+}
+
+func newPriceGetterClients(lggr logger.Logger, chainSet legacyevm.LegacyChainContainer, chainIDs []uint64) (map[uint64]pricegetter.DynamicPriceGetterClient, error) {
+	priceGetterClients := map[uint64]pricegetter.DynamicPriceGetterClient{}
+	for _, chainID := range chainIDs {
+		if _, exists := priceGetterClients[chainID]; exists {
+			continue
+		}
+		// Retrieve the chain.
+		chain, _, err := ccipconfig.GetChainByChainID(chainSet, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving chain for chainID %d: %w", chainID, err)
+		}
+		caller := rpclib.NewDynamicLimitedBatchCaller(
+			lggr,
+			chain.Client(),
+			rpclib.DefaultRpcBatchSizeLimit,
+			rpclib.DefaultRpcBatchBackOffMultiplier,
+			rpclib.DefaultMaxParallelRpcCalls,
+		)
+		priceGetterClients[chainID] = pricegetter.NewDynamicPriceGetterClient(caller)
+	}
+	return priceGetterClients, nil
+}
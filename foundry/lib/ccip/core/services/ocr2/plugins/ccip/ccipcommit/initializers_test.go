@@ -2,16 +2,21 @@ package ccipcommit
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	legacyEvmORMMocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 )
 
 func TestGetCommitPluginFilterNamesFromSpec(t *testing.T) {
@@ -80,3 +85,34 @@ func TestGetCommitPluginFilterNamesFromSpec(t *testing.T) {
 	}
 
 }
+
+func TestNewPriceGetterClients_TWAPOnlyChain(t *testing.T) {
+	cfg := ccipconfig.DynamicPriceGetterConfig{
+		AggregatorPrices: map[common.Address]ccipconfig.AggregatorPriceConfig{
+			utils.RandomAddress(): {ChainID: 1, AggregatorContractAddress: utils.RandomAddress()},
+		},
+		UniswapV3TWAPPrices: map[common.Address]ccipconfig.UniswapV3TWAPPriceConfig{
+			// Pool on the aggregator chain.
+			utils.RandomAddress(): {ChainID: 1, PoolAddress: utils.RandomAddress()},
+			// Pools on a chain without aggregators.
+			utils.RandomAddress(): {ChainID: 2, PoolAddress: utils.RandomAddress()},
+			utils.RandomAddress(): {ChainID: 2, PoolAddress: utils.RandomAddress()},
+		},
+	}
+
+	chainIDs := dynamicPriceGetterChainIDs(cfg)
+	assert.ElementsMatch(t, []uint64{1, 2}, chainIDs)
+
+	chainSet := legacyEvmORMMocks.NewLegacyChainContainer(t)
+	for _, chainID := range chainIDs {
+		chain := legacyEvmORMMocks.NewChain(t)
+		chain.On("ID").Return(new(big.Int).SetUint64(chainID))
+		chain.On("Client").Return(evmclimocks.NewClient(t))
+		chainSet.On("Get", strconv.FormatUint(chainID, 10)).Return(chain, nil).Once()
+	}
+
+	clients, err := newPriceGetterClients(logger.TestLogger(t), chainSet, chainIDs)
+	require.NoError(t, err)
+	assert.Len(t, clients, 2)
+	assert.Contains(t, clients, uint64(2))
+}
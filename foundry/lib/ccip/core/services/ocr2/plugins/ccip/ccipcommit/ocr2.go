@@ -18,6 +18,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
@@ -66,6 +67,8 @@ type CommitPluginStaticConfig struct {
 	priceGetter      pricegetter.PriceGetter
 	metricsCollector ccip.PluginMetricsCollector
 	chainHealthcheck cache.ChainHealthcheck
+	priceConsensus   ccipconfig.PriceConsensusConfig
+	debugRecorder    *ccip.DebugRecorder
 }
 
 type CommitReportingPlugin struct {
@@ -84,8 +87,10 @@ type CommitReportingPlugin struct {
 	// Offchain
 	priceGetter      pricegetter.PriceGetter
 	metricsCollector ccip.PluginMetricsCollector
+	priceConsensus   ccipconfig.PriceConsensusConfig
 	// State
 	chainHealthcheck cache.ChainHealthcheck
+	debugRecorder    *ccip.DebugRecorder
 }
 
 // Query is not used by the CCIP Commit plugin.
@@ -97,7 +102,8 @@ func (r *CommitReportingPlugin) Query(context.Context, types.ReportTimestamp) (t
 // the token and gas price updates required. A valid report could contain a merkle
 // root and price updates. Price updates should never contain nil values, otherwise
 // the observation will be considered invalid and rejected.
-func (r *CommitReportingPlugin) Observation(ctx context.Context, epochAndRound types.ReportTimestamp, _ types.Query) (types.Observation, error) {
+func (r *CommitReportingPlugin) Observation(ctx context.Context, epochAndRound types.ReportTimestamp, _ types.Query) (observation types.Observation, err error) {
+	defer func() { r.debugRecorder.RecordObservation(epochAndRound, observation, err) }()
 	lggr := r.lggr.Named("CommitObservation")
 	if healthy, err := r.chainHealthcheck.IsHealthy(ctx); err != nil {
 		return nil, err
@@ -210,9 +216,23 @@ func (r *CommitReportingPlugin) generatePriceUpdates(
 	}
 	lggr.Infow("Raw token prices", "rawTokenPrices", rawTokenPricesUSD)
 
+	// Tokens skipped by a price getter opted in to skipping rejected prices are observed at their latest committed
+	// price, as long as it is within its heartbeat. They don't trigger an update and keep the observation complete.
+	var latestTokenPrices map[cciptypes.Address]update
+	if skipper, ok := r.priceGetter.(pricegetter.TokenPriceSkipper); ok && skipper.SkipsRejectedPrices() {
+		if latestTokenPrices, err = r.getLatestTokenPriceUpdates(ctx, time.Now()); err != nil {
+			return nil, nil, fmt.Errorf("get latest token prices: %w", err)
+		}
+	}
+	updatePolicy := r.tokenPriceUpdatePolicy()
+
 	// make sure that we got prices for all the tokens of our query
 	for _, token := range queryTokens {
 		if rawTokenPricesUSD[token] == nil {
+			latest, exists := latestTokenPrices[token]
+			if exists && token != r.sourceNative && time.Since(latest.timestamp) < updatePolicy.heartBeatOf(token) {
+				continue
+			}
 			return nil, nil, errors.Errorf("missing token price: %+v", token)
 		}
 	}
@@ -229,6 +249,11 @@ func (r *CommitReportingPlugin) generatePriceUpdates(
 
 	tokenPricesUSD = make(map[cciptypes.Address]*big.Int, len(rawTokenPricesUSD))
 	for i, token := range sortedChainTokens {
+		if rawTokenPricesUSD[token] == nil {
+			lggr.Warnw("Token price skipped by the price getter, observing its latest committed price", "token", token)
+			tokenPricesUSD[token] = latestTokenPrices[token].value
+			continue
+		}
 		tokenPricesUSD[token] = calculateUsdPer1e18TokenAmount(rawTokenPricesUSD[token], destTokensDecimals[i])
 	}
 
@@ -257,12 +282,12 @@ func calculateUsdPer1e18TokenAmount(price *big.Int, decimals uint8) *big.Int {
 	return tmp.Div(tmp, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
 }
 
-// Gets the latest token price updates based on logs within the heartbeat
+// Gets the latest token price updates based on logs within the longest token heartbeat
 // The updates returned by this function are guaranteed to not contain nil values.
 func (r *CommitReportingPlugin) getLatestTokenPriceUpdates(ctx context.Context, now time.Time) (map[cciptypes.Address]update, error) {
 	tokenPriceUpdates, err := r.destPriceRegistryReader.GetTokenPriceUpdatesCreatedAfter(
 		ctx,
-		now.Add(-r.offchainConfig.TokenPriceHeartBeat),
+		now.Add(-r.tokenPriceUpdatePolicy().maxHeartBeat()),
 		0,
 	)
 	if err != nil {
@@ -314,7 +339,8 @@ func (r *CommitReportingPlugin) getLatestGasPriceUpdate(ctx context.Context, now
 	return gasUpdate, nil
 }
 
-func (r *CommitReportingPlugin) Report(ctx context.Context, epochAndRound types.ReportTimestamp, _ types.Query, observations []types.AttributedObservation) (bool, types.Report, error) {
+func (r *CommitReportingPlugin) Report(ctx context.Context, epochAndRound types.ReportTimestamp, _ types.Query, observations []types.AttributedObservation) (shouldReport bool, _ types.Report, err error) {
+	defer func() { r.debugRecorder.RecordReport(epochAndRound, observations, shouldReport, err) }()
 	now := time.Now()
 	lggr := r.lggr.Named("CommitReport")
 	if healthy, err := r.chainHealthcheck.IsHealthy(ctx); err != nil {
@@ -345,6 +371,7 @@ func (r *CommitReportingPlugin) Report(ctx context.Context, epochAndRound types.
 	if err != nil {
 		return false, nil, err
 	}
+	r.debugRecorder.RecordConsensus(epochAndRound, agreedInterval)
 
 	tokenPrices, gasPrices, err := r.selectPriceUpdates(ctx, now, validObservations)
 	if err != nil {
@@ -521,35 +548,41 @@ func (r *CommitReportingPlugin) selectPriceUpdates(ctx context.Context, now time
 // Note priceUpdates must be deterministic.
 // The provided latestTokenPrices should not contain nil values.
 func (r *CommitReportingPlugin) calculatePriceUpdates(observations []ccip.CommitObservation, latestGasPrice update, latestTokenPrices map[cciptypes.Address]update) ([]cciptypes.TokenPrice, []cciptypes.GasPrice, error) {
-	priceObservations := make(map[cciptypes.Address][]*big.Int)
+	priceObservations := make(map[cciptypes.Address][]observedPrice)
 	var sourceGasObservations []*big.Int
 
 	for _, obs := range observations {
 		sourceGasObservations = append(sourceGasObservations, obs.SourceGasPriceUSD)
 		// iterate over any token which price is included in observations
 		for token, price := range obs.TokenPricesUSD {
-			priceObservations[token] = append(priceObservations[token], price)
+			priceObservations[token] = append(priceObservations[token], observedPrice{observer: obs.Observer, price: price})
 		}
 	}
 
+	consensus, err := newPriceConsensus(r.priceConsensus, r.F)
+	if err != nil {
+		return nil, nil, err
+	}
+	updatePolicy := r.tokenPriceUpdatePolicy()
+
 	var tokenPriceUpdates []cciptypes.TokenPrice
 	for token, tokenPriceObservations := range priceObservations {
-		medianPrice := ccipcalc.BigIntSortedMiddle(tokenPriceObservations)
+		consensusPrice := consensus.aggregate(tokenPriceObservations)
 
 		latestTokenPrice, exists := latestTokenPrices[token]
 		if exists {
-			tokenPriceUpdatedRecently := time.Since(latestTokenPrice.timestamp) < r.offchainConfig.TokenPriceHeartBeat
-			tokenPriceNotChanged := !ccipcalc.Deviates(medianPrice, latestTokenPrice.value, int64(r.offchainConfig.TokenPriceDeviationPPB))
+			tokenPriceUpdatedRecently := time.Since(latestTokenPrice.timestamp) < updatePolicy.heartBeatOf(token)
+			tokenPriceNotChanged := !ccipcalc.Deviates(consensusPrice, latestTokenPrice.value, updatePolicy.deviationPPBOf(token))
 			if tokenPriceUpdatedRecently && tokenPriceNotChanged {
 				r.lggr.Debugw("price was updated recently, skipping the update",
-					"token", token, "newPrice", medianPrice, "existingPrice", latestTokenPrice.value)
+					"token", token, "newPrice", consensusPrice, "existingPrice", latestTokenPrice.value)
 				continue // skip the update if we recently had a price update close to the new value
 			}
 		}
 
 		tokenPriceUpdates = append(tokenPriceUpdates, cciptypes.TokenPrice{
 			Token: token,
-			Value: medianPrice,
+			Value: consensusPrice,
 		})
 	}
 
@@ -632,7 +665,16 @@ func (r *CommitReportingPlugin) buildReport(ctx context.Context, lggr logger.Log
 	}, nil
 }
 
-func (r *CommitReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, reportTimestamp types.ReportTimestamp, report types.Report) (bool, error) {
+func (r *CommitReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, reportTimestamp types.ReportTimestamp, report types.Report) (accept bool, err error) {
+	var rejectReason string
+	defer func() {
+		if err != nil {
+			rejectReason = err.Error()
+		}
+		if !accept {
+			r.debugRecorder.RecordRejectedReport(reportTimestamp, rejectReason)
+		}
+	}()
 	parsedReport, err := r.commitStoreReader.DecodeCommitReport(ctx, report)
 	if err != nil {
 		return false, err
@@ -648,6 +690,7 @@ func (r *CommitReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context,
 	// Empty report, should not be put on chain
 	if parsedReport.MerkleRoot == [32]byte{} && len(parsedReport.GasPrices) == 0 && len(parsedReport.TokenPrices) == 0 {
 		lggr.Warn("Empty report, should not be put on chain")
+		rejectReason = "empty report"
 		return false, nil
 	}
 
@@ -659,6 +702,7 @@ func (r *CommitReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context,
 
 	if r.isStaleReport(ctx, lggr, parsedReport, reportTimestamp) {
 		lggr.Infow("Rejecting stale report")
+		rejectReason = "stale report"
 		return false, nil
 	}
 
@@ -794,9 +838,10 @@ func (r *CommitReportingPlugin) isStaleTokenPrices(ctx context.Context, lggr log
 		return true
 	}
 
+	updatePolicy := r.tokenPriceUpdatePolicy()
 	for _, tokenUpdate := range priceUpdates {
 		latestUpdate, ok := latestTokenPriceUpdates[tokenUpdate.Token]
-		priceEqual := ok && !ccipcalc.Deviates(tokenUpdate.Value, latestUpdate.value, int64(r.offchainConfig.TokenPriceDeviationPPB))
+		priceEqual := ok && !ccipcalc.Deviates(tokenUpdate.Value, latestUpdate.value, updatePolicy.deviationPPBOf(tokenUpdate.Token))
 
 		if !priceEqual {
 			lggr.Infow("Found non-stale token price", "token", tokenUpdate.Token, "usdPerToken", tokenUpdate.Value, "latestUpdate", latestUpdate.value)
@@ -809,6 +854,10 @@ func (r *CommitReportingPlugin) isStaleTokenPrices(ctx context.Context, lggr log
 	return true
 }
 
+func (r *CommitReportingPlugin) tokenPriceUpdatePolicy() tokenPriceUpdatePolicy {
+	return newTokenPriceUpdatePolicy(r.offchainConfig, r.priceConsensus)
+}
+
 func (r *CommitReportingPlugin) Close() error {
 	return nil
 }
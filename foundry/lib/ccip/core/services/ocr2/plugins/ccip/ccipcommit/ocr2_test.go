@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
@@ -260,7 +261,7 @@ func TestCommitReportingPlugin_Observation(t *testing.T) {
 			p.gasPriceEstimator = gasPriceEstimator
 			p.offchainConfig.PriceReportingDisabled = tc.priceReportingDisabled
 			p.metricsCollector = ccip.NoopMetricsCollector
-			p.chainHealthcheck = cache.NewChainHealthcheck(p.lggr, onRampReader, commitStoreReader)
+			p.chainHealthcheck = cache.NewChainHealthcheck(p.lggr, onRampReader, commitStoreReader, ccipconfig.ChainHealthConfig{}, cache.ChainSignals{}, cache.ChainSignals{})
 
 			obs, err := p.Observation(ctx, tc.epochAndRound, types.Query{})
 
@@ -653,6 +654,27 @@ func TestCommitReportingPlugin_ShouldAcceptFinalizedReport(t *testing.T) {
 		assert.False(t, shouldAccept)
 	})
 
+	t.Run("rejection reasons are recorded for debugging", func(t *testing.T) {
+		p := newPlugin()
+		p.debugRecorder = ccip.NewDebugRecorder(10)
+
+		commitStoreReader := ccipdatamocks.NewCommitStoreReader(t)
+		p.commitStoreReader = commitStoreReader
+		commitStoreReader.On("DecodeCommitReport", mock.Anything, []byte("whatever")).
+			Return(cciptypes.CommitStoreReport{}, errors.New("unable to decode report"))
+		commitStoreReader.On("DecodeCommitReport", mock.Anything, mock.Anything).Return(cciptypes.CommitStoreReport{}, nil)
+
+		_, err := p.ShouldAcceptFinalizedReport(ctx, types.ReportTimestamp{Epoch: 1}, []byte("whatever"))
+		assert.Error(t, err)
+		_, err = p.ShouldAcceptFinalizedReport(ctx, types.ReportTimestamp{Epoch: 2}, []byte("empty"))
+		assert.NoError(t, err)
+
+		rounds := p.debugRecorder.Rounds()
+		require.Len(t, rounds, 2)
+		assert.Equal(t, []string{"unable to decode report"}, rounds[0].Rejections)
+		assert.Equal(t, []string{"empty report"}, rounds[1].Rejections)
+	})
+
 	t.Run("stale report should not be accepted", func(t *testing.T) {
 		onChainSeqNum := uint64(100)
 
@@ -1004,6 +1026,7 @@ func TestCommitReportingPlugin_calculatePriceUpdates(t *testing.T) {
 		execGasPriceDeviationPPB int64
 		tokenPriceHeartBeat      config.Duration
 		tokenPriceDeviationPPB   uint32
+		priceConsensus           ccipconfig.PriceConsensusConfig
 		expTokenUpdates          []cciptypes.TokenPrice
 		expGasUpdates            []cciptypes.GasPrice
 	}{
@@ -1125,6 +1148,94 @@ func TestCommitReportingPlugin_calculatePriceUpdates(t *testing.T) {
 			// We expect a gas update because no latest
 			expGasUpdates: []cciptypes.GasPrice{{DestChainSelector: defaultSourceChainSelector, Value: big.NewInt(0)}},
 		},
+		{
+			name: "trimmed mean discards the f lowest and highest token prices",
+			commitObservations: []ccip.CommitObservation{
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: big.NewInt(1)}, SourceGasPriceUSD: val1e18(0)},
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: big.NewInt(12)}, SourceGasPriceUSD: val1e18(0)},
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: big.NewInt(14)}, SourceGasPriceUSD: val1e18(0)},
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: big.NewInt(100)}, SourceGasPriceUSD: val1e18(0)},
+			},
+			f:              1,
+			priceConsensus: ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusTrimmedMean},
+			expTokenUpdates: []cciptypes.TokenPrice{
+				{Token: feeToken1, Value: big.NewInt(13)},
+			},
+			// We expect a gas update because no latest
+			expGasUpdates: []cciptypes.GasPrice{{DestChainSelector: defaultSourceChainSelector, Value: big.NewInt(0)}},
+		},
+		{
+			name: "weighted median favours the token prices of the heaviest oracles",
+			commitObservations: []ccip.CommitObservation{
+				{Observer: 0, TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: big.NewInt(10)}, SourceGasPriceUSD: val1e18(0)},
+				{Observer: 1, TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: big.NewInt(12)}, SourceGasPriceUSD: val1e18(0)},
+				{Observer: 2, TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: big.NewInt(14)}, SourceGasPriceUSD: val1e18(0)},
+			},
+			f: 1,
+			priceConsensus: ccipconfig.PriceConsensusConfig{
+				Strategy:      ccipconfig.PriceConsensusWeightedMedian,
+				OracleWeights: map[uint8]uint32{2: 2},
+			},
+			expTokenUpdates: []cciptypes.TokenPrice{
+				{Token: feeToken1, Value: big.NewInt(14)},
+			},
+			// We expect a gas update because no latest
+			expGasUpdates: []cciptypes.GasPrice{{DestChainSelector: defaultSourceChainSelector, Value: big.NewInt(0)}},
+		},
+		{
+			name: "token price update included because it deviates more than the deviation of the token",
+			commitObservations: []ccip.CommitObservation{
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: val1e18(11)}, SourceGasPriceUSD: val1e18(0)},
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: val1e18(12)}, SourceGasPriceUSD: val1e18(0)},
+			},
+			f:                        1,
+			gasPriceHeartBeat:        *config.MustNewDuration(time.Hour),
+			daGasPriceDeviationPPB:   20e7,
+			execGasPriceDeviationPPB: 20e7,
+			tokenPriceHeartBeat:      *config.MustNewDuration(time.Hour),
+			tokenPriceDeviationPPB:   20e7,
+			priceConsensus: ccipconfig.PriceConsensusConfig{
+				Tokens: map[common.Address]ccipconfig.TokenPriceUpdateConfig{common.HexToAddress("0xa"): {DeviationPPB: 1e8}},
+			},
+			latestTokenPrices: map[cciptypes.Address]update{
+				feeToken1: {
+					timestamp: time.Now().Add(-30 * time.Minute),
+					value:     val1e18(10),
+				},
+			},
+			expTokenUpdates: []cciptypes.TokenPrice{
+				{Token: feeToken1, Value: val1e18(12)},
+			},
+			// We expect a gas update because no latest
+			expGasUpdates: []cciptypes.GasPrice{{DestChainSelector: defaultSourceChainSelector, Value: big.NewInt(0)}},
+		},
+		{
+			name: "token price update included because the heartbeat of the token elapsed",
+			commitObservations: []ccip.CommitObservation{
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: val1e18(11)}, SourceGasPriceUSD: val1e18(0)},
+				{TokenPricesUSD: map[cciptypes.Address]*big.Int{feeToken1: val1e18(12)}, SourceGasPriceUSD: val1e18(0)},
+			},
+			f:                        1,
+			gasPriceHeartBeat:        *config.MustNewDuration(time.Hour),
+			daGasPriceDeviationPPB:   20e7,
+			execGasPriceDeviationPPB: 20e7,
+			tokenPriceHeartBeat:      *config.MustNewDuration(time.Hour),
+			tokenPriceDeviationPPB:   20e7,
+			priceConsensus: ccipconfig.PriceConsensusConfig{
+				Tokens: map[common.Address]ccipconfig.TokenPriceUpdateConfig{common.HexToAddress("0xa"): {HeartBeatSeconds: 600}},
+			},
+			latestTokenPrices: map[cciptypes.Address]update{
+				feeToken1: {
+					timestamp: time.Now().Add(-30 * time.Minute),
+					value:     val1e18(10),
+				},
+			},
+			expTokenUpdates: []cciptypes.TokenPrice{
+				{Token: feeToken1, Value: val1e18(12)},
+			},
+			// We expect a gas update because no latest
+			expGasUpdates: []cciptypes.GasPrice{{DestChainSelector: defaultSourceChainSelector, Value: big.NewInt(0)}},
+		},
 		{
 			name: "gas price and token price both included because they are not close to the latest",
 			commitObservations: []ccip.CommitObservation{
@@ -1256,6 +1367,7 @@ func TestCommitReportingPlugin_calculatePriceUpdates(t *testing.T) {
 				},
 				gasPriceEstimator: estimator,
 				F:                 tc.f,
+				priceConsensus:    tc.priceConsensus,
 			}
 			gotTokens, gotGas, err := r.calculatePriceUpdates(tc.commitObservations, tc.latestGasPrice, tc.latestTokenPrices)
 
@@ -1470,6 +1582,91 @@ func TestCommitReportingPlugin_generatePriceUpdates(t *testing.T) {
 	}
 }
 
+// skippingPriceGetter is a price getter opted in to skipping the tokens whose price it rejected.
+type skippingPriceGetter struct {
+	*pricegetter.MockPriceGetter
+}
+
+func (skippingPriceGetter) SkipsRejectedPrices() bool { return true }
+
+func TestCommitReportingPlugin_generatePriceUpdatesSkippedTokens(t *testing.T) {
+	val1e18 := func(val int64) *big.Int { return new(big.Int).Mul(big.NewInt(1e18), big.NewInt(val)) }
+	sourceNative := ccipcalc.HexToAddress("1000")
+	skippedToken := ccipcalc.HexToAddress("2000")
+	destTokens := []cciptypes.Address{sourceNative, skippedToken}
+
+	testCases := []struct {
+		name              string
+		priceGetter       func(*pricegetter.MockPriceGetter) pricegetter.PriceGetter
+		priceRespData     map[cciptypes.Address]*big.Int
+		latestPriceUpdate *cciptypes.TokenPriceUpdate
+		expTokenPricesUSD map[cciptypes.Address]*big.Int
+		expErr            bool
+	}{
+		{
+			name:              "skipped token observed at its latest committed price",
+			priceGetter:       func(m *pricegetter.MockPriceGetter) pricegetter.PriceGetter { return skippingPriceGetter{m} },
+			priceRespData:     map[cciptypes.Address]*big.Int{sourceNative: val1e18(100)},
+			latestPriceUpdate: &cciptypes.TokenPriceUpdate{TokenPrice: cciptypes.TokenPrice{Token: skippedToken, Value: val1e18(7)}, TimestampUnixSec: big.NewInt(time.Now().Add(-time.Minute).Unix())},
+			expTokenPricesUSD: map[cciptypes.Address]*big.Int{sourceNative: val1e18(100), skippedToken: val1e18(7)},
+		},
+		{
+			name:              "skipped token without a committed price within its heartbeat",
+			priceGetter:       func(m *pricegetter.MockPriceGetter) pricegetter.PriceGetter { return skippingPriceGetter{m} },
+			priceRespData:     map[cciptypes.Address]*big.Int{sourceNative: val1e18(100)},
+			latestPriceUpdate: &cciptypes.TokenPriceUpdate{TokenPrice: cciptypes.TokenPrice{Token: skippedToken, Value: val1e18(7)}, TimestampUnixSec: big.NewInt(time.Now().Add(-2 * time.Hour).Unix())},
+			expErr:            true,
+		},
+		{
+			name:              "skipped source native price",
+			priceGetter:       func(m *pricegetter.MockPriceGetter) pricegetter.PriceGetter { return skippingPriceGetter{m} },
+			priceRespData:     map[cciptypes.Address]*big.Int{skippedToken: val1e18(7)},
+			latestPriceUpdate: &cciptypes.TokenPriceUpdate{TokenPrice: cciptypes.TokenPrice{Token: sourceNative, Value: val1e18(100)}, TimestampUnixSec: big.NewInt(time.Now().Add(-time.Minute).Unix())},
+			expErr:            true,
+		},
+		{
+			name:          "price getter not opted in",
+			priceGetter:   func(m *pricegetter.MockPriceGetter) pricegetter.PriceGetter { return m },
+			priceRespData: map[cciptypes.Address]*big.Int{sourceNative: val1e18(100)},
+			expErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priceGetter := pricegetter.NewMockPriceGetter(t)
+			priceGetter.On("TokenPricesUSD", mock.Anything, destTokens).Return(tc.priceRespData, nil)
+
+			destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+			destPriceReg.On("GetTokensDecimals", mock.Anything, destTokens).Return([]uint8{18, 18}, nil).Maybe()
+			if tc.latestPriceUpdate != nil {
+				destPriceReg.On("GetTokenPriceUpdatesCreatedAfter", mock.Anything, mock.Anything, 0).
+					Return([]cciptypes.TokenPriceUpdateWithTxMeta{{TokenPriceUpdate: *tc.latestPriceUpdate}}, nil)
+			}
+
+			gasPriceEstimator := prices.NewMockGasPriceEstimatorCommit(t)
+			gasPriceEstimator.On("GetGasPrice", mock.Anything).Return(big.NewInt(10), nil).Maybe()
+			gasPriceEstimator.On("DenoteInUSD", mock.Anything, mock.Anything).Return(big.NewInt(1000), nil).Maybe()
+
+			p := &CommitReportingPlugin{
+				sourceNative:            sourceNative,
+				priceGetter:             tc.priceGetter(priceGetter),
+				gasPriceEstimator:       gasPriceEstimator,
+				destPriceRegistryReader: destPriceReg,
+				offchainConfig:          cciptypes.CommitOffchainConfig{TokenPriceHeartBeat: time.Hour},
+			}
+
+			_, tokenPricesUSD, err := p.generatePriceUpdates(context.Background(), logger.TestLogger(t), destTokens)
+			if tc.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expTokenPricesUSD, tokenPricesUSD)
+		})
+	}
+}
+
 func TestCommitReportingPlugin_isStaleReport(t *testing.T) {
 	ctx := context.Background()
 	lggr := logger.TestLogger(t)
@@ -0,0 +1,197 @@
+package ccipcommit
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+)
+
+// observedPrice is a token price observed by a single oracle.
+type observedPrice struct {
+	observer commontypes.OracleID
+	price    *big.Int
+}
+
+// priceConsensus aggregates the prices of a token observed by the oracles into the price to report.
+// Implementations must be deterministic, all the oracles have to aggregate the same observations into the same price.
+type priceConsensus interface {
+	aggregate(observations []observedPrice) *big.Int
+}
+
+func newPriceConsensus(cfg ccipconfig.PriceConsensusConfig, f int) (priceConsensus, error) {
+	switch cfg.Strategy {
+	case "", ccipconfig.PriceConsensusMedian:
+		return medianPriceConsensus{}, nil
+	case ccipconfig.PriceConsensusTrimmedMean:
+		return trimmedMeanPriceConsensus{f: f}, nil
+	case ccipconfig.PriceConsensusWeightedMedian:
+		weights := make(map[commontypes.OracleID]uint64, len(cfg.OracleWeights))
+		for oracle, weight := range cfg.OracleWeights {
+			weights[commontypes.OracleID(oracle)] = uint64(weight)
+		}
+		return weightedMedianPriceConsensus{weights: weights}, nil
+	default:
+		return nil, fmt.Errorf("unknown price consensus strategy %q", cfg.Strategy)
+	}
+}
+
+// validatePriceConsensus checks that the f faulty oracles of the n oracles of the lane can't set the reported prices
+// on their own. With the weighted median, the f largest weights must sum to less than half of the total weight.
+func validatePriceConsensus(cfg ccipconfig.PriceConsensusConfig, f, n int) error {
+	if cfg.Strategy != ccipconfig.PriceConsensusWeightedMedian {
+		return nil
+	}
+	weights := make([]uint64, n)
+	for oracle := range weights {
+		weights[oracle] = 1
+	}
+	for oracle, weight := range cfg.OracleWeights {
+		if int(oracle) >= n {
+			return fmt.Errorf("weight of oracle %d, the lane only has %d oracles", oracle, n)
+		}
+		weights[oracle] = uint64(weight)
+	}
+	sort.Slice(weights, func(i, j int) bool { return weights[i] > weights[j] })
+
+	var total, largest uint64
+	for i, weight := range weights {
+		total += weight
+		if i < f {
+			largest += weight
+		}
+	}
+	if 2*largest >= total {
+		return fmt.Errorf("the %d largest oracle weights sum to %d, at least half of the total weight %d", f, largest, total)
+	}
+	return nil
+}
+
+// medianPriceConsensus picks the middle price, the upper one for an even number of observations.
+type medianPriceConsensus struct{}
+
+func (medianPriceConsensus) aggregate(observations []observedPrice) *big.Int {
+	prices := make([]*big.Int, len(observations))
+	for i, obs := range observations {
+		prices[i] = obs.price
+	}
+	return ccipcalc.BigIntSortedMiddle(prices)
+}
+
+// trimmedMeanPriceConsensus discards the f lowest and f highest prices, which may be set by faulty oracles,
+// and averages the others. Without more than 2f observations nothing would be left, it falls back to the median.
+type trimmedMeanPriceConsensus struct {
+	f int
+}
+
+func (c trimmedMeanPriceConsensus) aggregate(observations []observedPrice) *big.Int {
+	if len(observations) <= 2*c.f {
+		return medianPriceConsensus{}.aggregate(observations)
+	}
+	sorted := sortedByPrice(observations)
+	kept := sorted[c.f : len(sorted)-c.f]
+
+	sum := big.NewInt(0)
+	for _, obs := range kept {
+		sum.Add(sum, obs.price)
+	}
+	return sum.Div(sum, big.NewInt(int64(len(kept))))
+}
+
+// weightedMedianPriceConsensus picks the lowest price whose cumulative weight exceeds half of the total weight,
+// oracles without a configured weight have a weight of 1. With equal weights it picks the same price as the median.
+type weightedMedianPriceConsensus struct {
+	weights map[commontypes.OracleID]uint64
+}
+
+func (c weightedMedianPriceConsensus) aggregate(observations []observedPrice) *big.Int {
+	if len(observations) == 0 {
+		return nil
+	}
+	sorted := sortedByPrice(observations)
+
+	var total uint64
+	for _, obs := range sorted {
+		total += c.weight(obs.observer)
+	}
+	var cumulative uint64
+	for _, obs := range sorted {
+		cumulative += c.weight(obs.observer)
+		if 2*cumulative > total {
+			return obs.price
+		}
+	}
+	return sorted[len(sorted)-1].price
+}
+
+func (c weightedMedianPriceConsensus) weight(oracle commontypes.OracleID) uint64 {
+	if weight, ok := c.weights[oracle]; ok {
+		return weight
+	}
+	return 1
+}
+
+// sortedByPrice returns a copy of the observations sorted by price, then by observer so that the order is deterministic.
+func sortedByPrice(observations []observedPrice) []observedPrice {
+	sorted := make([]observedPrice, len(observations))
+	copy(sorted, observations)
+	sort.Slice(sorted, func(i, j int) bool {
+		if cmp := sorted[i].price.Cmp(sorted[j].price); cmp != 0 {
+			return cmp < 0
+		}
+		return sorted[i].observer < sorted[j].observer
+	})
+	return sorted
+}
+
+// tokenPriceUpdatePolicy decides when a token price is reported, from the offchain config deviation and heartbeat
+// overridden per token by the job spec.
+type tokenPriceUpdatePolicy struct {
+	deviationPPB int64
+	heartBeat    time.Duration
+	tokens       map[cciptypes.Address]ccipconfig.TokenPriceUpdateConfig
+}
+
+func newTokenPriceUpdatePolicy(offchainConfig cciptypes.CommitOffchainConfig, cfg ccipconfig.PriceConsensusConfig) tokenPriceUpdatePolicy {
+	tokens := make(map[cciptypes.Address]ccipconfig.TokenPriceUpdateConfig, len(cfg.Tokens))
+	for token, tokenCfg := range cfg.Tokens {
+		tokens[ccipcalc.EvmAddrToGeneric(token)] = tokenCfg
+	}
+	return tokenPriceUpdatePolicy{
+		deviationPPB: int64(offchainConfig.TokenPriceDeviationPPB),
+		heartBeat:    offchainConfig.TokenPriceHeartBeat,
+		tokens:       tokens,
+	}
+}
+
+// deviationPPBOf returns the deviation from the latest price of the token triggering an update.
+func (p tokenPriceUpdatePolicy) deviationPPBOf(token cciptypes.Address) int64 {
+	if tokenCfg, ok := p.tokens[token]; ok && tokenCfg.DeviationPPB > 0 {
+		return int64(tokenCfg.DeviationPPB)
+	}
+	return p.deviationPPB
+}
+
+// heartBeatOf returns the maximum age of the latest price of the token before it's updated regardless of deviation.
+func (p tokenPriceUpdatePolicy) heartBeatOf(token cciptypes.Address) time.Duration {
+	if tokenCfg, ok := p.tokens[token]; ok && tokenCfg.HeartBeatSeconds > 0 {
+		return time.Duration(tokenCfg.HeartBeatSeconds) * time.Second
+	}
+	return p.heartBeat
+}
+
+// maxHeartBeat returns the longest heartbeat of all the tokens, the age of the price updates to look back at.
+func (p tokenPriceUpdatePolicy) maxHeartBeat() time.Duration {
+	maxHeartBeat := p.heartBeat
+	for token := range p.tokens {
+		maxHeartBeat = max(maxHeartBeat, p.heartBeatOf(token))
+	}
+	return maxHeartBeat
+}
@@ -0,0 +1,157 @@
+package ccipcommit
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+)
+
+func observedPrices(prices ...int64) []observedPrice {
+	observations := make([]observedPrice, len(prices))
+	for i, price := range prices {
+		observations[i] = observedPrice{observer: commontypes.OracleID(i), price: big.NewInt(price)}
+	}
+	return observations
+}
+
+func Test_priceConsensus(t *testing.T) {
+	testCases := []struct {
+		name         string
+		config       ccipconfig.PriceConsensusConfig
+		f            int
+		observations []observedPrice
+		exp          *big.Int
+	}{
+		{
+			name:         "median by default",
+			f:            1,
+			observations: observedPrices(30, 10, 20, 40),
+			exp:          big.NewInt(30),
+		},
+		{
+			name:         "trimmed mean",
+			config:       ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusTrimmedMean},
+			f:            1,
+			observations: observedPrices(1000, 10, 20, 1, 30),
+			exp:          big.NewInt(20),
+		},
+		{
+			name:         "trimmed mean rounds down",
+			config:       ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusTrimmedMean},
+			f:            1,
+			observations: observedPrices(1, 10, 11, 100),
+			exp:          big.NewInt(10),
+		},
+		{
+			name:         "trimmed mean of 2f+1 observations",
+			config:       ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusTrimmedMean},
+			f:            2,
+			observations: observedPrices(1000, 10, 20, 1, 30),
+			exp:          big.NewInt(20),
+		},
+		{
+			name:         "trimmed mean falls back to the median without more than 2f observations",
+			config:       ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusTrimmedMean},
+			f:            2,
+			observations: observedPrices(1, 10, 11, 1000),
+			exp:          big.NewInt(11),
+		},
+		{
+			name:         "trimmed mean of a single observation",
+			config:       ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusTrimmedMean},
+			f:            1,
+			observations: observedPrices(10),
+			exp:          big.NewInt(10),
+		},
+		{
+			name:         "weighted median with equal weights is the median",
+			config:       ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusWeightedMedian},
+			f:            1,
+			observations: observedPrices(30, 10, 20, 40),
+			exp:          big.NewInt(30),
+		},
+		{
+			name: "weighted median",
+			config: ccipconfig.PriceConsensusConfig{
+				Strategy:      ccipconfig.PriceConsensusWeightedMedian,
+				OracleWeights: map[uint8]uint32{0: 3, 3: 2},
+			},
+			f:            1,
+			observations: observedPrices(10, 20, 30, 40),
+			exp:          big.NewInt(20),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consensus, err := newPriceConsensus(tc.config, tc.f)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, consensus.aggregate(tc.observations))
+		})
+	}
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, err := newPriceConsensus(ccipconfig.PriceConsensusConfig{Strategy: "mode"}, 1)
+		require.Error(t, err)
+	})
+}
+
+func Test_validatePriceConsensus(t *testing.T) {
+	weighted := func(weights map[uint8]uint32) ccipconfig.PriceConsensusConfig {
+		return ccipconfig.PriceConsensusConfig{Strategy: ccipconfig.PriceConsensusWeightedMedian, OracleWeights: weights}
+	}
+
+	testCases := []struct {
+		name   string
+		config ccipconfig.PriceConsensusConfig
+		f, n   int
+		expErr string
+	}{
+		{name: "median", config: ccipconfig.PriceConsensusConfig{}, f: 1, n: 4},
+		{name: "equal weights", config: weighted(nil), f: 1, n: 4},
+		{name: "largest weight below half", config: weighted(map[uint8]uint32{0: 3, 3: 2}), f: 1, n: 4},
+		{name: "largest weight reaching half", config: weighted(map[uint8]uint32{0: 4, 3: 2}), f: 1, n: 4, expErr: "the 1 largest oracle weights sum to 4, at least half of the total weight 8"},
+		{name: "f largest weights above half", config: weighted(map[uint8]uint32{0: 4, 3: 4}), f: 2, n: 7, expErr: "the 2 largest oracle weights sum to 8, at least half of the total weight 13"},
+		{name: "unknown oracle", config: weighted(map[uint8]uint32{4: 1}), f: 1, n: 4, expErr: "weight of oracle 4, the lane only has 4 oracles"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePriceConsensus(tc.config, tc.f, tc.n)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_tokenPriceUpdatePolicy(t *testing.T) {
+	overridden := common.HexToAddress("0xa")
+	other := cciptypes.Address("0x000000000000000000000000000000000000000B")
+
+	policy := newTokenPriceUpdatePolicy(
+		cciptypes.CommitOffchainConfig{TokenPriceDeviationPPB: 5e7, TokenPriceHeartBeat: time.Hour},
+		ccipconfig.PriceConsensusConfig{Tokens: map[common.Address]ccipconfig.TokenPriceUpdateConfig{
+			overridden: {DeviationPPB: 1e6, HeartBeatSeconds: 24 * 3600},
+		}},
+	)
+
+	assert.Equal(t, int64(1e6), policy.deviationPPBOf(ccipcalc.EvmAddrToGeneric(overridden)))
+	assert.Equal(t, 24*time.Hour, policy.heartBeatOf(ccipcalc.EvmAddrToGeneric(overridden)))
+	assert.Equal(t, int64(5e7), policy.deviationPPBOf(other))
+	assert.Equal(t, time.Hour, policy.heartBeatOf(other))
+	assert.Equal(t, 24*time.Hour, policy.maxHeartBeat())
+}
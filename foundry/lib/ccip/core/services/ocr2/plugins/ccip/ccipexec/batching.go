@@ -3,14 +3,18 @@ package ccipexec
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/pkg/hashlib"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/pkg/merklemulti"
@@ -147,6 +151,7 @@ const (
 	InvalidNonce                         messageStatus = "invalid_nonce"
 	AggregateTokenValueComputeError      messageStatus = "aggregate_token_value_compute_error"
 	AggregateTokenLimitExceeded          messageStatus = "aggregate_token_limit_exceeded"
+	TokenPoolRateLimitExceeded           messageStatus = "token_pool_rate_limit_exceeded"
 	TokenDataNotReady                    messageStatus = "token_data_not_ready"
 	TokenDataFetchError                  messageStatus = "token_data_fetch_error"
 	TokenNotInDestTokenPrices            messageStatus = "token_not_in_dest_token_prices"
@@ -193,3 +198,108 @@ func (m *batchBuildContainer) addToBatch(msg cciptypes.EVM2EVMOnRampCCIPSendRequ
 func (m *batchBuildContainer) addState(msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta, state messageStatus) {
 	m.statuses = append(m.statuses, newMessageExecState(msg.SequenceNumber, msg.MessageID, state))
 }
+
+// batchOrdering decides the order the messages of a commit report are considered for the execution batch in.
+// The zero value keeps the sequence number order.
+type batchOrdering struct {
+	policy          string
+	prioritySenders map[cciptypes.Address]struct{}
+}
+
+func newBatchOrdering(cfg ccipconfig.BatchOrderingConfig) batchOrdering {
+	prioritySenders := make(map[cciptypes.Address]struct{}, len(cfg.PrioritySenders))
+	for _, sender := range cfg.PrioritySenders {
+		prioritySenders[ccipcalc.EvmAddrToGeneric(sender)] = struct{}{}
+	}
+	return batchOrdering{policy: cfg.Policy, prioritySenders: prioritySenders}
+}
+
+// score returns the function scoring the messages for the ordering policy, nil when the policy doesn't score messages.
+// Messages that can't be scored, e.g. because of a missing price, score zero and are left to buildBatch to skip.
+func (o batchOrdering) score(
+	numRequests int,
+	sourceTokenPricesUSD map[cciptypes.Address]*big.Int,
+	destTokenPricesUSD map[cciptypes.Address]*big.Int,
+	sourceToDestToken map[cciptypes.Address]cciptypes.Address,
+) func(cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta) *big.Rat {
+	switch o.policy {
+	case ccipconfig.BatchOrderingFeePerGas:
+		return func(msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta) *big.Rat {
+			feeTokenPrice, exists := sourceTokenPricesUSD[msg.FeeToken]
+			if !exists || msg.FeeTokenAmount == nil {
+				return new(big.Rat)
+			}
+			messageMaxGas, err := calculateMessageMaxGas(msg.GasLimit, numRequests, len(msg.Data), len(msg.TokenAmounts))
+			if err != nil || messageMaxGas == 0 {
+				return new(big.Rat)
+			}
+			feeUSD := new(big.Int).Mul(msg.FeeTokenAmount, feeTokenPrice)
+			return new(big.Rat).SetFrac(feeUSD, new(big.Int).SetUint64(messageMaxGas))
+		}
+	case ccipconfig.BatchOrderingTokenValue:
+		return func(msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta) *big.Rat {
+			// buildBatch logs the missing prices when it computes the value again.
+			value, err := aggregateTokenValue(logger.NullLogger, destTokenPricesUSD, sourceToDestToken, msg.TokenAmounts)
+			if err != nil {
+				return new(big.Rat)
+			}
+			return new(big.Rat).SetInt(value)
+		}
+	default:
+		return nil
+	}
+}
+
+// order returns the messages in the order they are considered for the batch. It repeatedly takes the best of the
+// next pending message of every sender, so that priority senders and higher scores are packed first while the
+// messages of each sender keep their sequence number order, which the offRamp enforces through the nonces.
+// Ties are broken by sequence number, msgs must be sorted by sequence number.
+func (o batchOrdering) order(
+	msgs []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta,
+	score func(cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta) *big.Rat,
+) []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta {
+	if len(o.prioritySenders) == 0 && score == nil {
+		return msgs
+	}
+
+	scores := make([]*big.Rat, len(msgs))
+	senders := make([]cciptypes.Address, 0)
+	pending := make(map[cciptypes.Address][]int)
+	for i, msg := range msgs {
+		if score != nil {
+			scores[i] = score(msg)
+		}
+		if _, exists := pending[msg.Sender]; !exists {
+			senders = append(senders, msg.Sender)
+		}
+		pending[msg.Sender] = append(pending[msg.Sender], i)
+	}
+
+	before := func(i, j int) bool {
+		_, iPriority := o.prioritySenders[msgs[i].Sender]
+		_, jPriority := o.prioritySenders[msgs[j].Sender]
+		if iPriority != jPriority {
+			return iPriority
+		}
+		if score != nil {
+			if c := scores[i].Cmp(scores[j]); c != 0 {
+				return c > 0
+			}
+		}
+		return msgs[i].SequenceNumber < msgs[j].SequenceNumber
+	}
+
+	ordered := make([]cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta, 0, len(msgs))
+	for len(ordered) < len(msgs) {
+		var next cciptypes.Address
+		best := -1
+		for _, sender := range senders {
+			if queue := pending[sender]; len(queue) > 0 && (best == -1 || before(queue[0], best)) {
+				next, best = sender, queue[0]
+			}
+		}
+		ordered = append(ordered, msgs[best])
+		pending[next] = pending[next][1:]
+	}
+	return ordered
+}
@@ -1,11 +1,29 @@
 package ccipexec
 
 import (
+	"context"
+	"math/big"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
 )
 
 func Test_validateSendRequests(t *testing.T) {
@@ -64,3 +82,259 @@ func Test_validateSendRequests(t *testing.T) {
 		})
 	}
 }
+
+var (
+	orderingSenders = []common.Address{
+		common.HexToAddress("0x1"),
+		common.HexToAddress("0x2"),
+		common.HexToAddress("0x3"),
+		common.HexToAddress("0x4"),
+	}
+	orderingFeeToken = ccipcalc.HexToAddress("0xfee")
+	orderingToken    = ccipcalc.HexToAddress("0x70c")
+)
+
+// orderingMessages derives a report's messages from random seeds, each seed picks the sender, fee, gas limit and
+// token amount of a message. Nonces are assigned per sender in sequence number order, starting at 1.
+func orderingMessages(seeds []uint64) []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta {
+	nonces := make(map[cciptypes.Address]uint64)
+	msgs := make([]cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta, 0, len(seeds))
+	for i, seed := range seeds {
+		sender := ccipcalc.EvmAddrToGeneric(orderingSenders[seed%uint64(len(orderingSenders))])
+		nonces[sender]++
+		msgs = append(msgs, cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{
+			EVM2EVMMessage: cciptypes.EVM2EVMMessage{
+				SequenceNumber: uint64(i + 1),
+				Sender:         sender,
+				Nonce:          nonces[sender],
+				FeeToken:       orderingFeeToken,
+				FeeTokenAmount: new(big.Int).SetUint64((seed >> 2) % 1_000),
+				GasLimit:       new(big.Int).SetUint64((seed >> 12) % 1_000_000),
+				TokenAmounts:   []cciptypes.TokenAmount{{Token: orderingToken, Amount: new(big.Int).SetUint64((seed >> 32) % 1_000)}},
+				MessageID:      cciptypes.Hash{byte(i)},
+			},
+			BlockTimestamp: time.Now(),
+		})
+	}
+	return msgs
+}
+
+func orderingScore(ordering batchOrdering, numRequests int) func(cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta) *big.Rat {
+	return ordering.score(
+		numRequests,
+		map[cciptypes.Address]*big.Int{orderingFeeToken: big.NewInt(1e18)},
+		map[cciptypes.Address]*big.Int{orderingToken: big.NewInt(1e18)},
+		map[cciptypes.Address]cciptypes.Address{orderingToken: orderingToken},
+	)
+}
+
+func Test_batchOrdering_order(t *testing.T) {
+	msg := func(seqNr uint64, sender common.Address, fee int64, value int64) cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta {
+		return cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{
+			EVM2EVMMessage: cciptypes.EVM2EVMMessage{
+				SequenceNumber: seqNr,
+				Sender:         ccipcalc.EvmAddrToGeneric(sender),
+				FeeToken:       orderingFeeToken,
+				FeeTokenAmount: big.NewInt(fee),
+				GasLimit:       big.NewInt(100_000),
+				TokenAmounts:   []cciptypes.TokenAmount{{Token: orderingToken, Amount: big.NewInt(value)}},
+			},
+		}
+	}
+	// Sender 1 pays little then a lot, sender 2 pays a medium fee but transfers the most value.
+	msgs := []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{
+		msg(1, orderingSenders[0], 1, 1),
+		msg(2, orderingSenders[0], 100, 1),
+		msg(3, orderingSenders[1], 10, 1_000),
+		msg(4, orderingSenders[2], 0, 0),
+	}
+
+	testCases := []struct {
+		name   string
+		config ccipconfig.BatchOrderingConfig
+		seqNrs []uint64
+	}{
+		{
+			name:   "sequence number by default",
+			config: ccipconfig.BatchOrderingConfig{},
+			seqNrs: []uint64{1, 2, 3, 4},
+		},
+		{
+			name:   "fee per gas keeps the sender order",
+			config: ccipconfig.BatchOrderingConfig{Policy: ccipconfig.BatchOrderingFeePerGas},
+			seqNrs: []uint64{3, 1, 2, 4},
+		},
+		{
+			name:   "token value",
+			config: ccipconfig.BatchOrderingConfig{Policy: ccipconfig.BatchOrderingTokenValue},
+			seqNrs: []uint64{3, 1, 2, 4},
+		},
+		{
+			name:   "priority senders first",
+			config: ccipconfig.BatchOrderingConfig{PrioritySenders: []common.Address{orderingSenders[2]}},
+			seqNrs: []uint64{4, 1, 2, 3},
+		},
+		{
+			name:   "priority senders then fee per gas",
+			config: ccipconfig.BatchOrderingConfig{Policy: ccipconfig.BatchOrderingFeePerGas, PrioritySenders: []common.Address{orderingSenders[0]}},
+			seqNrs: []uint64{1, 2, 3, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ordering := newBatchOrdering(tc.config)
+			ordered := ordering.order(msgs, orderingScore(ordering, len(msgs)))
+
+			seqNrs := make([]uint64, 0, len(ordered))
+			for _, msg := range ordered {
+				seqNrs = append(seqNrs, msg.SequenceNumber)
+			}
+			assert.Equal(t, tc.seqNrs, seqNrs)
+		})
+	}
+}
+
+func Test_batchOrdering_orderProperties(t *testing.T) {
+	params := gopter.DefaultTestParameters()
+	params.MinSuccessfulTests = 200
+	p := gopter.NewProperties(params)
+
+	policies := gen.OneConstOf(ccipconfig.BatchOrderingSequenceNumber, ccipconfig.BatchOrderingFeePerGas, ccipconfig.BatchOrderingTokenValue)
+	newOrdering := func(policy string, numPrioritySenders int) batchOrdering {
+		return newBatchOrdering(ccipconfig.BatchOrderingConfig{Policy: policy, PrioritySenders: orderingSenders[:numPrioritySenders]})
+	}
+
+	p.Property("orders a permutation of the messages keeping the sender order", prop.ForAll(func(seeds []uint64, policy string, numPrioritySenders int) bool {
+		msgs := orderingMessages(seeds)
+		ordering := newOrdering(policy, numPrioritySenders)
+		ordered := ordering.order(msgs, orderingScore(ordering, len(msgs)))
+		if len(ordered) != len(msgs) {
+			return false
+		}
+
+		seen := make(map[uint64]bool, len(ordered))
+		lastNonce := make(map[cciptypes.Address]uint64)
+		for _, msg := range ordered {
+			if seen[msg.SequenceNumber] || msg.Nonce != lastNonce[msg.Sender]+1 {
+				return false
+			}
+			seen[msg.SequenceNumber] = true
+			lastNonce[msg.Sender] = msg.Nonce
+		}
+		return true
+	}, gen.SliceOf(gen.UInt64()), policies, gen.IntRange(0, len(orderingSenders))))
+
+	p.Property("orders the messages of priority senders first", prop.ForAll(func(seeds []uint64, policy string, numPrioritySenders int) bool {
+		msgs := orderingMessages(seeds)
+		ordering := newOrdering(policy, numPrioritySenders)
+		prioritized := true
+		for _, msg := range ordering.order(msgs, orderingScore(ordering, len(msgs))) {
+			_, priority := ordering.prioritySenders[msg.Sender]
+			if priority && !prioritized {
+				return false
+			}
+			prioritized = priority
+		}
+		return true
+	}, gen.SliceOf(gen.UInt64()), policies, gen.IntRange(0, len(orderingSenders))))
+
+	p.Property("orders the best next message of any sender first", prop.ForAll(func(seeds []uint64, policy string) bool {
+		msgs := orderingMessages(seeds)
+		ordering := newOrdering(policy, 0)
+		score := orderingScore(ordering, len(msgs))
+		if score == nil {
+			score = func(cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta) *big.Rat { return new(big.Rat) }
+		}
+
+		// Replays the ordering, checking every message scores at least as high as the next message of the other senders.
+		next := make(map[cciptypes.Address]int)
+		for i, msg := range msgs {
+			if _, exists := next[msg.Sender]; !exists {
+				next[msg.Sender] = i
+			}
+		}
+		for _, msg := range ordering.order(msgs, score) {
+			for _, i := range next {
+				if i >= 0 && score(msgs[i]).Cmp(score(msg)) > 0 {
+					return false
+				}
+			}
+			next[msg.Sender] = -1
+			for i := int(msg.SequenceNumber); i < len(msgs); i++ {
+				if msgs[i].Sender == msg.Sender {
+					next[msg.Sender] = i
+					break
+				}
+			}
+		}
+		return true
+	}, gen.SliceOf(gen.UInt64()), policies))
+
+	p.TestingRun(t)
+}
+
+func TestExecutionReportingPlugin_buildBatchOrderingProperties(t *testing.T) {
+	gasPriceEstimator := prices.NewMockGasPriceEstimatorExec(t)
+	gasPriceEstimator.On("EstimateMsgCostUSD", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(0), nil).Maybe()
+	offRampReader := ccipdatamocks.NewOffRampReader(t)
+	offRampReader.On("GetSendersNonce", mock.Anything, mock.Anything).Return(func(_ context.Context, senders []cciptypes.Address) map[cciptypes.Address]uint64 {
+		nonces := make(map[cciptypes.Address]uint64, len(senders))
+		for _, sender := range senders {
+			nonces[sender] = 0
+		}
+		return nonces
+	}, nil).Maybe()
+	tokenDataWorker := tokendata.NewBackgroundWorker(map[cciptypes.Address]tokendata.Reader{}, 10, 5*time.Second, time.Hour)
+	require.NoError(t, tokenDataWorker.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, tokenDataWorker.Close()) })
+
+	params := gopter.DefaultTestParameters()
+	params.MinSuccessfulTests = 100
+	p := gopter.NewProperties(params)
+
+	p.Property("builds a batch sorted by sequence number with contiguous sender nonces", prop.ForAll(func(seeds []uint64, policy string, numPrioritySenders int) bool {
+		msgs := orderingMessages(seeds)
+		tokenDataWorker.AddJobsFromMsgs(testutils.Context(t), msgs)
+		destNative := ccipcalc.HexToAddress("0xdead")
+		plugin := ExecutionReportingPlugin{
+			tokenDataWorker:   tokenDataWorker,
+			offRampReader:     offRampReader,
+			destWrappedNative: destNative,
+			offchainConfig:    cciptypes.ExecOffchainConfig{BatchGasLimit: 3_000_000, RelativeBoostPerWaitHour: 1},
+			lggr:              logger.NullLogger,
+			gasPriceEstimator: gasPriceEstimator,
+			batchOrdering:     newBatchOrdering(ccipconfig.BatchOrderingConfig{Policy: policy, PrioritySenders: orderingSenders[:numPrioritySenders]}),
+		}
+
+		batch, statuses := plugin.buildBatch(
+			context.Background(),
+			logger.NullLogger,
+			commitReportWithSendRequests{sendRequestsWithMeta: msgs},
+			big.NewInt(0),
+			big.NewInt(1e18),
+			nil,
+			map[cciptypes.Address]*big.Int{orderingFeeToken: big.NewInt(1e18)},
+			map[cciptypes.Address]*big.Int{orderingToken: big.NewInt(1), destNative: big.NewInt(1e18)},
+			big.NewInt(1),
+			map[cciptypes.Address]cciptypes.Address{orderingToken: orderingToken},
+		)
+		if len(statuses) != len(msgs) {
+			return false
+		}
+		if !sort.SliceIsSorted(batch, func(i, j int) bool { return batch[i].SeqNr < batch[j].SeqNr }) {
+			return false
+		}
+		lastNonce := make(map[cciptypes.Address]uint64)
+		for _, observed := range batch {
+			msg := msgs[observed.SeqNr-1]
+			if msg.Nonce != lastNonce[msg.Sender]+1 {
+				return false
+			}
+			lastNonce[msg.Sender] = msg.Nonce
+		}
+		return true
+	}, gen.SliceOf(gen.UInt64()), gen.OneConstOf(ccipconfig.BatchOrderingFeePerGas, ccipconfig.BatchOrderingTokenValue), gen.IntRange(0, len(orderingSenders))))
+
+	p.TestingRun(t)
+}
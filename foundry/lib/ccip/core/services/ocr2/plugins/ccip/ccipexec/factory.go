@@ -89,6 +89,15 @@ func (rf *ExecutionReportingPluginFactory) NewReportingPlugin(config types.Repor
 	}
 
 	lggr := rf.config.lggr.Named("ExecutionReportingPlugin")
+	offRampAddress, err := rf.config.offRampReader.Address(ctx)
+	if err != nil {
+		return nil, types.ReportingPluginInfo{}, fmt.Errorf("get offramp address: %w", err)
+	}
+	inflightReports := newPersistedInflightExecReportsContainer(offchainConfig.InflightCacheExpiry.Duration(), rf.config.inflightReportsORM, rf.config.destChainSelector, offRampAddress)
+	if err = inflightReports.load(ctx, lggr); err != nil {
+		// Start with an empty cache rather than not at all, the inflight reports are an optimization.
+		lggr.Errorw("Failed to load inflight reports", "err", err)
+	}
 	return &ExecutionReportingPlugin{
 			F:                           config.F,
 			lggr:                        lggr,
@@ -105,10 +114,13 @@ func (rf *ExecutionReportingPluginFactory) NewReportingPlugin(config types.Repor
 			onchainConfig:               onchainConfig,
 			offRampReader:               rf.config.offRampReader,
 			tokenPoolBatchedReader:      rf.config.tokenPoolBatchedReader,
-			inflightReports:             newInflightExecReportsContainer(offchainConfig.InflightCacheExpiry.Duration()),
+			inflightReports:             inflightReports,
 			commitRootsCache:            cache.NewCommitRootsCache(lggr, onchainConfig.PermissionLessExecutionThresholdSeconds, offchainConfig.RootSnoozeTime.Duration()),
 			metricsCollector:            rf.config.metricsCollector,
 			chainHealthcheck:            rf.config.chainHealthcheck,
+			messageTracker:              rf.config.messageTracker,
+			batchOrdering:               rf.config.batchOrdering,
+			debugRecorder:               rf.config.debugRecorder,
 		}, types.ReportingPluginInfo{
 			Name: "CCIPExecution",
 			// Setting this to false saves on calldata since OffRamp doesn't require agreement between NOPs
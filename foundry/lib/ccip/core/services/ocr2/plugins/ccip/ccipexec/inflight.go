@@ -1,6 +1,7 @@
 package ccipexec
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
 )
 
 // InflightInternalExecutionReport serves the same purpose as InflightCommitReport
@@ -25,6 +27,12 @@ type inflightExecReportsContainer struct {
 	reports []InflightInternalExecutionReport
 
 	cacheExpiry time.Duration
+
+	// orm persists the reports of the lane, so that a restarted node doesn't execute them again. Reports are only
+	// kept in memory when it is nil.
+	orm               ccipdb.InflightExecReportsORM
+	destChainSelector uint64
+	offRamp           string
 }
 
 func newInflightExecReportsContainer(inflightCacheExpiry time.Duration) *inflightExecReportsContainer {
@@ -35,6 +43,43 @@ func newInflightExecReportsContainer(inflightCacheExpiry time.Duration) *infligh
 	}
 }
 
+func newPersistedInflightExecReportsContainer(inflightCacheExpiry time.Duration, orm ccipdb.InflightExecReportsORM, destChainSelector uint64, offRamp cciptypes.Address) *inflightExecReportsContainer {
+	container := newInflightExecReportsContainer(inflightCacheExpiry)
+	container.orm = orm
+	container.destChainSelector = destChainSelector
+	container.offRamp = string(offRamp)
+	return container
+}
+
+// load replaces the reports with the unexpired reports persisted for the lane, and deletes the expired ones.
+func (container *inflightExecReportsContainer) load(ctx context.Context, lggr logger.Logger) error {
+	if container.orm == nil {
+		return nil
+	}
+	container.locker.Lock()
+	defer container.locker.Unlock()
+
+	expiredBefore := time.Now().Add(-container.cacheExpiry)
+	if err := container.orm.DeleteReports(ctx, container.destChainSelector, container.offRamp, expiredBefore); err != nil {
+		return err
+	}
+	persisted, err := container.orm.GetReports(ctx, container.destChainSelector, container.offRamp, expiredBefore)
+	if err != nil {
+		return err
+	}
+
+	reports := make([]InflightInternalExecutionReport, 0, len(persisted))
+	for _, report := range persisted {
+		reports = append(reports, InflightInternalExecutionReport{
+			createdAt: report.CreatedAt,
+			messages:  report.Messages,
+		})
+	}
+	container.reports = reports
+	lggr.Infow("Inflight reports loaded", "count", len(reports))
+	return nil
+}
+
 func (container *inflightExecReportsContainer) getAll() []InflightInternalExecutionReport {
 	container.locker.RLock()
 	defer container.locker.RUnlock()
@@ -45,24 +90,33 @@ func (container *inflightExecReportsContainer) getAll() []InflightInternalExecut
 	return reports
 }
 
-func (container *inflightExecReportsContainer) expire(lggr logger.Logger) {
+func (container *inflightExecReportsContainer) expire(ctx context.Context, lggr logger.Logger) {
 	container.locker.Lock()
 	defer container.locker.Unlock()
 	// Reap old inflight txs and check if any messages in the report are inflight.
 	var stillInFlight []InflightInternalExecutionReport
+	expired := false
 	for _, report := range container.reports {
 		if time.Since(report.createdAt) > container.cacheExpiry {
 			// Happy path: inflight report was successfully transmitted onchain, we remove it from inflight and onchain state reflects inflight.
 			// Sad path: inflight report reverts onchain, we remove it from inflight, onchain state does not reflect the change so we retry.
 			lggr.Infow("Inflight report expired", "messages", report.messages)
+			expired = true
 		} else {
 			stillInFlight = append(stillInFlight, report)
 		}
 	}
 	container.reports = stillInFlight
+
+	if expired && container.orm != nil {
+		if err := container.orm.DeleteReports(ctx, container.destChainSelector, container.offRamp, time.Now().Add(-container.cacheExpiry)); err != nil {
+			// Expired reports left in the db are deleted on the next expiry or when the reports are loaded.
+			lggr.Errorw("Failed to delete expired inflight reports", "err", err)
+		}
+	}
 }
 
-func (container *inflightExecReportsContainer) add(lggr logger.Logger, messages []cciptypes.EVM2EVMMessage) error {
+func (container *inflightExecReportsContainer) add(ctx context.Context, lggr logger.Logger, messages []cciptypes.EVM2EVMMessage) error {
 	container.locker.Lock()
 	defer container.locker.Unlock()
 
@@ -74,9 +128,20 @@ func (container *inflightExecReportsContainer) add(lggr logger.Logger, messages
 
 	// Otherwise not already in flight, add it.
 	lggr.Info("Inflight report added")
-	container.reports = append(container.reports, InflightInternalExecutionReport{
+	report := InflightInternalExecutionReport{
 		createdAt: time.Now(),
 		messages:  messages,
-	})
+	}
+	container.reports = append(container.reports, report)
+
+	if container.orm != nil {
+		// The report stays inflight in memory regardless, persisting it only matters if the node restarts before it expires.
+		if err := container.orm.InsertReport(ctx, container.destChainSelector, container.offRamp, ccipdb.InflightExecReport{
+			Messages:  report.messages,
+			CreatedAt: report.createdAt,
+		}); err != nil {
+			lggr.Errorw("Failed to persist inflight report", "err", err)
+		}
+	}
 	return nil
 }
@@ -1,24 +1,31 @@
 package ccipexec
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+	ccipdbmocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb/mocks"
 )
 
 func TestInflightReportsContainer_add(t *testing.T) {
 	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
 	container := newInflightExecReportsContainer(time.Second)
 
-	err := container.add(lggr, []cciptypes.EVM2EVMMessage{
+	err := container.add(ctx, lggr, []cciptypes.EVM2EVMMessage{
 		{SequenceNumber: 1}, {SequenceNumber: 2}, {SequenceNumber: 3},
 	})
 	require.NoError(t, err)
-	err = container.add(lggr, []cciptypes.EVM2EVMMessage{
+	err = container.add(ctx, lggr, []cciptypes.EVM2EVMMessage{
 		{SequenceNumber: 1},
 	})
 	require.Error(t, err)
@@ -28,15 +35,70 @@ func TestInflightReportsContainer_add(t *testing.T) {
 
 func TestInflightReportsContainer_expire(t *testing.T) {
 	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
 	container := newInflightExecReportsContainer(time.Second)
 
-	err := container.add(lggr, []cciptypes.EVM2EVMMessage{
+	err := container.add(ctx, lggr, []cciptypes.EVM2EVMMessage{
 		{SequenceNumber: 1}, {SequenceNumber: 2}, {SequenceNumber: 3},
 	})
 	require.NoError(t, err)
 	container.reports[0].createdAt = time.Now().Add(-time.Second * 5)
 	require.Equal(t, 1, len(container.getAll()))
 
-	container.expire(lggr)
+	container.expire(ctx, lggr)
 	require.Equal(t, 0, len(container.getAll()))
 }
+
+func TestInflightReportsContainer_persisted(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	destChainSelector := uint64(16015286601757825753)
+	offRamp := cciptypes.Address("0x2222222222222222222222222222222222222222")
+	messages := []cciptypes.EVM2EVMMessage{{SequenceNumber: 4}, {SequenceNumber: 5}}
+
+	t.Run("loads the unexpired reports", func(t *testing.T) {
+		orm := ccipdbmocks.NewInflightExecReportsORM(t)
+		createdAt := time.Now().Add(-time.Minute)
+		expiredBefore := mock.MatchedBy(func(ts time.Time) bool {
+			return ts.Before(time.Now().Add(-time.Hour+time.Second)) && ts.After(time.Now().Add(-time.Hour-time.Minute))
+		})
+		orm.On("DeleteReports", mock.Anything, destChainSelector, string(offRamp), expiredBefore).Return(nil).Once()
+		orm.On("GetReports", mock.Anything, destChainSelector, string(offRamp), expiredBefore).
+			Return([]ccipdb.InflightExecReport{{Messages: messages, CreatedAt: createdAt}}, nil).Once()
+
+		container := newPersistedInflightExecReportsContainer(time.Hour, orm, destChainSelector, offRamp)
+		require.NoError(t, container.load(ctx, lggr))
+		assert.Equal(t, []InflightInternalExecutionReport{{createdAt: createdAt, messages: messages}}, container.getAll())
+
+		// A restarted node doesn't accept the reloaded report again.
+		require.ErrorContains(t, container.add(ctx, lggr, messages), "report is already in flight")
+	})
+
+	t.Run("persists added reports and deletes expired ones", func(t *testing.T) {
+		orm := ccipdbmocks.NewInflightExecReportsORM(t)
+		orm.On("InsertReport", mock.Anything, destChainSelector, string(offRamp), mock.MatchedBy(func(report ccipdb.InflightExecReport) bool {
+			return assert.Equal(t, messages, report.Messages) && time.Since(report.CreatedAt) < time.Minute
+		})).Return(nil).Once()
+
+		container := newPersistedInflightExecReportsContainer(time.Hour, orm, destChainSelector, offRamp)
+		require.NoError(t, container.add(ctx, lggr, messages))
+
+		// Nothing expired, nothing to delete.
+		container.expire(ctx, lggr)
+		require.Len(t, container.getAll(), 1)
+
+		container.reports[0].createdAt = time.Now().Add(-2 * time.Hour)
+		orm.On("DeleteReports", mock.Anything, destChainSelector, string(offRamp), mock.Anything).Return(nil).Once()
+		container.expire(ctx, lggr)
+		require.Empty(t, container.getAll())
+	})
+
+	t.Run("keeps the reports in memory when the db fails", func(t *testing.T) {
+		orm := ccipdbmocks.NewInflightExecReportsORM(t)
+		orm.On("InsertReport", mock.Anything, destChainSelector, string(offRamp), mock.Anything).Return(errors.New("db down")).Once()
+
+		container := newPersistedInflightExecReportsContainer(time.Hour, orm, destChainSelector, offRamp)
+		require.NoError(t, container.add(ctx, lggr, messages))
+		require.Len(t, container.getAll(), 1)
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"net/url"
 	"strconv"
 	"time"
 
@@ -18,13 +17,13 @@ import (
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
 
 	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 
-	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
@@ -37,19 +36,20 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/oraclelib"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/usdc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 const numTokenDataWorkers = 5
 
-func NewExecutionServices(ctx context.Context, lggr logger.Logger, jb job.Job, chainSet legacyevm.LegacyChainContainer, new bool, argsNoPlugin libocr2.OCR2OracleArgs, logError func(string), qopts ...pg.QOpt) ([]job.ServiceCtx, error) {
-	execPluginConfig, backfillArgs, chainHealthcheck, tokenWorker, err := jobSpecToExecPluginConfig(ctx, lggr, jb, chainSet, qopts...)
+func NewExecutionServices(ctx context.Context, lggr logger.Logger, jb job.Job, chainSet legacyevm.LegacyChainContainer, new bool, argsNoPlugin libocr2.OCR2OracleArgs, logError func(string), ds sqlutil.DataSource, chainHealthRegistry *ccip.ChainHealthRegistry, debugRegistry *ccip.DebugRegistry, manualExecutionRegistry *ManualExecutionRegistry, qopts ...pg.QOpt) ([]job.ServiceCtx, error) {
+	execPluginConfig, backfillArgs, chainHealthcheck, tokenWorker, err := jobSpecToExecPluginConfig(ctx, lggr, jb, chainSet, ds, qopts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +64,12 @@ func NewExecutionServices(ctx context.Context, lggr logger.Logger, jb job.Job, c
 	if err != nil {
 		return nil, err
 	}
+	// Tracking the healthcheck lets admins clear its sticky unhealthy status while the job is running
+	trackedChainHealthcheck := chainHealthRegistry.Track(jb.ID, chainHealthcheck)
+	// The recorded rounds can be inspected through the API while the job is running
+	trackedDebugRecorder := debugRegistry.Track(jb.ID, execPluginConfig.debugRecorder)
+	// Stuck messages of the lane can be executed manually through the API while the job is running
+	trackedManualExecutor := manualExecutionRegistry.Track(jb.ID, execPluginConfig.manualExecutor)
 	// If this is a brand-new job, then we make use of the start blocks. If not then we're rebooting and log poller will pick up where we left off.
 	if new {
 		return []job.ServiceCtx{
@@ -75,14 +81,20 @@ func NewExecutionServices(ctx context.Context, lggr logger.Logger, jb job.Job, c
 				backfillArgs.DestStartBlock,
 				job.NewServiceAdapter(oracle),
 			),
-			chainHealthcheck,
+			trackedChainHealthcheck,
+			trackedDebugRecorder,
+			trackedManualExecutor,
 			tokenWorker,
+			execPluginConfig.messageTracker,
 		}, nil
 	}
 	return []job.ServiceCtx{
 		job.NewServiceAdapter(oracle),
-		chainHealthcheck,
+		trackedChainHealthcheck,
+		trackedDebugRecorder,
+		trackedManualExecutor,
 		tokenWorker,
+		execPluginConfig.messageTracker,
 	}, nil
 }
 
@@ -111,11 +123,14 @@ func UnregisterExecPluginLpFilters(ctx context.Context, lggr logger.Logger, jb j
 		func() error {
 			return factory.CloseOffRampReader(lggr, versionFinder, offRampAddress, params.destChain.Client(), params.destChain.LogPoller(), params.destChain.GasEstimator(), params.destChain.Config().EVM().GasEstimator().PriceMax().ToInt(), qopts...)
 		},
-		func() error { // usdc token data reader
-			if usdcDisabled := params.pluginConfig.USDCConfig.AttestationAPI == ""; usdcDisabled {
-				return nil
+		func() error { // token data readers
+			args := tokenDataProviderArgs{
+				lggr:          lggr,
+				jobID:         jobIDToString(jb.ID),
+				sourceChainID: params.sourceChain.ID().Int64(),
+				sourceLP:      params.sourceChain.LogPoller(),
 			}
-			return ccipdata.CloseUSDCReader(lggr, jobIDToString(jb.ID), params.pluginConfig.USDCConfig.SourceMessageTransmitterAddress, params.sourceChain.LogPoller(), qopts...)
+			return closeTokenDataProviders(args, params.pluginConfig, qopts...)
 		},
 	}
 
@@ -134,42 +149,7 @@ func ExecReportToEthTxMeta(ctx context.Context, typ ccipconfig.ContractType, ver
 	return factory.ExecReportToEthTxMeta(ctx, typ, ver)
 }
 
-func initTokenDataProviders(lggr logger.Logger, jobID string, pluginConfig ccipconfig.ExecutionPluginJobSpecConfig, sourceLP logpoller.LogPoller, qopts ...pg.QOpt) (map[cciptypes.Address]tokendata.Reader, error) {
-	tokenDataProviders := make(map[cciptypes.Address]tokendata.Reader)
-
-	// init usdc token data provider
-	if pluginConfig.USDCConfig.AttestationAPI != "" {
-		lggr.Infof("USDC token data provider enabled")
-		err := pluginConfig.USDCConfig.ValidateUSDCConfig()
-		if err != nil {
-			return nil, err
-		}
-
-		attestationURI, err := url.ParseRequestURI(pluginConfig.USDCConfig.AttestationAPI)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to parse USDC attestation API")
-		}
-
-		usdcReader, err := ccipdata.NewUSDCReader(lggr, jobID, pluginConfig.USDCConfig.SourceMessageTransmitterAddress, sourceLP, true)
-		if err != nil {
-			return nil, errors.Wrap(err, "new usdc reader")
-		}
-
-		tokenDataProviders[cciptypes.Address(pluginConfig.USDCConfig.SourceTokenAddress.String())] =
-			usdc.NewUSDCTokenDataReader(
-				lggr,
-				usdcReader,
-				attestationURI,
-				int(pluginConfig.USDCConfig.AttestationAPITimeoutSeconds),
-				pluginConfig.USDCConfig.SourceTokenAddress,
-				time.Duration(pluginConfig.USDCConfig.AttestationAPIIntervalMilliseconds)*time.Millisecond,
-			)
-	}
-
-	return tokenDataProviders, nil
-}
-
-func jobSpecToExecPluginConfig(ctx context.Context, lggr logger.Logger, jb job.Job, chainSet legacyevm.LegacyChainContainer, qopts ...pg.QOpt) (*ExecutionPluginStaticConfig, *ccipcommon.BackfillArgs, *cache.ObservedChainHealthcheck, *tokendata.BackgroundWorker, error) {
+func jobSpecToExecPluginConfig(ctx context.Context, lggr logger.Logger, jb job.Job, chainSet legacyevm.LegacyChainContainer, ds sqlutil.DataSource, qopts ...pg.QOpt) (*ExecutionPluginStaticConfig, *ccipcommon.BackfillArgs, *cache.ObservedChainHealthcheck, *tokendata.BackgroundWorker, error) {
 	params, err := extractJobSpecParams(lggr, jb, chainSet, true, qopts...)
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -218,7 +198,12 @@ func jobSpecToExecPluginConfig(ctx context.Context, lggr logger.Logger, jb job.J
 		return nil, nil, nil, nil, errors.Wrap(err, "could not load commitStoreReader reader")
 	}
 
-	tokenDataProviders, err := initTokenDataProviders(lggr, jobIDToString(jb.ID), params.pluginConfig, params.sourceChain.LogPoller(), qopts...)
+	tokenDataProviders, err := initTokenDataProviders(tokenDataProviderArgs{
+		lggr:          lggr,
+		jobID:         jobIDToString(jb.ID),
+		sourceChainID: sourceChainID,
+		sourceLP:      params.sourceChain.LogPoller(),
+	}, params.pluginConfig, qopts...)
 	if err != nil {
 		return nil, nil, nil, nil, errors.Wrap(err, "could not get token data providers")
 	}
@@ -267,13 +252,16 @@ func jobSpecToExecPluginConfig(ctx context.Context, lggr logger.Logger, jb job.J
 		cache.NewChainHealthcheck(
 			// Adding more details to Logger to make healthcheck logs more informative
 			// It's safe because healthcheck logs only in case of unhealthy state
-			lggr.With(
+			lggr.Named(fmt.Sprintf("ChainHealthcheck.%d", jb.ID)).With(
 				"onramp", params.offRampConfig.OnRamp,
 				"commitStore", params.offRampConfig.CommitStore,
 				"offramp", offrampAddress,
 			),
 			onRampReader,
 			commitStoreReader,
+			params.pluginConfig.ChainHealth,
+			cache.ChainSignals{HeadTracker: params.sourceChain.HeadTracker(), LogPoller: params.sourceChain.LogPoller()},
+			cache.ChainSignals{HeadTracker: params.destChain.HeadTracker(), LogPoller: params.destChain.LogPoller()},
 		),
 		ccip.ExecPluginLabel,
 		sourceChainID,
@@ -292,6 +280,20 @@ func jobSpecToExecPluginConfig(ctx context.Context, lggr logger.Logger, jb job.J
 		5*time.Second,
 		onchainConfig.PermissionLessExecutionThresholdSeconds,
 	)
+
+	messageTracker := msgtracker.NewTracker(
+		execLggr,
+		msgtracker.NewORM(ds),
+		onRampReader,
+		commitStoreReader,
+		offRampReader,
+		params.destChain.TxManager(),
+		ccip.NewLaneMetricsCollector(sourceChainID, destChainID),
+		sourceChainSelector,
+		destChainSelector,
+		params.offRampConfig.OnRamp,
+		offrampAddress,
+	)
 	return &ExecutionPluginStaticConfig{
 			lggr:                        execLggr,
 			onRampReader:                onRampReader,
@@ -305,6 +307,18 @@ func jobSpecToExecPluginConfig(ctx context.Context, lggr logger.Logger, jb job.J
 			tokenDataWorker:             tokenBackgroundWorker,
 			metricsCollector:            metricsCollector,
 			chainHealthcheck:            chainHealthcheck,
+			messageTracker:              messageTracker,
+			batchOrdering:               newBatchOrdering(params.pluginConfig.BatchOrdering),
+			inflightReportsORM:          ccipdb.NewInflightExecReportsORM(ds),
+			debugRecorder:               ccip.NewDebugRecorder(params.pluginConfig.DebugRounds),
+			manualExecutor: &ManualExecutor{
+				onRampReader:      onRampReader,
+				commitStoreReader: commitStoreReader,
+				offRampReader:     offRampReader,
+				tokenDataReaders:  tokenDataProviders,
+				versionFinder:     versionFinder,
+				destClient:        params.destChain.Client(),
+			},
 		}, &ccipcommon.BackfillArgs{
 			SourceLP:         params.sourceChain.LogPoller(),
 			DestLP:           params.destChain.LogPoller(),
@@ -0,0 +1,223 @@
+package ccipexec
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+)
+
+var ErrManualExecutorNotFound = errors.New("no running execution job for the message")
+
+// ManualExecution is the manuallyExecute call of the offRamp executing a single stuck message.
+type ManualExecution struct {
+	OffRamp        cciptypes.Address
+	MessageID      cciptypes.Hash
+	SequenceNumber uint64
+	MerkleRoot     [32]byte
+	// OffchainTokenData is the token data of each of the message's token amounts, empty for tokens that don't require any.
+	OffchainTokenData [][]byte
+	Calldata          []byte
+}
+
+// ManualExecutor builds the manual executions of the messages of a running execution job, reusing the readers and
+// token data providers of the job.
+type ManualExecutor struct {
+	onRampReader      ccipdata.OnRampReader
+	commitStoreReader ccipdata.CommitStoreReader
+	offRampReader     ccipdata.OffRampReader
+	tokenDataReaders  map[cciptypes.Address]tokendata.Reader
+	versionFinder     factory.VersionFinder
+	destClient        bind.ContractBackend
+}
+
+// SendRequestsByTxHash returns the messages sent to the onRamp of the job by the given source chain transaction,
+// whether or not they are tracked.
+func (e *ManualExecutor) SendRequestsByTxHash(ctx context.Context, txHash cciptypes.Hash) ([]cciptypes.EVM2EVMMessageWithTxMeta, error) {
+	return e.onRampReader.GetSendRequestsByTxHash(ctx, txHash)
+}
+
+// Build builds the manuallyExecute call of the message with the given sequence number. A nil gasLimitOverride
+// executes with the gas limit of the message.
+func (e *ManualExecutor) Build(ctx context.Context, seqNr uint64, gasLimitOverride *big.Int) (ManualExecution, error) {
+	execution, report, err := buildManualExecutionReport(ctx, e.onRampReader, e.commitStoreReader, e.offRampReader, e.tokenDataReaders, seqNr)
+	if err != nil {
+		return ManualExecution{}, err
+	}
+
+	encodedReport, err := e.offRampReader.EncodeExecutionReport(ctx, report)
+	if err != nil {
+		return ManualExecution{}, fmt.Errorf("encode execution report: %w", err)
+	}
+	typ, version, err := e.versionFinder.TypeAndVersion(execution.OffRamp, e.destClient)
+	if err != nil {
+		return ManualExecution{}, errors.Wrap(err, "unable to read type and version")
+	}
+	if gasLimitOverride == nil {
+		gasLimitOverride = big.NewInt(0)
+	}
+	execution.Calldata, err = factory.ManuallyExecuteCalldata(typ, version, encodedReport, []*big.Int{gasLimitOverride})
+	if err != nil {
+		return ManualExecution{}, fmt.Errorf("encode manuallyExecute calldata: %w", err)
+	}
+	return execution, nil
+}
+
+// buildManualExecutionReport finds the commit report of the message with the given sequence number, rebuilds the
+// merkle tree of its root and returns the execution report proving the message, along with its offchain token data.
+func buildManualExecutionReport(
+	ctx context.Context,
+	onRampReader ccipdata.OnRampReader,
+	commitStoreReader ccipdata.CommitStoreReader,
+	offRampReader ccipdata.OffRampReader,
+	tokenDataReaders map[cciptypes.Address]tokendata.Reader,
+	seqNr uint64,
+) (ManualExecution, cciptypes.ExecReport, error) {
+	state, err := offRampReader.GetExecutionState(ctx, seqNr)
+	if err != nil {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("get execution state: %w", err)
+	}
+	if cciptypes.MessageExecutionState(state) == cciptypes.ExecutionStateSuccess {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("message %d is already executed", seqNr)
+	}
+
+	commitReport, err := getCommitReportForSeqNum(ctx, commitStoreReader, seqNr)
+	if err != nil {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("get commit report of message %d: %w", seqNr, err)
+	}
+	sendReqsInRoot, _, tree, err := getProofData(ctx, onRampReader, commitReport.Interval)
+	if err != nil {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("get proof data: %w", err)
+	}
+	if tree.Root() != commitReport.MerkleRoot {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("rebuilt root %x does not match the committed root %x", tree.Root(), commitReport.MerkleRoot)
+	}
+
+	innerIdx := int(seqNr - commitReport.Interval.Min)
+	if innerIdx >= len(sendReqsInRoot) || sendReqsInRoot[innerIdx].SequenceNumber != seqNr {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("message %d not found in the send requests of root %x", seqNr, commitReport.MerkleRoot)
+	}
+	sendReq := sendReqsInRoot[innerIdx]
+	msg := cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{
+		EVM2EVMMessage: sendReq.EVM2EVMMessage,
+		BlockTimestamp: time.UnixMilli(sendReq.BlockTimestampUnixMilli),
+		LogIndex:       uint(sendReq.LogIndex),
+		TxHash:         sendReq.TxHash,
+	}
+	tokenData := make([][]byte, len(msg.TokenAmounts))
+	for i, token := range msg.TokenAmounts {
+		reader, exists := tokenDataReaders[token.Token]
+		if !exists {
+			// No token data required
+			continue
+		}
+		tokenData[i], err = reader.ReadTokenData(ctx, msg, i)
+		if err != nil {
+			return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("read token data of token %s: %w", token.Token, err)
+		}
+	}
+
+	report, err := buildExecutionReportForMessages(sendReqsInRoot, tree, commitReport.Interval, []ccip.ObservedMessage{
+		{SeqNr: seqNr, MsgData: ccip.MsgData{TokenData: tokenData}},
+	})
+	if err != nil {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("build execution report: %w", err)
+	}
+
+	offRampAddress, err := offRampReader.Address(ctx)
+	if err != nil {
+		return ManualExecution{}, cciptypes.ExecReport{}, fmt.Errorf("get offramp reader address: %w", err)
+	}
+	return ManualExecution{
+		OffRamp:           offRampAddress,
+		MessageID:         msg.MessageID,
+		SequenceNumber:    seqNr,
+		MerkleRoot:        commitReport.MerkleRoot,
+		OffchainTokenData: tokenData,
+	}, report, nil
+}
+
+// ManualExecutionRegistry keeps track of the manual executors of the running execution jobs, so that stuck messages
+// can be executed manually through the API. A nil registry tracks nothing.
+type ManualExecutionRegistry struct {
+	executors map[int32]*ManualExecutor
+	mu        sync.RWMutex
+}
+
+func NewManualExecutionRegistry() *ManualExecutionRegistry {
+	return &ManualExecutionRegistry{executors: make(map[int32]*ManualExecutor)}
+}
+
+// Track returns a service registering the executor under the job ID while it's running.
+func (r *ManualExecutionRegistry) Track(jobID int32, executor *ManualExecutor) job.ServiceCtx {
+	return &registeredManualExecutor{registry: r, jobID: jobID, executor: executor}
+}
+
+// Executor returns the manual executor of the running job.
+func (r *ManualExecutionRegistry) Executor(jobID int32) (*ManualExecutor, error) {
+	if r == nil {
+		return nil, ErrManualExecutorNotFound
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	executor, ok := r.executors[jobID]
+	if !ok {
+		return nil, ErrManualExecutorNotFound
+	}
+	return executor, nil
+}
+
+// Executors returns the manual executors of all the running jobs.
+func (r *ManualExecutionRegistry) Executors() []*ManualExecutor {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	executors := make([]*ManualExecutor, 0, len(r.executors))
+	for _, executor := range r.executors {
+		executors = append(executors, executor)
+	}
+	return executors
+}
+
+type registeredManualExecutor struct {
+	registry *ManualExecutionRegistry
+	jobID    int32
+	executor *ManualExecutor
+}
+
+func (s *registeredManualExecutor) Start(context.Context) error {
+	if s.registry == nil {
+		return nil
+	}
+	s.registry.mu.Lock()
+	defer s.registry.mu.Unlock()
+	if _, exists := s.registry.executors[s.jobID]; exists {
+		return fmt.Errorf("manual executor of job %d is already registered", s.jobID)
+	}
+	s.registry.executors[s.jobID] = s.executor
+	return nil
+}
+
+func (s *registeredManualExecutor) Close() error {
+	if s.registry == nil {
+		return nil
+	}
+	s.registry.mu.Lock()
+	defer s.registry.mu.Unlock()
+	delete(s.registry.executors, s.jobID)
+	return nil
+}
@@ -0,0 +1,141 @@
+package ccipexec
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/pkg/hashlib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/pkg/merklemulti"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+)
+
+func Test_buildManualExecutionReport(t *testing.T) {
+	ctx := testutils.Context(t)
+	offRampAddr := cciptypes.Address("0x2222222222222222222222222222222222222222")
+	usdc := cciptypes.Address("0x3333333333333333333333333333333333333333")
+	link := cciptypes.Address("0x4444444444444444444444444444444444444444")
+
+	interval := cciptypes.CommitStoreInterval{Min: 10, Max: 12}
+	var sendReqs []cciptypes.EVM2EVMMessageWithTxMeta
+	var leaves [][32]byte
+	for seqNr := interval.Min; seqNr <= interval.Max; seqNr++ {
+		sendReq := cciptypes.EVM2EVMMessageWithTxMeta{
+			EVM2EVMMessage: cciptypes.EVM2EVMMessage{
+				SequenceNumber: seqNr,
+				MessageID:      cciptypes.Hash{byte(seqNr)},
+				Hash:           cciptypes.Hash{0xff, byte(seqNr)},
+			},
+		}
+		sendReqs = append(sendReqs, sendReq)
+		leaves = append(leaves, sendReq.Hash)
+	}
+	sendReqs[1].TokenAmounts = []cciptypes.TokenAmount{{Token: link, Amount: big.NewInt(1)}, {Token: usdc, Amount: big.NewInt(2)}}
+	tree, err := merklemulti.NewTree(hashlib.NewKeccakCtx(), leaves)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, root [32]byte) (*mocks.OnRampReader, *mocks.CommitStoreReader, *mocks.OffRampReader) {
+		onRamp := mocks.NewOnRampReader(t)
+		commitStore := mocks.NewCommitStoreReader(t)
+		offRamp := mocks.NewOffRampReader(t)
+		offRamp.On("GetExecutionState", mock.Anything, uint64(11)).Return(uint8(cciptypes.ExecutionStateFailure), nil)
+		commitStore.On("GetCommitReportMatchingSeqNum", mock.Anything, uint64(11), 0).Return([]cciptypes.CommitStoreReportWithTxMeta{{
+			CommitStoreReport: cciptypes.CommitStoreReport{Interval: interval, MerkleRoot: root},
+		}}, nil)
+		onRamp.On("GetSendRequestsBetweenSeqNums", mock.Anything, interval.Min, interval.Max, false).Return(sendReqs, nil)
+		return onRamp, commitStore, offRamp
+	}
+
+	t.Run("builds the proof and token data of the message", func(t *testing.T) {
+		onRamp, commitStore, offRamp := setup(t, tree.Root())
+		offRamp.On("Address", mock.Anything).Return(offRampAddr, nil)
+		usdcReader := tokendata.NewMockReader(t)
+		usdcReader.On("ReadTokenData", mock.Anything, mock.Anything, 1).Return([]byte("attestation"), nil)
+
+		execution, report, err := buildManualExecutionReport(ctx, onRamp, commitStore, offRamp, map[cciptypes.Address]tokendata.Reader{usdc: usdcReader}, 11)
+		require.NoError(t, err)
+
+		assert.Equal(t, offRampAddr, execution.OffRamp)
+		assert.Equal(t, cciptypes.Hash{11}, execution.MessageID)
+		assert.Equal(t, uint64(11), execution.SequenceNumber)
+		assert.Equal(t, tree.Root(), execution.MerkleRoot)
+		assert.Equal(t, [][]byte{nil, []byte("attestation")}, execution.OffchainTokenData)
+
+		require.Len(t, report.Messages, 1)
+		assert.Equal(t, sendReqs[1].EVM2EVMMessage, report.Messages[0])
+		assert.Equal(t, [][][]byte{execution.OffchainTokenData}, report.OffchainTokenData)
+		proof, err := tree.Prove([]int{1})
+		require.NoError(t, err)
+		assert.Equal(t, proof.Hashes, report.Proofs)
+	})
+
+	t.Run("rejects a root that doesn't match the commit report", func(t *testing.T) {
+		onRamp, commitStore, offRamp := setup(t, [32]byte{1})
+
+		_, _, err := buildManualExecutionReport(ctx, onRamp, commitStore, offRamp, nil, 11)
+		require.ErrorContains(t, err, "does not match the committed root")
+	})
+
+	t.Run("fails when the token data is not ready", func(t *testing.T) {
+		onRamp, commitStore, offRamp := setup(t, tree.Root())
+		usdcReader := tokendata.NewMockReader(t)
+		usdcReader.On("ReadTokenData", mock.Anything, mock.Anything, 1).Return(nil, tokendata.ErrNotReady)
+
+		_, _, err := buildManualExecutionReport(ctx, onRamp, commitStore, offRamp, map[cciptypes.Address]tokendata.Reader{usdc: usdcReader}, 11)
+		require.ErrorIs(t, err, tokendata.ErrNotReady)
+	})
+
+	t.Run("rejects an executed message", func(t *testing.T) {
+		offRamp := mocks.NewOffRampReader(t)
+		offRamp.On("GetExecutionState", mock.Anything, uint64(11)).Return(uint8(cciptypes.ExecutionStateSuccess), nil)
+
+		_, _, err := buildManualExecutionReport(ctx, nil, nil, offRamp, nil, 11)
+		require.ErrorContains(t, err, "already executed")
+	})
+
+	t.Run("fails when the message is not committed", func(t *testing.T) {
+		commitStore := mocks.NewCommitStoreReader(t)
+		offRamp := mocks.NewOffRampReader(t)
+		offRamp.On("GetExecutionState", mock.Anything, uint64(13)).Return(uint8(cciptypes.ExecutionStateUntouched), nil)
+		commitStore.On("GetCommitReportMatchingSeqNum", mock.Anything, uint64(13), 0).Return(nil, errors.New("rpc error"))
+
+		_, _, err := buildManualExecutionReport(ctx, nil, commitStore, offRamp, nil, 13)
+		require.ErrorContains(t, err, "get commit report of message 13")
+	})
+}
+
+func TestManualExecutionRegistry(t *testing.T) {
+	ctx := testutils.Context(t)
+	registry := NewManualExecutionRegistry()
+	executor := &ManualExecutor{}
+
+	tracked := registry.Track(1, executor)
+	_, err := registry.Executor(1)
+	require.ErrorIs(t, err, ErrManualExecutorNotFound)
+
+	require.NoError(t, tracked.Start(ctx))
+	found, err := registry.Executor(1)
+	require.NoError(t, err)
+	assert.Same(t, executor, found)
+	assert.Equal(t, []*ManualExecutor{executor}, registry.Executors())
+	require.ErrorContains(t, registry.Track(1, &ManualExecutor{}).Start(ctx), "already registered")
+
+	require.NoError(t, tracked.Close())
+	_, err = registry.Executor(1)
+	require.ErrorIs(t, err, ErrManualExecutorNotFound)
+	assert.Empty(t, registry.Executors())
+
+	var nilRegistry *ManualExecutionRegistry
+	require.NoError(t, nilRegistry.Track(1, executor).Start(ctx))
+	_, err = nilRegistry.Executor(1)
+	require.ErrorIs(t, err, ErrManualExecutorNotFound)
+	assert.Empty(t, nilRegistry.Executors())
+}
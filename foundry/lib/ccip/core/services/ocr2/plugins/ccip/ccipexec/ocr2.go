@@ -24,6 +24,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/pkg/hashlib"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
@@ -62,6 +64,11 @@ type ExecutionPluginStaticConfig struct {
 	tokenPoolBatchedReader      batchreader.TokenPoolBatchedReader
 	metricsCollector            ccip.PluginMetricsCollector
 	chainHealthcheck            cache.ChainHealthcheck
+	messageTracker              msgtracker.Tracker
+	batchOrdering               batchOrdering
+	inflightReportsORM          ccipdb.InflightExecReportsORM
+	debugRecorder               *ccip.DebugRecorder
+	manualExecutor              *ManualExecutor
 }
 
 type ExecutionReportingPlugin struct {
@@ -91,13 +98,17 @@ type ExecutionReportingPlugin struct {
 	inflightReports  *inflightExecReportsContainer
 	commitRootsCache cache.CommitsRootsCache
 	chainHealthcheck cache.ChainHealthcheck
+	messageTracker   msgtracker.Tracker
+	batchOrdering    batchOrdering
+	debugRecorder    *ccip.DebugRecorder
 }
 
 func (r *ExecutionReportingPlugin) Query(context.Context, types.ReportTimestamp) (types.Query, error) {
 	return types.Query{}, nil
 }
 
-func (r *ExecutionReportingPlugin) Observation(ctx context.Context, timestamp types.ReportTimestamp, query types.Query) (types.Observation, error) {
+func (r *ExecutionReportingPlugin) Observation(ctx context.Context, timestamp types.ReportTimestamp, query types.Query) (observation types.Observation, err error) {
+	defer func() { r.debugRecorder.RecordObservation(timestamp, observation, err) }()
 	lggr := r.lggr.Named("ExecutionObservation")
 	if healthy, err := r.chainHealthcheck.IsHealthy(ctx); err != nil {
 		return nil, err
@@ -111,7 +122,7 @@ func (r *ExecutionReportingPlugin) Observation(ctx context.Context, timestamp ty
 	}
 
 	// Expire any inflight reports.
-	r.inflightReports.expire(lggr)
+	r.inflightReports.expire(ctx, lggr)
 	inFlight := r.inflightReports.getAll()
 
 	executableObservations, err := r.getExecutableObservations(ctx, lggr, inFlight)
@@ -190,6 +201,10 @@ func (r *ExecutionReportingPlugin) getExecutableObservations(ctx context.Context
 				r.commitRootsCache.MarkAsExecuted(merkleRoot)
 				continue
 			}
+			// The root is considered again, its snooze expired if it was snoozed.
+			if r.messageTracker != nil {
+				r.messageTracker.Unsnoozed(ctx, merkleRoot)
+			}
 
 			blessed, err := r.commitStoreReader.IsBlessed(ctx, merkleRoot)
 			if err != nil {
@@ -211,12 +226,16 @@ func (r *ExecutionReportingPlugin) getExecutableObservations(ctx context.Context
 				return []ccip.ObservedMessage{}, nil
 			}
 
+			poolBuckets := newTokenPoolBuckets(tokenExecData.destPoolRateLimits, time.Now())
+			poolBuckets.consumeInflight(inflight, tokenExecData.sourceToDestTokens)
+
 			batch, msgExecStates := r.buildBatch(
 				ctx,
 				rootLggr,
 				rep,
 				inflightAggregateValue,
 				tokenExecData.rateLimiterTokenBucket.Tokens,
+				poolBuckets,
 				tokenExecData.sourceTokenPrices,
 				tokenExecData.destTokenPrices,
 				tokenExecData.gasPrice,
@@ -226,6 +245,9 @@ func (r *ExecutionReportingPlugin) getExecutableObservations(ctx context.Context
 				return batch, nil
 			}
 			r.commitRootsCache.Snooze(merkleRoot)
+			if r.messageTracker != nil {
+				r.messageTracker.Snoozed(ctx, merkleRoot)
+			}
 		}
 	}
 	return []ccip.ObservedMessage{}, nil
@@ -252,14 +274,15 @@ func (r *ExecutionReportingPlugin) getExecutedSeqNrsInRange(ctx context.Context,
 }
 
 // Builds a batch of transactions that can be executed, takes into account
-// the available gas, rate limiting, execution state, nonce state, and
-// profitability of execution.
+// the available gas, rate limiting of the offRamp and the destination token pools,
+// execution state, nonce state, and profitability of execution.
 func (r *ExecutionReportingPlugin) buildBatch(
 	ctx context.Context,
 	lggr logger.Logger,
 	report commitReportWithSendRequests,
 	inflightAggregateValue *big.Int,
 	aggregateTokenLimit *big.Int,
+	poolBuckets tokenPoolBuckets,
 	sourceTokenPricesUSD map[cciptypes.Address]*big.Int,
 	destTokenPricesUSD map[cciptypes.Address]*big.Int,
 	gasPrice *big.Int,
@@ -281,8 +304,12 @@ func (r *ExecutionReportingPlugin) buildBatch(
 	availableDataLen := MaxDataLenPerBatch
 	tokenDataRemainingDuration := MaximumAllowedTokenDataWaitTimePerBatch
 	batchBuilder := newBatchBuildContainer(len(report.sendRequestsWithMeta))
+	msgs := r.batchOrdering.order(
+		report.sendRequestsWithMeta,
+		r.batchOrdering.score(len(report.sendRequestsWithMeta), sourceTokenPricesUSD, destTokenPricesUSD, sourceToDestToken),
+	)
 
-	for _, msg := range report.sendRequestsWithMeta {
+	for _, msg := range msgs {
 		msgLggr := lggr.With("messageID", hexutil.Encode(msg.MessageID[:]), "seqNr", msg.SequenceNumber)
 
 		if msg.Executed {
@@ -347,6 +374,13 @@ func (r *ExecutionReportingPlugin) buildBatch(
 			continue
 		}
 
+		// the pools would revert the execution of messages that exceed their inbound rate limit
+		if destToken, exceeded := poolBuckets.exceeded(sourceToDestToken, msg.TokenAmounts); exceeded {
+			msgLggr.Warnw("Skipping message - token pool rate limit exceeded", "token", destToken, "poolTokensLeft", poolBuckets[destToken].String())
+			batchBuilder.skip(msg, TokenPoolRateLimitExceeded)
+			continue
+		}
+
 		tokenData, elapsed, err1 := r.getTokenDataWithTimeout(ctx, msg, tokenDataRemainingDuration)
 		tokenDataRemainingDuration -= elapsed
 		if err1 != nil {
@@ -404,6 +438,7 @@ func (r *ExecutionReportingPlugin) buildBatch(
 		availableGas -= messageMaxGas
 		availableDataLen -= len(msg.Data)
 		aggregateTokenLimit.Sub(aggregateTokenLimit, msgValue)
+		poolBuckets.consume(sourceToDestToken, msg.TokenAmounts)
 		expectedNonces[msg.Sender] = msg.Nonce + 1
 		batchBuilder.addToBatch(msg, tokenData)
 
@@ -418,6 +453,10 @@ func (r *ExecutionReportingPlugin) buildBatch(
 		)
 	}
 
+	// The batch ordering only decides which messages make it into the batch, observations and reports stay sorted by sequence number.
+	sort.Slice(batchBuilder.batch, func(i, j int) bool {
+		return batchBuilder.batch[i].SeqNr < batchBuilder.batch[j].SeqNr
+	})
 	return batchBuilder.batch, batchBuilder.statuses
 }
 
@@ -622,7 +661,8 @@ func (r *ExecutionReportingPlugin) buildReport(ctx context.Context, lggr logger.
 	return encodedReport, nil
 }
 
-func (r *ExecutionReportingPlugin) Report(ctx context.Context, timestamp types.ReportTimestamp, query types.Query, observations []types.AttributedObservation) (bool, types.Report, error) {
+func (r *ExecutionReportingPlugin) Report(ctx context.Context, timestamp types.ReportTimestamp, query types.Query, observations []types.AttributedObservation) (shouldReport bool, _ types.Report, err error) {
+	defer func() { r.debugRecorder.RecordReport(timestamp, observations, shouldReport, err) }()
 	lggr := r.lggr.Named("ExecutionReport")
 	if healthy, err := r.chainHealthcheck.IsHealthy(ctx); err != nil {
 		return false, nil, err
@@ -640,6 +680,7 @@ func (r *ExecutionReportingPlugin) Report(ctx context.Context, timestamp types.R
 	if err != nil {
 		return false, nil, err
 	}
+	r.debugRecorder.RecordConsensus(timestamp, observedMessages)
 	if len(observedMessages) == 0 {
 		return false, nil, nil
 	}
@@ -712,7 +753,16 @@ func calculateObservedMessagesConsensus(observations []ccip.ExecutionObservation
 	return finalSequenceNumbers, nil
 }
 
-func (r *ExecutionReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (bool, error) {
+func (r *ExecutionReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (accept bool, err error) {
+	var rejectReason string
+	defer func() {
+		if err != nil {
+			rejectReason = err.Error()
+		}
+		if !accept {
+			r.debugRecorder.RecordRejectedReport(timestamp, rejectReason)
+		}
+	}()
 	lggr := r.lggr.Named("ShouldAcceptFinalizedReport")
 	execReport, err := r.offRampReader.DecodeExecutionReport(ctx, report)
 	if err != nil {
@@ -733,10 +783,11 @@ func (r *ExecutionReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Conte
 	}
 	if stale {
 		lggr.Info("Execution report is stale")
+		rejectReason = "stale report"
 		return false, nil
 	}
 	// Else just assume in flight
-	if err = r.inflightReports.add(lggr, execReport.Messages); err != nil {
+	if err = r.inflightReports.add(ctx, lggr, execReport.Messages); err != nil {
 		return false, err
 	}
 	if len(execReport.Messages) > 0 {
@@ -900,6 +951,7 @@ func (r *ExecutionReportingPlugin) getUnexpiredCommitReports(
 
 type execTokenData struct {
 	rateLimiterTokenBucket cciptypes.TokenBucketRateLimit
+	destPoolRateLimits     map[cciptypes.Address]cciptypes.TokenBucketRateLimit
 	sourceTokenPrices      map[cciptypes.Address]*big.Int
 	destTokenPrices        map[cciptypes.Address]*big.Int
 	sourceToDestTokens     map[cciptypes.Address]cciptypes.Address
@@ -952,6 +1004,9 @@ func (r *ExecutionReportingPlugin) prepareTokenExecData(ctx context.Context) (ex
 	if err != nil {
 		return execTokenData{}, err
 	}
+	if r.messageTracker != nil {
+		r.messageTracker.TokenPrices(sourceTokensPrices, destTokenPrices[r.destWrappedNative])
+	}
 
 	sourceToDestTokens, err := r.offRampReader.GetSourceToDestTokensMapping(ctx)
 	if err != nil {
@@ -963,8 +1018,15 @@ func (r *ExecutionReportingPlugin) prepareTokenExecData(ctx context.Context) (ex
 		return execTokenData{}, err
 	}
 
+	destPoolRateLimits, err := r.getDestPoolRateLimits(ctx)
+	if err != nil {
+		// The pools still enforce their rate limits onchain, executions exceeding them revert.
+		r.lggr.Warnw("Unable to read the token pools rate limits, messages are not checked against them", "err", err)
+	}
+
 	return execTokenData{
 		rateLimiterTokenBucket: rateLimiterTokenBucket,
+		destPoolRateLimits:     destPoolRateLimits,
 		sourceTokenPrices:      sourceTokensPrices,
 		sourceToDestTokens:     sourceToDestTokens,
 		destTokenPrices:        destTokenPrices,
@@ -972,6 +1034,54 @@ func (r *ExecutionReportingPlugin) prepareTokenExecData(ctx context.Context) (ex
 	}, nil
 }
 
+// getDestPoolRateLimits returns the inbound rate limiter state of the destination token pools by destination token.
+// The pools whose rate limiter can't be read, e.g. because their version isn't supported, are left out.
+func (r *ExecutionReportingPlugin) getDestPoolRateLimits(ctx context.Context) (map[cciptypes.Address]cciptypes.TokenBucketRateLimit, error) {
+	offRampTokens, err := r.offRampReader.GetTokens(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get offRamp tokens: %w", err)
+	}
+	if len(offRampTokens.DestinationPool) == 0 {
+		return map[cciptypes.Address]cciptypes.TokenBucketRateLimit{}, nil
+	}
+
+	destTokens := make([]cciptypes.Address, 0, len(offRampTokens.DestinationPool))
+	pools := make([]cciptypes.Address, 0, len(offRampTokens.DestinationPool))
+	for token, pool := range offRampTokens.DestinationPool {
+		destTokens = append(destTokens, token)
+		pools = append(pools, pool)
+	}
+
+	destPoolRateLimits := make(map[cciptypes.Address]cciptypes.TokenBucketRateLimit, len(destTokens))
+	rateLimits, err := r.tokenPoolBatchedReader.GetInboundTokenPoolRateLimits(ctx, pools)
+	if err == nil && len(rateLimits) != len(pools) {
+		err = fmt.Errorf("token pool rate limits length exp=%d actual=%d", len(pools), len(rateLimits))
+	}
+	if err != nil {
+		// A single unsupported or failing pool fails the whole batch, the pools are read one by one to only skip it.
+		r.lggr.Warnw("Unable to batch read the token pools rate limits, reading them one by one", "err", err)
+		for i, pool := range pools {
+			rateLimit, err := r.tokenPoolBatchedReader.GetInboundTokenPoolRateLimits(ctx, []cciptypes.Address{pool})
+			if err == nil && len(rateLimit) != 1 {
+				err = fmt.Errorf("token pool rate limits length exp=1 actual=%d", len(rateLimit))
+			}
+			if err != nil {
+				// The pool still enforces its rate limit onchain, executions exceeding it revert.
+				r.lggr.Warnw("Unable to read the token pool rate limit, messages are not checked against it",
+					"pool", pool, "token", destTokens[i], "err", err)
+				continue
+			}
+			destPoolRateLimits[destTokens[i]] = rateLimit[0]
+		}
+		return destPoolRateLimits, nil
+	}
+
+	for i, token := range destTokens {
+		destPoolRateLimits[token] = rateLimits[i]
+	}
+	return destPoolRateLimits, nil
+}
+
 // ensurePriceRegistrySynchronization ensures that the source price registry points to the same as the one configured on the onRamp.
 // This is required since the price registry address on the onRamp can change over time.
 func (r *ExecutionReportingPlugin) ensurePriceRegistrySynchronization(ctx context.Context) error {
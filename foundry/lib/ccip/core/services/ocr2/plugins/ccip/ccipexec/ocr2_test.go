@@ -29,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	ccipcachemocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
@@ -216,7 +217,7 @@ func TestExecutionReportingPlugin_Observation(t *testing.T) {
 			p.sourcePriceRegistryProvider = mockOnRampPriceRegistryProvider
 
 			p.commitRootsCache = cache.NewCommitRootsCache(logger.TestLogger(t), time.Minute, time.Minute)
-			p.chainHealthcheck = cache.NewChainHealthcheck(p.lggr, mockOnRampReader, commitStoreReader)
+			p.chainHealthcheck = cache.NewChainHealthcheck(p.lggr, mockOnRampReader, commitStoreReader, ccipconfig.ChainHealthConfig{}, cache.ChainSignals{}, cache.ChainSignals{})
 
 			_, err = p.Observation(ctx, types.ReportTimestamp{}, types.Query{})
 			if tc.expErr {
@@ -505,6 +506,7 @@ func TestExecutionReportingPlugin_buildBatch(t *testing.T) {
 		reqs                     []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta
 		inflight                 *big.Int
 		tokenLimit, destGasPrice *big.Int
+		poolBuckets              tokenPoolBuckets
 		srcPrices, dstPrices     map[cciptypes.Address]*big.Int
 		offRampNoncesBySender    map[cciptypes.Address]uint64
 		srcToDestTokens          map[cciptypes.Address]cciptypes.Address
@@ -595,6 +597,21 @@ func TestExecutionReportingPlugin_buildBatch(t *testing.T) {
 			offRampNoncesBySender: map[cciptypes.Address]uint64{sender1: 1},
 			expectedStates:        []messageExecStatus{newMessageExecState(msg5.SequenceNumber, msg5.MessageID, AggregateTokenLimitExceeded)},
 		},
+		{
+			name:         "message with tokens is not executed if the token pool rate limit is reached",
+			reqs:         []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{msg4},
+			inflight:     big.NewInt(0),
+			tokenLimit:   big.NewInt(1000),
+			destGasPrice: big.NewInt(10),
+			poolBuckets:  tokenPoolBuckets{destNative: big.NewInt(50)},
+			srcPrices:    map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1e18)},
+			dstPrices:    map[cciptypes.Address]*big.Int{destNative: big.NewInt(1e18)},
+			srcToDestTokens: map[cciptypes.Address]cciptypes.Address{
+				srcNative: destNative,
+			},
+			offRampNoncesBySender: map[cciptypes.Address]uint64{sender1: 0},
+			expectedStates:        []messageExecStatus{newMessageExecState(msg4.SequenceNumber, msg4.MessageID, TokenPoolRateLimitExceeded)},
+		},
 		{
 			name:                  "skip when nonce doesn't match chain value",
 			reqs:                  []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{msg1},
@@ -764,6 +781,7 @@ func TestExecutionReportingPlugin_buildBatch(t *testing.T) {
 				commitReportWithSendRequests{sendRequestsWithMeta: tc.reqs},
 				tc.inflight,
 				tc.tokenLimit,
+				tc.poolBuckets,
 				tc.srcPrices,
 				tc.dstPrices,
 				tc.destGasPrice,
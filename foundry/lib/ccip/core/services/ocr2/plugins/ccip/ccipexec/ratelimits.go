@@ -0,0 +1,79 @@
+package ccipexec
+
+import (
+	"math/big"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// tokenPoolBuckets simulates the inbound rate limiters of the destination token pools while a batch is built,
+// it holds the tokens available in the bucket of each rate limited destination token.
+// Tokens without a bucket, e.g. because the rate limiter of their pool is disabled, are never limited.
+type tokenPoolBuckets map[cciptypes.Address]*big.Int
+
+// newTokenPoolBuckets returns the buckets of the enabled rate limiters, refilled up to now as the pools would do
+// when consuming them.
+func newTokenPoolBuckets(rateLimits map[cciptypes.Address]cciptypes.TokenBucketRateLimit, now time.Time) tokenPoolBuckets {
+	buckets := make(tokenPoolBuckets, len(rateLimits))
+	for token, rateLimit := range rateLimits {
+		if !rateLimit.IsEnabled || rateLimit.Tokens == nil || rateLimit.Capacity == nil || rateLimit.Rate == nil {
+			continue
+		}
+		tokens := new(big.Int).Set(rateLimit.Tokens)
+		if elapsed := now.Unix() - int64(rateLimit.LastUpdated); elapsed > 0 {
+			tokens.Add(tokens, new(big.Int).Mul(rateLimit.Rate, big.NewInt(elapsed)))
+		}
+		if tokens.Cmp(rateLimit.Capacity) > 0 {
+			tokens.Set(rateLimit.Capacity)
+		}
+		buckets[token] = tokens
+	}
+	return buckets
+}
+
+// consumeInflight consumes the tokens of the messages that are already inflight, they will be taken from
+// the buckets before the ones of the batch being built.
+func (b tokenPoolBuckets) consumeInflight(inflight []InflightInternalExecutionReport, sourceToDest map[cciptypes.Address]cciptypes.Address) {
+	for _, rep := range inflight {
+		for _, message := range rep.messages {
+			b.consume(sourceToDest, message.TokenAmounts)
+		}
+	}
+}
+
+// exceeded returns the first destination token whose bucket doesn't have enough tokens left for the amounts.
+func (b tokenPoolBuckets) exceeded(sourceToDest map[cciptypes.Address]cciptypes.Address, tokenAmounts []cciptypes.TokenAmount) (cciptypes.Address, bool) {
+	for token, amount := range b.destAmounts(sourceToDest, tokenAmounts) {
+		if b[token].Cmp(amount) < 0 {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+// consume takes the amounts from the buckets, a bucket can go negative when inflight messages overdraw it.
+func (b tokenPoolBuckets) consume(sourceToDest map[cciptypes.Address]cciptypes.Address, tokenAmounts []cciptypes.TokenAmount) {
+	for token, amount := range b.destAmounts(sourceToDest, tokenAmounts) {
+		b[token].Sub(b[token], amount)
+	}
+}
+
+// destAmounts sums the amounts per rate limited destination token, a message can transfer the same token several times.
+func (b tokenPoolBuckets) destAmounts(sourceToDest map[cciptypes.Address]cciptypes.Address, tokenAmounts []cciptypes.TokenAmount) map[cciptypes.Address]*big.Int {
+	amounts := make(map[cciptypes.Address]*big.Int)
+	for _, tokenAmount := range tokenAmounts {
+		destToken, ok := sourceToDest[tokenAmount.Token]
+		if !ok {
+			continue
+		}
+		if _, limited := b[destToken]; !limited {
+			continue
+		}
+		if _, ok := amounts[destToken]; !ok {
+			amounts[destToken] = big.NewInt(0)
+		}
+		amounts[destToken].Add(amounts[destToken], tokenAmount.Amount)
+	}
+	return amounts
+}
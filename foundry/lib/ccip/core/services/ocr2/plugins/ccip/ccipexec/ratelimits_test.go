@@ -0,0 +1,167 @@
+package ccipexec
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	batchreadermocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader/mocks"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+func Test_newTokenPoolBuckets(t *testing.T) {
+	now := time.Unix(1000, 0)
+	link := ccipcalc.HexToAddress("0x1")
+	usdc := ccipcalc.HexToAddress("0x2")
+	weth := ccipcalc.HexToAddress("0x3")
+	wavax := ccipcalc.HexToAddress("0x4")
+
+	buckets := newTokenPoolBuckets(map[cciptypes.Address]cciptypes.TokenBucketRateLimit{
+		// refilled for 10 seconds
+		link: {IsEnabled: true, Tokens: big.NewInt(100), Capacity: big.NewInt(1000), Rate: big.NewInt(5), LastUpdated: 990},
+		// refilled up to the capacity
+		usdc: {IsEnabled: true, Tokens: big.NewInt(100), Capacity: big.NewInt(120), Rate: big.NewInt(5), LastUpdated: 990},
+		// updated after now, not refilled
+		weth:  {IsEnabled: true, Tokens: big.NewInt(100), Capacity: big.NewInt(1000), Rate: big.NewInt(5), LastUpdated: 1010},
+		wavax: {IsEnabled: false, Tokens: big.NewInt(0), Capacity: big.NewInt(0), Rate: big.NewInt(0)},
+	}, now)
+
+	assert.Equal(t, tokenPoolBuckets{
+		link: big.NewInt(150),
+		usdc: big.NewInt(120),
+		weth: big.NewInt(100),
+	}, buckets)
+}
+
+func Test_tokenPoolBuckets(t *testing.T) {
+	srcLink := ccipcalc.HexToAddress("0x1")
+	srcUsdc := ccipcalc.HexToAddress("0x2")
+	srcWeth := ccipcalc.HexToAddress("0x3")
+	srcUnknown := ccipcalc.HexToAddress("0x4")
+	link := ccipcalc.HexToAddress("0x11")
+	usdc := ccipcalc.HexToAddress("0x12")
+	weth := ccipcalc.HexToAddress("0x13")
+	sourceToDest := map[cciptypes.Address]cciptypes.Address{srcLink: link, srcUsdc: usdc, srcWeth: weth}
+
+	buckets := tokenPoolBuckets{link: big.NewInt(100), usdc: big.NewInt(50)}
+
+	t.Run("inflight messages are consumed first", func(t *testing.T) {
+		buckets.consumeInflight([]InflightInternalExecutionReport{{
+			messages: []cciptypes.EVM2EVMMessage{
+				{TokenAmounts: []cciptypes.TokenAmount{{Token: srcLink, Amount: big.NewInt(20)}}},
+				{TokenAmounts: []cciptypes.TokenAmount{{Token: srcWeth, Amount: big.NewInt(1000)}}},
+			},
+		}}, sourceToDest)
+		assert.Equal(t, tokenPoolBuckets{link: big.NewInt(80), usdc: big.NewInt(50)}, buckets)
+	})
+
+	t.Run("amounts of the same token are summed", func(t *testing.T) {
+		token, exceeded := buckets.exceeded(sourceToDest, []cciptypes.TokenAmount{
+			{Token: srcLink, Amount: big.NewInt(50)},
+			{Token: srcLink, Amount: big.NewInt(50)},
+		})
+		assert.True(t, exceeded)
+		assert.Equal(t, link, token)
+	})
+
+	t.Run("tokens without a bucket are not limited", func(t *testing.T) {
+		tokenAmounts := []cciptypes.TokenAmount{
+			{Token: srcLink, Amount: big.NewInt(80)},
+			{Token: srcUsdc, Amount: big.NewInt(10)},
+			{Token: srcWeth, Amount: big.NewInt(1e18)},
+			{Token: srcUnknown, Amount: big.NewInt(1e18)},
+		}
+		_, exceeded := buckets.exceeded(sourceToDest, tokenAmounts)
+		require.False(t, exceeded)
+
+		buckets.consume(sourceToDest, tokenAmounts)
+		assert.Len(t, buckets, 2)
+		assert.Equal(t, int64(0), buckets[link].Int64())
+		assert.Equal(t, int64(40), buckets[usdc].Int64())
+	})
+
+	t.Run("nil buckets never limit", func(t *testing.T) {
+		var noBuckets tokenPoolBuckets
+		_, exceeded := noBuckets.exceeded(sourceToDest, []cciptypes.TokenAmount{{Token: srcLink, Amount: big.NewInt(1e18)}})
+		assert.False(t, exceeded)
+	})
+}
+
+func TestExecutionReportingPlugin_getDestPoolRateLimits(t *testing.T) {
+	ctx := testutils.Context(t)
+	link := ccipcalc.HexToAddress("0x1")
+	linkPool := ccipcalc.HexToAddress("0x11")
+	linkRateLimit := cciptypes.TokenBucketRateLimit{IsEnabled: true, Tokens: big.NewInt(1), Capacity: big.NewInt(2), Rate: big.NewInt(3)}
+	usdc := ccipcalc.HexToAddress("0x2")
+	usdcPool := ccipcalc.HexToAddress("0x22")
+
+	testCases := []struct {
+		name      string
+		destPools map[cciptypes.Address]cciptypes.Address
+		// poolRateLimits are the rate limits of the supported pools, reading any other pool fails its batch.
+		poolRateLimits map[cciptypes.Address]cciptypes.TokenBucketRateLimit
+		exp            map[cciptypes.Address]cciptypes.TokenBucketRateLimit
+	}{
+		{
+			name: "no pools",
+			exp:  map[cciptypes.Address]cciptypes.TokenBucketRateLimit{},
+		},
+		{
+			name:           "rate limits by destination token",
+			destPools:      map[cciptypes.Address]cciptypes.Address{link: linkPool},
+			poolRateLimits: map[cciptypes.Address]cciptypes.TokenBucketRateLimit{linkPool: linkRateLimit},
+			exp:            map[cciptypes.Address]cciptypes.TokenBucketRateLimit{link: linkRateLimit},
+		},
+		{
+			name:           "unsupported pool is skipped",
+			destPools:      map[cciptypes.Address]cciptypes.Address{link: linkPool, usdc: usdcPool},
+			poolRateLimits: map[cciptypes.Address]cciptypes.TokenBucketRateLimit{linkPool: linkRateLimit},
+			exp:            map[cciptypes.Address]cciptypes.TokenBucketRateLimit{link: linkRateLimit},
+		},
+		{
+			name:      "all pools failing",
+			destPools: map[cciptypes.Address]cciptypes.Address{link: linkPool, usdc: usdcPool},
+			exp:       map[cciptypes.Address]cciptypes.TokenBucketRateLimit{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			offRampReader := ccipdatamocks.NewOffRampReader(t)
+			offRampReader.On("GetTokens", ctx).Return(cciptypes.OffRampTokens{DestinationPool: tc.destPools}, nil)
+			tokenPoolBatchedReader := batchreadermocks.NewTokenPoolBatchedReader(t)
+			tokenPoolBatchedReader.On("GetInboundTokenPoolRateLimits", ctx, mock.Anything).Return(
+				func(_ context.Context, pools []cciptypes.Address) ([]cciptypes.TokenBucketRateLimit, error) {
+					rateLimits := make([]cciptypes.TokenBucketRateLimit, len(pools))
+					for i, pool := range pools {
+						rateLimit, ok := tc.poolRateLimits[pool]
+						if !ok {
+							return nil, fmt.Errorf("unsupported token pool %s", pool)
+						}
+						rateLimits[i] = rateLimit
+					}
+					return rateLimits, nil
+				}).Maybe()
+
+			p := &ExecutionReportingPlugin{
+				lggr:                   logger.TestLogger(t),
+				offRampReader:          offRampReader,
+				tokenPoolBatchedReader: tokenPoolBatchedReader,
+			}
+			rateLimits, err := p.getDestPoolRateLimits(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, rateLimits)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package ccipexec
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/attestation"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/http"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/observability"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/usdc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// tokenDataProviderArgs holds everything a token data provider can be built from.
+type tokenDataProviderArgs struct {
+	lggr          logger.Logger
+	jobID         string
+	sourceChainID int64
+	sourceLP      logpoller.LogPoller
+}
+
+// tokenDataProviderFactory creates and closes the tokendata.Reader of a single provider type.
+// close MUST mirror the log poller filters registered by create.
+type tokenDataProviderFactory struct {
+	create func(args tokenDataProviderArgs, cfg ccipconfig.TokenDataProviderConfig, qopts ...pg.QOpt) (tokendata.Reader, error)
+	close  func(args tokenDataProviderArgs, cfg ccipconfig.TokenDataProviderConfig, qopts ...pg.QOpt) error
+}
+
+// tokenDataProviderFactories is the registry of the supported ccipconfig.TokenDataProviderConfig types.
+var tokenDataProviderFactories = map[string]tokenDataProviderFactory{
+	ccipconfig.TokenDataProviderTypeUSDC: {
+		create: newUSDCTokenDataProvider,
+		close:  closeUSDCTokenDataProvider,
+	},
+	ccipconfig.TokenDataProviderTypeHTTPAttestation: {
+		create: newHTTPAttestationTokenDataProvider,
+		close:  closeHTTPAttestationTokenDataProvider,
+	},
+}
+
+// tokenDataProviderConfigs returns all the configured token data providers, including the legacy USDCConfig.
+func tokenDataProviderConfigs(pluginConfig ccipconfig.ExecutionPluginJobSpecConfig) ([]ccipconfig.TokenDataProviderConfig, error) {
+	if err := pluginConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	providers := make([]ccipconfig.TokenDataProviderConfig, 0, len(pluginConfig.TokenDataProviders)+1)
+	if pluginConfig.USDCConfig.AttestationAPI != "" {
+		usdcConfig, err := json.Marshal(pluginConfig.USDCConfig)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, ccipconfig.TokenDataProviderConfig{
+			Type:               ccipconfig.TokenDataProviderTypeUSDC,
+			SourceTokenAddress: pluginConfig.USDCConfig.SourceTokenAddress,
+			Config:             usdcConfig,
+		})
+	}
+	return append(providers, pluginConfig.TokenDataProviders...), nil
+}
+
+func initTokenDataProviders(args tokenDataProviderArgs, pluginConfig ccipconfig.ExecutionPluginJobSpecConfig, qopts ...pg.QOpt) (map[cciptypes.Address]tokendata.Reader, error) {
+	providerConfigs, err := tokenDataProviderConfigs(pluginConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenDataProviders := make(map[cciptypes.Address]tokendata.Reader, len(providerConfigs))
+	for _, cfg := range providerConfigs {
+		providerFactory, ok := tokenDataProviderFactories[cfg.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown token data provider type %q", cfg.Type)
+		}
+
+		args.lggr.Infow("Token data provider enabled", "type", cfg.Type, "token", cfg.SourceTokenAddress)
+		reader, err := providerFactory.create(args, cfg, qopts...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "new %s token data provider for token %s", cfg.Type, cfg.SourceTokenAddress)
+		}
+		tokenDataProviders[cciptypes.Address(cfg.SourceTokenAddress.String())] = observability.NewObservedReader(reader, args.sourceChainID, cfg.Type)
+	}
+	return tokenDataProviders, nil
+}
+
+func closeTokenDataProviders(args tokenDataProviderArgs, pluginConfig ccipconfig.ExecutionPluginJobSpecConfig, qopts ...pg.QOpt) error {
+	providerConfigs, err := tokenDataProviderConfigs(pluginConfig)
+	if err != nil {
+		return err
+	}
+
+	for _, cfg := range providerConfigs {
+		providerFactory, ok := tokenDataProviderFactories[cfg.Type]
+		if !ok {
+			return fmt.Errorf("unknown token data provider type %q", cfg.Type)
+		}
+		if err := providerFactory.close(args, cfg, qopts...); err != nil {
+			return errors.Wrapf(err, "close %s token data provider for token %s", cfg.Type, cfg.SourceTokenAddress)
+		}
+	}
+	return nil
+}
+
+func newUSDCTokenDataProvider(args tokenDataProviderArgs, cfg ccipconfig.TokenDataProviderConfig, qopts ...pg.QOpt) (tokendata.Reader, error) {
+	usdcConfig, err := cfg.USDCConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	attestationURI, err := url.ParseRequestURI(usdcConfig.AttestationAPI)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse USDC attestation API")
+	}
+
+	usdcReader, err := ccipdata.NewUSDCReader(args.lggr, args.jobID, usdcConfig.SourceMessageTransmitterAddress, args.sourceLP, true, qopts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "new usdc reader")
+	}
+
+	return usdc.NewUSDCTokenDataReader(
+		args.lggr,
+		usdcReader,
+		attestationURI,
+		int(usdcConfig.AttestationAPITimeoutSeconds),
+		usdcConfig.SourceTokenAddress,
+		time.Duration(usdcConfig.AttestationAPIIntervalMilliseconds)*time.Millisecond,
+	), nil
+}
+
+func closeUSDCTokenDataProvider(args tokenDataProviderArgs, cfg ccipconfig.TokenDataProviderConfig, qopts ...pg.QOpt) error {
+	usdcConfig, err := cfg.USDCConfig()
+	if err != nil {
+		return err
+	}
+	return ccipdata.CloseUSDCReader(args.lggr, args.jobID, usdcConfig.SourceMessageTransmitterAddress, args.sourceLP, qopts...)
+}
+
+func newHTTPAttestationTokenDataProvider(args tokenDataProviderArgs, cfg ccipconfig.TokenDataProviderConfig, qopts ...pg.QOpt) (tokendata.Reader, error) {
+	attestationConfig, err := cfg.HTTPAttestationConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	eventReader, err := ccipdata.NewTokenEventReader(
+		args.lggr,
+		args.jobID,
+		utils.Keccak256Fixed([]byte(attestationConfig.SourceEventSignature)),
+		attestationConfig.SourceEventAddress,
+		args.sourceLP,
+		true,
+		qopts...,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "new token event reader")
+	}
+
+	return attestation.NewTokenDataReader(
+		args.lggr,
+		eventReader,
+		http.NewObservedAttestationIHttpClient(&http.HttpClient{}),
+		cfg.SourceTokenAddress,
+		attestationConfig,
+	)
+}
+
+func closeHTTPAttestationTokenDataProvider(args tokenDataProviderArgs, cfg ccipconfig.TokenDataProviderConfig, qopts ...pg.QOpt) error {
+	attestationConfig, err := cfg.HTTPAttestationConfig()
+	if err != nil {
+		return err
+	}
+	return ccipdata.CloseTokenEventReader(
+		args.lggr,
+		args.jobID,
+		utils.Keccak256Fixed([]byte(attestationConfig.SourceEventSignature)),
+		attestationConfig.SourceEventAddress,
+		args.sourceLP,
+		qopts...,
+	)
+}
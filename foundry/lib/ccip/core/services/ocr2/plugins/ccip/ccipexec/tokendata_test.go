@@ -0,0 +1,69 @@
+package ccipexec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+func TestTokenDataProviderConfigs(t *testing.T) {
+	legacyUSDC := ccipconfig.USDCConfig{
+		AttestationAPI:                  "http://localhost:1234",
+		SourceTokenAddress:              utils.RandomAddress(),
+		SourceMessageTransmitterAddress: utils.RandomAddress(),
+	}
+	otherToken := utils.RandomAddress()
+	otherUSDC, err := json.Marshal(ccipconfig.USDCConfig{
+		AttestationAPI:                  "http://localhost:5678",
+		SourceMessageTransmitterAddress: utils.RandomAddress(),
+	})
+	require.NoError(t, err)
+
+	t.Run("legacy usdc config is registered as a provider", func(t *testing.T) {
+		providers, err := tokenDataProviderConfigs(ccipconfig.ExecutionPluginJobSpecConfig{
+			USDCConfig: legacyUSDC,
+			TokenDataProviders: []ccipconfig.TokenDataProviderConfig{
+				{Type: ccipconfig.TokenDataProviderTypeUSDC, SourceTokenAddress: otherToken, Config: otherUSDC},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, providers, 2)
+
+		assert.Equal(t, ccipconfig.TokenDataProviderTypeUSDC, providers[0].Type)
+		assert.Equal(t, legacyUSDC.SourceTokenAddress, providers[0].SourceTokenAddress)
+		usdcConfig, err := providers[0].USDCConfig()
+		require.NoError(t, err)
+		assert.Equal(t, legacyUSDC, usdcConfig)
+
+		assert.Equal(t, otherToken, providers[1].SourceTokenAddress)
+	})
+
+	t.Run("no providers", func(t *testing.T) {
+		providers, err := tokenDataProviderConfigs(ccipconfig.ExecutionPluginJobSpecConfig{})
+		require.NoError(t, err)
+		assert.Empty(t, providers)
+	})
+
+	t.Run("invalid provider", func(t *testing.T) {
+		_, err := tokenDataProviderConfigs(ccipconfig.ExecutionPluginJobSpecConfig{
+			TokenDataProviders: []ccipconfig.TokenDataProviderConfig{
+				{Type: "unknown", SourceTokenAddress: otherToken},
+			},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestTokenDataProviderFactoriesCoverConfigTypes(t *testing.T) {
+	for _, typ := range []string{ccipconfig.TokenDataProviderTypeUSDC, ccipconfig.TokenDataProviderTypeHTTPAttestation} {
+		factory, ok := tokenDataProviderFactories[typ]
+		require.True(t, ok, "missing token data provider factory for %s", typ)
+		assert.NotNil(t, factory.create)
+		assert.NotNil(t, factory.close)
+	}
+}
@@ -0,0 +1,182 @@
+package ccipsim
+
+import (
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/testhelpers"
+)
+
+func TestReorg(t *testing.T) {
+	ctx := testutils.Context(t)
+	chain, user := testhelpers.SetupChain(t)
+	nonce, err := chain.PendingNonceAt(ctx, user.From)
+	require.NoError(t, err)
+	tx, err := user.Signer(user.From, types.NewTransaction(nonce, user.From, big.NewInt(1), 21_000, big.NewInt(1e9), nil))
+	require.NoError(t, err)
+	require.NoError(t, chain.SendTransaction(ctx, tx))
+	chain.Commit()
+	head := chain.Blockchain().CurrentHeader()
+
+	require.NoError(t, reorg(chain, 1))
+	newHead := chain.Blockchain().CurrentHeader()
+	assert.Equal(t, head.Number.Uint64()+1, newHead.Number.Uint64())
+	assert.NotEqual(t, head.Hash(), chain.Blockchain().GetHeaderByNumber(head.Number.Uint64()).Hash())
+	_, err = chain.TransactionReceipt(ctx, tx.Hash())
+	require.ErrorIs(t, err, ethereum.NotFound, "the transaction was dropped with its block")
+
+	require.Error(t, reorg(chain, int(newHead.Number.Uint64())))
+}
+
+func TestScenarioValidate(t *testing.T) {
+	chains := []ChainConfig{
+		{ChainID: testhelpers.SourceChainID, ChainSelector: testhelpers.SourceChainSelector},
+		{ChainID: testhelpers.DestChainID, ChainSelector: testhelpers.DestChainSelector},
+	}
+	lane := LaneConfig{SourceChainSelector: testhelpers.SourceChainSelector, DestChainSelector: testhelpers.DestChainSelector}
+	messages := []MessagePattern{{Count: 1}}
+
+	testCases := []struct {
+		name     string
+		scenario Scenario
+		expErr   bool
+	}{
+		{name: "default", scenario: DefaultScenario()},
+		{
+			name: "lanes sharing a chain",
+			scenario: Scenario{
+				Chains: append(chains, ChainConfig{ChainID: 2337, ChainSelector: 12922642891491394802}),
+				Lanes: []LaneConfig{
+					lane,
+					{SourceChainSelector: 12922642891491394802, DestChainSelector: testhelpers.DestChainSelector},
+					{SourceChainSelector: testhelpers.DestChainSelector, DestChainSelector: testhelpers.SourceChainSelector},
+				},
+				Messages: messages,
+			},
+		},
+		{name: "single chain", scenario: Scenario{Chains: chains[:1], Lanes: []LaneConfig{lane}, Messages: messages}, expErr: true},
+		{name: "no lanes", scenario: Scenario{Chains: chains, Messages: messages}, expErr: true},
+		{name: "no messages", scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}}, expErr: true},
+		{
+			name:     "same chains",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{{SourceChainSelector: testhelpers.DestChainSelector, DestChainSelector: testhelpers.DestChainSelector}}, Messages: messages},
+			expErr:   true,
+		},
+		{
+			name:     "unknown lane chain",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{{SourceChainSelector: 12922642891491394802, DestChainSelector: testhelpers.DestChainSelector}}, Messages: messages},
+			expErr:   true,
+		},
+		{
+			name:     "duplicate chain",
+			scenario: Scenario{Chains: append(chains, chains[0]), Lanes: []LaneConfig{lane}, Messages: messages},
+			expErr:   true,
+		},
+		{
+			name: "selector of another chain",
+			scenario: Scenario{Chains: []ChainConfig{
+				{ChainID: testhelpers.SourceChainID, ChainSelector: testhelpers.DestChainSelector},
+				{ChainID: testhelpers.DestChainID, ChainSelector: testhelpers.SourceChainSelector},
+			}, Lanes: []LaneConfig{lane}, Messages: messages},
+			expErr: true,
+		},
+		{name: "too few oracles", scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}, Messages: messages, Oracles: 3}, expErr: true},
+		{
+			name:     "negative token amount",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}, Messages: []MessagePattern{{Count: 1, TokenAmount: big.NewInt(-1)}}},
+			expErr:   true,
+		},
+		{
+			name: "faults",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}, Messages: messages, Faults: []Fault{
+				{Type: FaultReorg, ChainSelector: testhelpers.SourceChainSelector, Depth: 2},
+				{Type: FaultRPCErrors, ChainSelector: testhelpers.DestChainSelector, Blocks: 5},
+				{Type: FaultCurse, ChainSelector: testhelpers.DestChainSelector, AfterMessages: 1, Blocks: 5},
+			}},
+		},
+		{
+			name:     "unknown fault",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}, Messages: messages, Faults: []Fault{{Type: "flood", ChainSelector: testhelpers.SourceChainSelector}}},
+			expErr:   true,
+		},
+		{
+			name:     "unknown fault chain",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}, Messages: messages, Faults: []Fault{{Type: FaultReorg, ChainSelector: 12922642891491394802, Depth: 1}}},
+			expErr:   true,
+		},
+		{
+			name:     "reorg without depth",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}, Messages: messages, Faults: []Fault{{Type: FaultReorg, ChainSelector: testhelpers.SourceChainSelector}}},
+			expErr:   true,
+		},
+		{
+			name:     "curse without duration",
+			scenario: Scenario{Chains: chains, Lanes: []LaneConfig{lane}, Messages: messages, Faults: []Fault{{Type: FaultCurse, ChainSelector: testhelpers.DestChainSelector}}},
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scenario.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLoadScenario(t *testing.T) {
+	path := t.TempDir() + "/scenario.json"
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"Name": "burst",
+		"Chains": [{"ChainID": 1000, "ChainSelector": 11787463284727550157}, {"ChainID": 1337, "ChainSelector": 3379446385462418246}],
+		"Lanes": [{"SourceChainSelector": 11787463284727550157, "DestChainSelector": 3379446385462418246}],
+		"Messages": [{"Count": 10, "TokenAmount": 1000}, {"Count": 2, "Strict": true}],
+		"MessagesPerBlock": 5,
+		"Faults": [{"Type": "rpc-errors", "ChainSelector": 3379446385462418246, "AfterMessages": 5, "Blocks": 3}]
+	}`), 0600))
+
+	scenario, err := LoadScenario(path)
+	require.NoError(t, err)
+	assert.Equal(t, "burst", scenario.Name)
+	assert.Equal(t, 12, scenario.totalMessages())
+	assert.Equal(t, big.NewInt(1000), scenario.Messages[0].TokenAmount)
+	assert.Equal(t, 5, scenario.messagesPerBlock())
+	assert.Equal(t, time.Second, scenario.blockInterval())
+	assert.Equal(t, []Fault{{Type: FaultRPCErrors, ChainSelector: testhelpers.DestChainSelector, AfterMessages: 5, Blocks: 3}}, scenario.Faults)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"Lanes": []}`), 0600))
+	_, err = LoadScenario(path)
+	require.Error(t, err)
+}
+
+func TestNewLatencyStats(t *testing.T) {
+	assert.Equal(t, LatencyStats{}, newLatencyStats(nil))
+
+	samples := make([]time.Duration, 100)
+	for i := range samples {
+		// Reversed so that the samples have to be sorted.
+		samples[i] = time.Duration(100-i) * time.Second
+	}
+	assert.Equal(t, LatencyStats{
+		Count: 100,
+		P50:   50 * time.Second,
+		P90:   90 * time.Second,
+		P99:   99 * time.Second,
+		Max:   100 * time.Second,
+	}, newLatencyStats(samples))
+
+	assert.Equal(t, LatencyStats{Count: 1, P50: time.Second, P90: time.Second, P99: time.Second, Max: time.Second},
+		newLatencyStats([]time.Duration{time.Second}))
+}
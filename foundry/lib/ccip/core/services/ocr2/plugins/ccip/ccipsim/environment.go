@@ -0,0 +1,57 @@
+package ccipsim
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/mock_arm_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/link_token_interface"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+// Environment deploys the chains and the lanes of a simulation, see integrationtesthelpers.SimEnvironment for the one
+// backed by the integration test harness.
+type Environment interface {
+	// NewChain starts a simulated chain, the returned user is funded and owns the contracts deployed on the chain.
+	NewChain(cfg ChainConfig) (*backends.SimulatedBackend, *bind.TransactOpts, error)
+	// NewLane deploys the contracts of a lane between two chains started by NewChain and starts the DON of the lane.
+	NewLane(source, dest Chain, opts LaneOptions) (LaneContracts, error)
+}
+
+// Chain is a chain started by the Environment.
+type Chain struct {
+	ChainConfig
+	Backend *backends.SimulatedBackend
+	User    *bind.TransactOpts
+}
+
+// LaneOptions configures the DON of a lane.
+type LaneOptions struct {
+	Oracles int
+	// WrapClient wraps the clients of the nodes to the chains, to inject the RPC faults.
+	WrapClient  func(chainID *big.Int, c client.Client) client.Client
+	ChainHealth *ccipconfig.ChainHealthConfig
+}
+
+// LaneContracts are the contracts of a lane the messages are sent through and observed on.
+type LaneContracts struct {
+	Router    *router.Router
+	LinkToken *link_token_interface.LinkToken
+	OnRamp    *evm_2_evm_onramp.EVM2EVMOnRamp
+	SourceARM *mock_arm_contract.MockARMContract
+
+	CommitStore *commit_store.CommitStore
+	OffRamp     *evm_2_evm_offramp.EVM2EVMOffRamp
+	DestARM     *mock_arm_contract.MockARMContract
+	// Receiver receives the messages, StrictReceiver the strict ones.
+	Receiver       common.Address
+	StrictReceiver common.Address
+}
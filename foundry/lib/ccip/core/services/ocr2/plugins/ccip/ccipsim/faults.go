@@ -0,0 +1,115 @@
+package ccipsim
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var errInjectedRPC = errors.New("ccipsim: injected RPC error")
+
+// rpcFaults toggles the RPC errors of the nodes per chain.
+type rpcFaults struct {
+	mu     sync.Mutex
+	chains map[string]*atomic.Bool
+}
+
+func newRPCFaults() *rpcFaults {
+	return &rpcFaults{chains: make(map[string]*atomic.Bool)}
+}
+
+func (f *rpcFaults) failing(chainID *big.Int) *atomic.Bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	failing, ok := f.chains[chainID.String()]
+	if !ok {
+		failing = new(atomic.Bool)
+		f.chains[chainID.String()] = failing
+	}
+	return failing
+}
+
+// wrap is used as the WrapClient of the integration harness.
+func (f *rpcFaults) wrap(chainID *big.Int, c client.Client) client.Client {
+	return &faultyClient{Client: c, failing: f.failing(chainID)}
+}
+
+// faultyClient fails the reads the plugins and log poller rely on while its chain is failing.
+type faultyClient struct {
+	client.Client
+	failing *atomic.Bool
+}
+
+func (c *faultyClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if c.failing.Load() {
+		return nil, errInjectedRPC
+	}
+	return c.Client.CallContract(ctx, msg, blockNumber)
+}
+
+func (c *faultyClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if c.failing.Load() {
+		return errInjectedRPC
+	}
+	return c.Client.CallContext(ctx, result, method, args...)
+}
+
+func (c *faultyClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	if c.failing.Load() {
+		return errInjectedRPC
+	}
+	return c.Client.BatchCallContext(ctx, b)
+}
+
+func (c *faultyClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if c.failing.Load() {
+		return nil, errInjectedRPC
+	}
+	return c.Client.FilterLogs(ctx, q)
+}
+
+func (c *faultyClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	if c.failing.Load() {
+		return nil, errInjectedRPC
+	}
+	return c.Client.HeadByNumber(ctx, n)
+}
+
+func (c *faultyClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
+	if c.failing.Load() {
+		return nil, errInjectedRPC
+	}
+	return c.Client.LatestBlockHeight(ctx)
+}
+
+// reorg replaces the latest depth blocks of the chain by depth+1 empty blocks, so that the new chain is the canonical one.
+// The pending transactions, e.g. the ones of the nodes, can't be forked away, so they are mined first in the head that
+// is dropped.
+func reorg(chain *backends.SimulatedBackend, depth int) error {
+	// Forking from the head only fails when there are pending transactions.
+	if err := chain.Fork(context.Background(), chain.Blockchain().CurrentHeader().Hash()); err != nil {
+		chain.Commit()
+	}
+	head := chain.Blockchain().CurrentHeader().Number.Uint64()
+	if uint64(depth) >= head {
+		return errors.New("ccipsim: reorg deeper than the chain")
+	}
+	parent := chain.Blockchain().GetHeaderByNumber(head - uint64(depth))
+	if err := chain.Fork(context.Background(), parent.Hash()); err != nil {
+		return err
+	}
+	for i := 0; i <= depth; i++ {
+		chain.Commit()
+	}
+	return nil
+}
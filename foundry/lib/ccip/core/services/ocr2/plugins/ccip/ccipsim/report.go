@@ -0,0 +1,78 @@
+package ccipsim
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Report is the outcome of a simulation.
+type Report struct {
+	Scenario string
+	Lanes    []LaneReport
+}
+
+// LaneReport measures the messages of a lane. Latencies are wall clock times from the confirmation of the send
+// transaction to the block in which the simulator first saw the message committed or executed, so they are
+// rounded up to the block interval of the scenario.
+type LaneReport struct {
+	SourceChainSelector uint64
+	DestChainSelector   uint64
+	Sent                int
+	Committed           int
+	Executed            int
+	// Failed counts the messages whose execution reverted.
+	Failed        int
+	CommitLatency LatencyStats
+	ExecLatency   LatencyStats
+	// ThroughputPerMinute is the number of messages executed per minute, from the first send to the last execution.
+	ThroughputPerMinute float64
+}
+
+// LatencyStats summarizes latency samples.
+type LatencyStats struct {
+	Count int
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+func newLatencyStats(samples []time.Duration) LatencyStats {
+	if len(samples) == 0 {
+		return LatencyStats{}
+	}
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return LatencyStats{
+		Count: len(sorted),
+		P50:   percentile(sorted, 50),
+		P90:   percentile(sorted, 90),
+		P99:   percentile(sorted, 99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted samples.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+func (r Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Scenario %s\n", r.Scenario)
+	for _, lane := range r.Lanes {
+		fmt.Fprintf(&sb, "Lane %d -> %d: sent %d, committed %d, executed %d (failed %d), %.2f msgs/min\n",
+			lane.SourceChainSelector, lane.DestChainSelector, lane.Sent, lane.Committed, lane.Executed, lane.Failed, lane.ThroughputPerMinute)
+		fmt.Fprintf(&sb, "  commit latency: %s\n", lane.CommitLatency)
+		fmt.Fprintf(&sb, "  exec latency:   %s\n", lane.ExecLatency)
+	}
+	return sb.String()
+}
+
+func (s LatencyStats) String() string {
+	return fmt.Sprintf("p50 %s, p90 %s, p99 %s, max %s (%d samples)", s.P50, s.P90, s.P99, s.Max, s.Count)
+}
@@ -0,0 +1,27 @@
+package ccipsim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipsim"
+	integrationtesthelpers "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/testhelpers/integration"
+)
+
+// TestRun simulates the default scenario, the lane has to recover from its faults.
+func TestRun(t *testing.T) {
+	env := integrationtesthelpers.NewSimEnvironment(t)
+	report, err := ccipsim.Run(testutils.Context(t), logger.TestLogger(t), ccipsim.DefaultScenario(), env)
+	require.NoError(t, err)
+	t.Log(report)
+
+	require.Len(t, report.Lanes, 1)
+	lane := report.Lanes[0]
+	assert.Equal(t, 4, lane.Sent, "the messages dropped by the reorg are sent again")
+	assert.Equal(t, 4, lane.Executed)
+	assert.Zero(t, lane.Failed)
+}
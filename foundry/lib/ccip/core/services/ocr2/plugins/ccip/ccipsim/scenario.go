@@ -0,0 +1,222 @@
+package ccipsim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/testhelpers"
+)
+
+// Fault types that can be injected during a simulation.
+const (
+	// FaultReorg replaces the latest Depth blocks of the chain by Depth+1 empty blocks.
+	FaultReorg = "reorg"
+	// FaultRPCErrors fails the contract calls, log queries and head requests of the nodes to the chain for Blocks blocks.
+	FaultRPCErrors = "rpc-errors"
+	// FaultCurse curses the RMN of the chain for Blocks blocks.
+	FaultCurse = "curse"
+)
+
+// Scenario describes a simulation: the chains and the lanes between them, the load sent on each lane and the faults
+// to inject.
+type Scenario struct {
+	// Name identifies the scenario in the report.
+	Name string
+	// Chains are simulated once and shared by the lanes from and to them.
+	Chains []ChainConfig
+	// Lanes connect two of the chains, each with its own contracts and DON.
+	Lanes []LaneConfig
+	// Oracles is the number of oracles of each DON, defaults to 4 which is the minimum.
+	Oracles int
+	// Messages is the load sent on every lane, in order.
+	Messages []MessagePattern
+	// MessagesPerBlock is the number of messages sent per source block, defaults to 1.
+	MessagesPerBlock int
+	// BlockIntervalMillis is the wall clock time between two blocks of the simulated chains, defaults to 1000.
+	BlockIntervalMillis int
+	// TimeoutSeconds bounds the time waiting for the messages to be executed once sent, defaults to 300.
+	TimeoutSeconds int
+	// Faults are injected on a chain, so they affect every lane from or to it.
+	Faults []Fault
+}
+
+// ChainConfig identifies a simulated chain, the selector has to be the one of the chain ID.
+type ChainConfig struct {
+	ChainID       uint64
+	ChainSelector uint64
+}
+
+// LaneConfig identifies the chains of a lane by their selector.
+type LaneConfig struct {
+	SourceChainSelector uint64
+	DestChainSelector   uint64
+}
+
+// MessagePattern describes Count identical messages.
+type MessagePattern struct {
+	Count int
+	// GasLimit is the execution gas limit of the messages, defaults to 200k.
+	GasLimit uint64
+	// DataBytes is the size of the message data.
+	DataBytes int
+	// TokenAmount is the amount of LINK transferred by each message, in juels.
+	TokenAmount *big.Int
+	// Strict sends the messages to the strict receiver, whose failures block the following messages of the sender.
+	Strict bool
+}
+
+// Fault is injected on a chain once AfterMessages messages have been sent on all the lanes.
+type Fault struct {
+	Type          string
+	ChainSelector uint64
+	AfterMessages int
+	// Depth is the number of blocks replaced by a FaultReorg.
+	Depth int
+	// Blocks is the duration of FaultRPCErrors and FaultCurse, in blocks.
+	Blocks int
+}
+
+// DefaultScenario sends a handful of messages on a single lane, while reorging the source chain and failing the RPC
+// of the dest chain. It is short enough to run in CI.
+func DefaultScenario() Scenario {
+	return Scenario{
+		Name: "default",
+		Chains: []ChainConfig{
+			{ChainID: testhelpers.SourceChainID, ChainSelector: testhelpers.SourceChainSelector},
+			{ChainID: testhelpers.DestChainID, ChainSelector: testhelpers.DestChainSelector},
+		},
+		Lanes:               []LaneConfig{{SourceChainSelector: testhelpers.SourceChainSelector, DestChainSelector: testhelpers.DestChainSelector}},
+		Messages:            []MessagePattern{{Count: 4, TokenAmount: big.NewInt(100)}},
+		BlockIntervalMillis: 500,
+		TimeoutSeconds:      120,
+		Faults: []Fault{
+			{Type: FaultReorg, ChainSelector: testhelpers.SourceChainSelector, AfterMessages: 2, Depth: 1},
+			{Type: FaultRPCErrors, ChainSelector: testhelpers.DestChainSelector, AfterMessages: 3, Blocks: 3},
+		},
+	}
+}
+
+// LoadScenario reads a JSON scenario file.
+func LoadScenario(path string) (Scenario, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Scenario{}, fmt.Errorf("failed to read scenario: %w", err)
+	}
+	var s Scenario
+	if err = json.Unmarshal(b, &s); err != nil {
+		return Scenario{}, fmt.Errorf("failed to decode scenario: %w", err)
+	}
+	return s, s.Validate()
+}
+
+func (s Scenario) Validate() error {
+	if len(s.Chains) < 2 {
+		return errors.New("at least 2 chains are required")
+	}
+	chains := make(map[uint64]bool, len(s.Chains))
+	for i, chain := range s.Chains {
+		// The plugins derive the chain IDs from the selectors, they have to match.
+		id, err := chainselectors.ChainIdFromSelector(chain.ChainSelector)
+		if err != nil {
+			return fmt.Errorf("chain %d: %w", i, err)
+		}
+		if id != chain.ChainID {
+			return fmt.Errorf("chain %d: selector %d is the one of chain %d, not %d", i, chain.ChainSelector, id, chain.ChainID)
+		}
+		if chains[chain.ChainSelector] {
+			return fmt.Errorf("chain %d: chain %d is declared twice", i, chain.ChainID)
+		}
+		chains[chain.ChainSelector] = true
+	}
+	if len(s.Lanes) == 0 {
+		return errors.New("no lanes")
+	}
+	for i, lane := range s.Lanes {
+		if !chains[lane.SourceChainSelector] || !chains[lane.DestChainSelector] {
+			return fmt.Errorf("lane %d: unknown chain selector", i)
+		}
+		if lane.SourceChainSelector == lane.DestChainSelector {
+			return fmt.Errorf("lane %d: source and dest chains are the same", i)
+		}
+	}
+	if s.Oracles != 0 && s.Oracles < 4 {
+		return fmt.Errorf("at least 4 oracles are required, got %d", s.Oracles)
+	}
+	if s.MessagesPerBlock < 0 || s.BlockIntervalMillis < 0 || s.TimeoutSeconds < 0 {
+		return errors.New("MessagesPerBlock, BlockIntervalMillis and TimeoutSeconds can't be negative")
+	}
+	if s.totalMessages() == 0 {
+		return errors.New("no messages")
+	}
+	for i, pattern := range s.Messages {
+		if pattern.Count < 0 || pattern.DataBytes < 0 {
+			return fmt.Errorf("message pattern %d: Count and DataBytes can't be negative", i)
+		}
+		if pattern.TokenAmount != nil && pattern.TokenAmount.Sign() < 0 {
+			return fmt.Errorf("message pattern %d: TokenAmount can't be negative", i)
+		}
+	}
+	for i, fault := range s.Faults {
+		if !chains[fault.ChainSelector] {
+			return fmt.Errorf("fault %d: unknown chain selector %d", i, fault.ChainSelector)
+		}
+		if err := fault.validate(); err != nil {
+			return fmt.Errorf("fault %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (f Fault) validate() error {
+	if f.AfterMessages < 0 {
+		return errors.New("AfterMessages can't be negative")
+	}
+	switch f.Type {
+	case FaultReorg:
+		if f.Depth <= 0 {
+			return errors.New("reorg Depth must be positive")
+		}
+	case FaultRPCErrors, FaultCurse:
+		if f.Blocks <= 0 {
+			return fmt.Errorf("%s Blocks must be positive", f.Type)
+		}
+	default:
+		return fmt.Errorf("unknown type %q", f.Type)
+	}
+	return nil
+}
+
+func (s Scenario) totalMessages() int {
+	total := 0
+	for _, pattern := range s.Messages {
+		total += pattern.Count
+	}
+	return total
+}
+
+func (s Scenario) messagesPerBlock() int {
+	if s.MessagesPerBlock == 0 {
+		return 1
+	}
+	return s.MessagesPerBlock
+}
+
+func (s Scenario) blockInterval() time.Duration {
+	if s.BlockIntervalMillis == 0 {
+		return time.Second
+	}
+	return time.Duration(s.BlockIntervalMillis) * time.Millisecond
+}
+
+func (s Scenario) timeout() time.Duration {
+	if s.TimeoutSeconds == 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(s.TimeoutSeconds) * time.Second
+}
@@ -0,0 +1,485 @@
+package ccipsim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/mock_arm_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+const defaultGasLimit = 200_000
+
+// evmExtraArgsV1Tag is the tag of the EVMExtraArgsV1 of the messages, see Client.sol.
+var evmExtraArgsV1Tag = []byte{0x97, 0xa6, 0x57, 0xc9}
+
+// Run deploys the chains of the scenario, the lanes between them and their DONs with env, sends the messages while
+// injecting the faults, and waits for the messages to be executed or for the timeout of the scenario. The messages
+// that are not executed by then are missing from the report, Run only fails if the simulation can't go on.
+func Run(ctx context.Context, lggr logger.Logger, scenario Scenario, env Environment) (Report, error) {
+	if err := scenario.Validate(); err != nil {
+		return Report{}, err
+	}
+	s, err := newSimulation(lggr, scenario, env)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var deadline time.Time
+	ticker := time.NewTicker(scenario.blockInterval())
+	defer ticker.Stop()
+	for !s.done() {
+		select {
+		case <-ctx.Done():
+			return Report{}, ctx.Err()
+		case <-ticker.C:
+		}
+		if err = s.tick(ctx); err != nil {
+			return Report{}, err
+		}
+		if deadline.IsZero() && s.allSent() {
+			deadline = time.Now().Add(scenario.timeout())
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			lggr.Warn("ccipsim: timed out waiting for the messages to be executed")
+			break
+		}
+	}
+
+	report := Report{Scenario: scenario.Name}
+	for _, l := range s.lanes {
+		report.Lanes = append(report.Lanes, l.report())
+	}
+	return report, nil
+}
+
+// activeFault is a fault injected for a number of blocks.
+type activeFault struct {
+	fault     Fault
+	remaining int
+}
+
+// simulation mines the chains and injects the faults, the lanes send and observe their messages.
+type simulation struct {
+	lggr      logger.Logger
+	scenario  Scenario
+	chains    []*chain
+	lanes     []*lane
+	rpcFaults *rpcFaults
+
+	injected []bool
+	active   []activeFault
+}
+
+// chain is a simulated chain, shared by the lanes from and to it.
+type chain struct {
+	cfg     ChainConfig
+	backend *backends.SimulatedBackend
+	// user owns the contracts of all the lanes deployed on the chain.
+	user *bind.TransactOpts
+}
+
+func newSimulation(lggr logger.Logger, scenario Scenario, env Environment) (*simulation, error) {
+	s := &simulation{
+		lggr:      lggr,
+		scenario:  scenario,
+		rpcFaults: newRPCFaults(),
+		injected:  make([]bool, len(scenario.Faults)),
+	}
+	for _, cfg := range scenario.Chains {
+		backend, user, err := env.NewChain(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start chain %d: %w", cfg.ChainID, err)
+		}
+		s.chains = append(s.chains, &chain{cfg: cfg, backend: backend, user: user})
+	}
+	for _, cfg := range scenario.Lanes {
+		l, err := s.setupLane(cfg, env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to deploy lane %d -> %d: %w", cfg.SourceChainSelector, cfg.DestChainSelector, err)
+		}
+		s.lanes = append(s.lanes, l)
+	}
+	return s, nil
+}
+
+func (s *simulation) chain(selector uint64) *chain {
+	for _, c := range s.chains {
+		if c.cfg.ChainSelector == selector {
+			return c
+		}
+	}
+	panic(fmt.Sprintf("ccipsim: unknown chain selector %d", selector))
+}
+
+type lane struct {
+	cfg          LaneConfig
+	source, dest *chain
+	contracts    LaneContracts
+
+	// messages are sent in order, next is the index of the next message to send.
+	messages []MessagePattern
+	next     int
+	// pending maps the sequence number of the sent messages to the index of the message.
+	pending map[uint64]int
+
+	sentAt      map[uint64]time.Time
+	committedAt map[uint64]time.Time
+	executedAt  map[uint64]time.Time
+	failed      int
+}
+
+// setupLane deploys the contracts of the lane on its chains and starts its DON.
+func (s *simulation) setupLane(cfg LaneConfig, env Environment) (*lane, error) {
+	l := &lane{
+		cfg:         cfg,
+		source:      s.chain(cfg.SourceChainSelector),
+		dest:        s.chain(cfg.DestChainSelector),
+		pending:     make(map[uint64]int),
+		sentAt:      make(map[uint64]time.Time),
+		committedAt: make(map[uint64]time.Time),
+		executedAt:  make(map[uint64]time.Time),
+	}
+	for _, pattern := range s.scenario.Messages {
+		for i := 0; i < pattern.Count; i++ {
+			l.messages = append(l.messages, pattern)
+		}
+	}
+
+	var err error
+	l.contracts, err = env.NewLane(
+		Chain{ChainConfig: l.source.cfg, Backend: l.source.backend, User: l.source.user},
+		Chain{ChainConfig: l.dest.cfg, Backend: l.dest.backend, User: l.dest.user},
+		LaneOptions{
+			Oracles: s.scenario.Oracles,
+			// The RPC faults are toggled per chain ID, for the nodes of all the lanes of the chain.
+			WrapClient: s.rpcFaults.wrap,
+			// A curse makes the lane unhealthy for 30 minutes by default, the lane has to recover within the scenario.
+			ChainHealth: &ccipconfig.ChainHealthConfig{
+				StickyUnhealthySeconds: uint(max(s.scenario.blockInterval()/time.Second, 1)),
+			},
+		})
+	return l, err
+}
+
+// tick sends the messages of the next source block of every lane, injects and clears the faults, mines a block on
+// every chain, then records the messages committed and executed so far.
+func (s *simulation) tick(ctx context.Context) error {
+	if err := s.clearExpiredFaults(); err != nil {
+		return err
+	}
+	for _, l := range s.lanes {
+		if err := l.send(ctx, s.scenario.messagesPerBlock()); err != nil {
+			return err
+		}
+	}
+	if err := s.injectFaults(); err != nil {
+		return err
+	}
+	for _, c := range s.chains {
+		c.backend.Commit()
+	}
+	for _, l := range s.lanes {
+		if err := l.observe(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *simulation) sent() int {
+	sent := 0
+	for _, l := range s.lanes {
+		sent += l.next
+	}
+	return sent
+}
+
+func (s *simulation) allSent() bool {
+	for _, l := range s.lanes {
+		if l.next < len(l.messages) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *simulation) done() bool {
+	if len(s.active) > 0 {
+		return false
+	}
+	for _, l := range s.lanes {
+		if !l.done() {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *simulation) injectFaults() error {
+	for i, fault := range s.scenario.Faults {
+		if s.injected[i] || s.sent() < fault.AfterMessages {
+			continue
+		}
+		s.injected[i] = true
+		c := s.chain(fault.ChainSelector)
+		s.lggr.Infof("ccipsim: injecting %s on chain %d", fault.Type, c.cfg.ChainID)
+
+		switch fault.Type {
+		case FaultReorg:
+			if err := reorg(c.backend, fault.Depth); err != nil {
+				return err
+			}
+			for _, l := range s.lanes {
+				if l.cfg.SourceChainSelector != fault.ChainSelector {
+					continue
+				}
+				if err := l.resendDropped(s.lggr); err != nil {
+					return err
+				}
+			}
+		case FaultRPCErrors:
+			s.rpcFaults.failing(new(big.Int).SetUint64(c.cfg.ChainID)).Store(true)
+			s.active = append(s.active, activeFault{fault: fault, remaining: fault.Blocks})
+		case FaultCurse:
+			// Every lane has its own RMN contract on the chain.
+			for _, arm := range s.laneARMs(fault.ChainSelector) {
+				if _, err := arm.VoteToCurse(c.user, [32]byte{}); err != nil {
+					return fmt.Errorf("failed to curse: %w", err)
+				}
+			}
+			s.active = append(s.active, activeFault{fault: fault, remaining: fault.Blocks})
+		}
+	}
+	return nil
+}
+
+func (s *simulation) clearExpiredFaults() error {
+	active := s.active[:0]
+	for _, a := range s.active {
+		a.remaining--
+		if a.remaining > 0 {
+			active = append(active, a)
+			continue
+		}
+		c := s.chain(a.fault.ChainSelector)
+		s.lggr.Infof("ccipsim: clearing %s on chain %d", a.fault.Type, c.cfg.ChainID)
+		switch a.fault.Type {
+		case FaultRPCErrors:
+			s.rpcFaults.failing(new(big.Int).SetUint64(c.cfg.ChainID)).Store(false)
+		case FaultCurse:
+			for _, arm := range s.laneARMs(a.fault.ChainSelector) {
+				if _, err := arm.OwnerUnvoteToCurse(c.user, []mock_arm_contract.ARMUnvoteToCurseRecord{}); err != nil {
+					return fmt.Errorf("failed to lift the curse: %w", err)
+				}
+			}
+		}
+	}
+	s.active = active
+	return nil
+}
+
+// laneARMs returns the RMN contracts the lanes deployed on the chain.
+func (s *simulation) laneARMs(selector uint64) []*mock_arm_contract.MockARMContract {
+	var arms []*mock_arm_contract.MockARMContract
+	for _, l := range s.lanes {
+		if l.cfg.SourceChainSelector == selector {
+			arms = append(arms, l.contracts.SourceARM)
+		}
+		if l.cfg.DestChainSelector == selector {
+			arms = append(arms, l.contracts.DestARM)
+		}
+	}
+	return arms
+}
+
+func (l *lane) send(ctx context.Context, count int) error {
+	count = min(count, len(l.messages)-l.next)
+	if count == 0 {
+		return nil
+	}
+	contracts, user := l.contracts, l.source.user
+
+	msgs := make([]router.ClientEVM2AnyMessage, count)
+	allowance := big.NewInt(0)
+	for i := range msgs {
+		var err error
+		if msgs[i], err = l.message(l.messages[l.next+i]); err != nil {
+			return err
+		}
+		fee, err := contracts.Router.GetFee(nil, l.cfg.DestChainSelector, msgs[i])
+		if err != nil {
+			return fmt.Errorf("failed to get the fee of a message: %w", err)
+		}
+		allowance.Add(allowance, fee)
+		for _, tokenAmount := range msgs[i].TokenAmounts {
+			allowance.Add(allowance, tokenAmount.Amount)
+		}
+	}
+	// The allowance is approved in the same block as the messages, so that a source reorg drops both.
+	if _, err := contracts.LinkToken.Approve(user, contracts.Router.Address(), allowance); err != nil {
+		return fmt.Errorf("failed to approve the fees and tokens: %w", err)
+	}
+	txs := make([]*types.Transaction, count)
+	for i, msg := range msgs {
+		var err error
+		if txs[i], err = contracts.Router.CcipSend(user, l.cfg.DestChainSelector, msg); err != nil {
+			return fmt.Errorf("failed to send a message: %w", err)
+		}
+	}
+
+	l.source.backend.Commit()
+	sentAt := time.Now()
+	for i, tx := range txs {
+		receipt, err := bind.WaitMined(ctx, l.source.backend, tx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for a message to be sent: %w", err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("send transaction %s reverted", tx.Hash())
+		}
+		for _, log := range receipt.Logs {
+			if log.Address != contracts.OnRamp.Address() {
+				continue
+			}
+			event, err := contracts.OnRamp.ParseCCIPSendRequested(*log)
+			if err != nil {
+				continue
+			}
+			l.pending[event.Message.SequenceNumber] = l.next + i
+			l.sentAt[event.Message.SequenceNumber] = sentAt
+		}
+	}
+	l.next += count
+	return nil
+}
+
+func (l *lane) message(pattern MessagePattern) (router.ClientEVM2AnyMessage, error) {
+	gasLimit := pattern.GasLimit
+	if gasLimit == 0 {
+		gasLimit = defaultGasLimit
+	}
+	extraArgs, err := utils.ABIEncode(`[{"type":"uint256"},{"type":"bool"}]`, new(big.Int).SetUint64(gasLimit), pattern.Strict)
+	if err != nil {
+		return router.ClientEVM2AnyMessage{}, fmt.Errorf("failed to encode the extra args: %w", err)
+	}
+	receiver := l.contracts.Receiver
+	if pattern.Strict {
+		receiver = l.contracts.StrictReceiver
+	}
+	encodedReceiver, err := utils.ABIEncode(`[{"type":"address"}]`, receiver)
+	if err != nil {
+		return router.ClientEVM2AnyMessage{}, fmt.Errorf("failed to encode the receiver: %w", err)
+	}
+	msg := router.ClientEVM2AnyMessage{
+		Receiver:  encodedReceiver,
+		Data:      make([]byte, pattern.DataBytes),
+		FeeToken:  l.contracts.LinkToken.Address(),
+		ExtraArgs: append(evmExtraArgsV1Tag, extraArgs...),
+	}
+	if pattern.TokenAmount != nil && pattern.TokenAmount.Sign() > 0 {
+		msg.TokenAmounts = []router.ClientEVMTokenAmount{{Token: l.contracts.LinkToken.Address(), Amount: pattern.TokenAmount}}
+	}
+	return msg, nil
+}
+
+// resendDropped queues again the messages whose send transaction was dropped by a source reorg.
+func (l *lane) resendDropped(lggr logger.Logger) error {
+	nextSeqNr, err := l.contracts.OnRamp.GetExpectedNextSequenceNumber(nil)
+	if err != nil {
+		return fmt.Errorf("failed to get the next sequence number of the onramp: %w", err)
+	}
+	first := l.next
+	for seqNr, idx := range l.pending {
+		if seqNr < nextSeqNr {
+			continue
+		}
+		first = min(first, idx)
+		delete(l.pending, seqNr)
+		delete(l.sentAt, seqNr)
+	}
+	if first < l.next {
+		lggr.Infof("ccipsim: resending %d messages dropped by the reorg", l.next-first)
+		l.next = first
+	}
+	return nil
+}
+
+func (l *lane) observe() error {
+	now := time.Now()
+	nextCommitted, err := l.contracts.CommitStore.GetExpectedNextSequenceNumber(nil)
+	if err != nil {
+		return fmt.Errorf("failed to get the next sequence number of the commit store: %w", err)
+	}
+	for seqNr := range l.pending {
+		if seqNr >= nextCommitted {
+			continue
+		}
+		if _, ok := l.committedAt[seqNr]; !ok {
+			l.committedAt[seqNr] = now
+		}
+		state, err := l.contracts.OffRamp.GetExecutionState(nil, seqNr)
+		if err != nil {
+			return fmt.Errorf("failed to get the execution state of message %d: %w", seqNr, err)
+		}
+		switch cciptypes.MessageExecutionState(state) {
+		case cciptypes.ExecutionStateSuccess:
+		case cciptypes.ExecutionStateFailure:
+			l.failed++
+		default:
+			continue
+		}
+		l.executedAt[seqNr] = now
+		delete(l.pending, seqNr)
+	}
+	return nil
+}
+
+func (l *lane) done() bool {
+	return l.next == len(l.messages) && len(l.pending) == 0
+}
+
+func (l *lane) report() LaneReport {
+	var commitLatencies, execLatencies []time.Duration
+	var firstSent, lastExecuted time.Time
+	for seqNr, sentAt := range l.sentAt {
+		if firstSent.IsZero() || sentAt.Before(firstSent) {
+			firstSent = sentAt
+		}
+		if committedAt, ok := l.committedAt[seqNr]; ok {
+			commitLatencies = append(commitLatencies, committedAt.Sub(sentAt))
+		}
+		if executedAt, ok := l.executedAt[seqNr]; ok {
+			execLatencies = append(execLatencies, executedAt.Sub(sentAt))
+			if executedAt.After(lastExecuted) {
+				lastExecuted = executedAt
+			}
+		}
+	}
+
+	report := LaneReport{
+		SourceChainSelector: l.cfg.SourceChainSelector,
+		DestChainSelector:   l.cfg.DestChainSelector,
+		Sent:                len(l.sentAt),
+		Committed:           len(l.committedAt),
+		Executed:            len(l.executedAt),
+		Failed:              l.failed,
+		CommitLatency:       newLatencyStats(commitLatencies),
+		ExecLatency:         newLatencyStats(execLatencies),
+	}
+	if elapsed := lastExecuted.Sub(firstSent); len(l.executedAt) > 0 && elapsed > 0 {
+		report.ThroughputPerMinute = float64(len(l.executedAt)) / elapsed.Minutes()
+	}
+	return report
+}
@@ -0,0 +1,92 @@
+package ccip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+var ErrChainHealthcheckNotFound = errors.New("no running chain healthcheck for the job")
+
+// ChainHealthcheck is the chain healthcheck of a CCIP job, as seen by the ChainHealthRegistry.
+type ChainHealthcheck interface {
+	job.ServiceCtx
+	services.HealthReporter
+	// ClearStickyUnhealthy clears the sticky unhealthy status, it errors if the policy of the lane doesn't allow it.
+	ClearStickyUnhealthy() error
+}
+
+// ChainHealthRegistry keeps track of the chain healthchecks of the running CCIP jobs,
+// so that admins can clear their sticky unhealthy status. A nil registry tracks nothing.
+type ChainHealthRegistry struct {
+	healthchecks map[int32]ChainHealthcheck
+	mu           sync.RWMutex
+}
+
+func NewChainHealthRegistry() *ChainHealthRegistry {
+	return &ChainHealthRegistry{healthchecks: make(map[int32]ChainHealthcheck)}
+}
+
+// Track returns the healthcheck registered under the job ID while it's running.
+func (r *ChainHealthRegistry) Track(jobID int32, healthcheck ChainHealthcheck) ChainHealthcheck {
+	if r == nil {
+		return healthcheck
+	}
+	return &registeredChainHealthcheck{ChainHealthcheck: healthcheck, registry: r, jobID: jobID}
+}
+
+// ClearStickyUnhealthy clears the sticky unhealthy status of the chain healthcheck of the job.
+func (r *ChainHealthRegistry) ClearStickyUnhealthy(jobID int32) error {
+	if r == nil {
+		return ErrChainHealthcheckNotFound
+	}
+	r.mu.RLock()
+	healthcheck, ok := r.healthchecks[jobID]
+	r.mu.RUnlock()
+	if !ok {
+		return ErrChainHealthcheckNotFound
+	}
+	return healthcheck.ClearStickyUnhealthy()
+}
+
+func (r *ChainHealthRegistry) register(jobID int32, healthcheck ChainHealthcheck) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.healthchecks[jobID]; exists {
+		return fmt.Errorf("chain healthcheck of job %d is already registered", jobID)
+	}
+	r.healthchecks[jobID] = healthcheck
+	return nil
+}
+
+func (r *ChainHealthRegistry) unregister(jobID int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.healthchecks, jobID)
+}
+
+type registeredChainHealthcheck struct {
+	ChainHealthcheck
+	registry *ChainHealthRegistry
+	jobID    int32
+}
+
+func (h *registeredChainHealthcheck) Start(ctx context.Context) error {
+	if err := h.ChainHealthcheck.Start(ctx); err != nil {
+		return err
+	}
+	if err := h.registry.register(h.jobID, h.ChainHealthcheck); err != nil {
+		return errors.Join(err, h.ChainHealthcheck.Close())
+	}
+	return nil
+}
+
+func (h *registeredChainHealthcheck) Close() error {
+	h.registry.unregister(h.jobID)
+	return h.ChainHealthcheck.Close()
+}
@@ -0,0 +1,50 @@
+package ccip
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache/mocks"
+)
+
+func TestChainHealthRegistry(t *testing.T) {
+	ctx := tests.Context(t)
+	registry := NewChainHealthRegistry()
+
+	healthcheck := mocks.NewChainHealthcheck(t)
+	healthcheck.On("Start", mock.Anything).Return(nil).Once()
+	healthcheck.On("Close").Return(nil).Once()
+	healthcheck.On("ClearStickyUnhealthy").Return(nil).Once()
+	healthcheck.On("ClearStickyUnhealthy").Return(ccipconfig.ErrStickyUnhealthyNotClearable).Once()
+
+	tracked := registry.Track(1, healthcheck)
+	require.ErrorIs(t, registry.ClearStickyUnhealthy(1), ErrChainHealthcheckNotFound)
+
+	require.NoError(t, tracked.Start(ctx))
+	require.NoError(t, registry.ClearStickyUnhealthy(1))
+	require.ErrorIs(t, registry.ClearStickyUnhealthy(1), ccipconfig.ErrStickyUnhealthyNotClearable)
+	require.ErrorIs(t, registry.ClearStickyUnhealthy(2), ErrChainHealthcheckNotFound)
+
+	// A second healthcheck of the same job can't be registered while the first one is running
+	duplicate := mocks.NewChainHealthcheck(t)
+	duplicate.On("Start", mock.Anything).Return(nil).Once()
+	duplicate.On("Close").Return(errors.New("close error")).Once()
+	require.ErrorContains(t, registry.Track(1, duplicate).Start(ctx), "already registered")
+
+	require.NoError(t, tracked.Close())
+	require.ErrorIs(t, registry.ClearStickyUnhealthy(1), ErrChainHealthcheckNotFound)
+}
+
+func TestChainHealthRegistry_nil(t *testing.T) {
+	var registry *ChainHealthRegistry
+	healthcheck := mocks.NewChainHealthcheck(t)
+
+	require.Equal(t, ChainHealthcheck(healthcheck), registry.Track(1, healthcheck))
+	require.ErrorIs(t, registry.ClearStickyUnhealthy(1), ErrChainHealthcheckNotFound)
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"text/template"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
@@ -25,18 +27,115 @@ type CommitPluginJobSpecConfig struct {
 	TokenPricesUSDPipeline string `json:"tokenPricesUSDPipeline,omitempty"`
 	// PriceGetterConfig defines where to get the token prices from (i.e. static or aggregator source).
 	PriceGetterConfig *DynamicPriceGetterConfig `json:"priceGetterConfig,omitempty"`
+	// MedianPriceGetterConfig defines multiple price sources per token, the median of the sources is used.
+	MedianPriceGetterConfig *MedianPriceGetterConfig `json:"medianPriceGetterConfig,omitempty"`
+	// ChainHealth configures which chain health checks stop the lane.
+	ChainHealth ChainHealthConfig `json:"chainHealth"`
+	// PriceConsensus configures how observed token prices are aggregated and when they are reported.
+	PriceConsensus PriceConsensusConfig `json:"priceConsensus"`
+	// DebugRounds is the number of latest rounds kept for debugging through the API, zero disables it.
+	DebugRounds uint `json:"debugRounds,omitempty"`
+}
+
+// MaxDebugRounds bounds the memory used by the rounds kept for debugging.
+const MaxDebugRounds = 1000
+
+// ValidateDebugRounds checks the number of rounds kept for debugging.
+func ValidateDebugRounds(n uint) error {
+	if n > MaxDebugRounds {
+		return fmt.Errorf("debugRounds %d is above the maximum of %d", n, MaxDebugRounds)
+	}
+	return nil
 }
 
 // DynamicPriceGetterConfig specifies which configuration to use for getting the price of tokens (map keys).
 type DynamicPriceGetterConfig struct {
 	AggregatorPrices map[common.Address]AggregatorPriceConfig `json:"aggregatorPrices"`
 	StaticPrices     map[common.Address]StaticPriceConfig     `json:"staticPrices"`
+	// UniswapV3TWAPPrices are used for tokens without aggregator, their price is derived from a pool TWAP.
+	UniswapV3TWAPPrices map[common.Address]UniswapV3TWAPPriceConfig `json:"uniswapV3TWAPPrices,omitempty"`
 }
 
+// Fallback behaviours of an aggregator price whose answer is rejected.
+const (
+	// AggregatorPriceFallbackError fails the whole price read, it is the default.
+	AggregatorPriceFallbackError = "error"
+	// AggregatorPriceFallbackSkip leaves the token out of the returned prices.
+	AggregatorPriceFallbackSkip = "skip"
+	// AggregatorPriceFallbackStatic returns the configured FallbackPrice instead.
+	AggregatorPriceFallbackStatic = "static"
+)
+
 // AggregatorPriceConfig specifies a price retrieved from an aggregator contract.
 type AggregatorPriceConfig struct {
 	ChainID                   uint64         `json:"chainID,string"`
 	AggregatorContractAddress common.Address `json:"contractAddress"`
+	// MaxAnswerAgeSeconds rejects answers updated more than this number of seconds ago. Zero disables the check.
+	MaxAnswerAgeSeconds uint32 `json:"maxAnswerAgeSeconds,omitempty"`
+	// MinPrice and MaxPrice reject answers out of these (inclusive) bounds. Nil disables the check.
+	MinPrice *big.Int `json:"minPrice,omitempty"`
+	MaxPrice *big.Int `json:"maxPrice,omitempty"`
+	// Fallback is applied when the answer is rejected, one of "error" (default), "skip" or "static".
+	// "skip" leaves the latest committed price of the token in place, see pricegetter.TokenPriceSkipper.
+	// Not allowed in the medianPriceGetterConfig, where rejected answers are simply not counted as a source.
+	Fallback string `json:"fallback,omitempty"`
+	// FallbackPrice is the price used by the "static" fallback.
+	FallbackPrice *big.Int `json:"fallbackPrice,omitempty"`
+}
+
+// FallbackOrDefault returns the configured Fallback, defaulting to AggregatorPriceFallbackError.
+func (c AggregatorPriceConfig) FallbackOrDefault() string {
+	if c.Fallback == "" {
+		return AggregatorPriceFallbackError
+	}
+	return c.Fallback
+}
+
+// Validate checks the aggregator address, chain and answer bounds.
+func (c AggregatorPriceConfig) Validate() error {
+	if c.AggregatorContractAddress == utils.ZeroAddress {
+		return fmt.Errorf("aggregator contract address is zero")
+	}
+	if c.ChainID == 0 {
+		return fmt.Errorf("chain id is zero")
+	}
+	if c.MinPrice != nil && c.MinPrice.Sign() < 0 {
+		return fmt.Errorf("min price is negative")
+	}
+	if c.MinPrice != nil && c.MaxPrice != nil && c.MinPrice.Cmp(c.MaxPrice) > 0 {
+		return fmt.Errorf("min price %s is greater than max price %s", c.MinPrice, c.MaxPrice)
+	}
+	switch c.FallbackOrDefault() {
+	case AggregatorPriceFallbackError, AggregatorPriceFallbackSkip:
+		if c.FallbackPrice != nil {
+			return fmt.Errorf("fallback price is only used with the %q fallback", AggregatorPriceFallbackStatic)
+		}
+	case AggregatorPriceFallbackStatic:
+		if c.FallbackPrice == nil || c.FallbackPrice.Sign() <= 0 {
+			return fmt.Errorf("fallback price must be positive")
+		}
+	default:
+		return fmt.Errorf("unknown fallback %q", c.Fallback)
+	}
+	return nil
+}
+
+// UniswapV3TWAPPriceConfig specifies a price computed from the time-weighted average tick of a Uniswap V3 pool
+// (or any pool exposing the same observe, token0 and token1 functions) pairing the token with a quote token. The USD
+// price of the quote token must be resolved by an aggregator or static price rule.
+type UniswapV3TWAPPriceConfig struct {
+	ChainID     uint64         `json:"chainID,string"`
+	PoolAddress common.Address `json:"poolAddress"`
+	// QuoteToken is the key of the price rule of the other token of the pool.
+	QuoteToken common.Address `json:"quoteToken"`
+	// TokenIsToken0 tells whether the token is token0 of the pool, i.e. its address is the lowest of the pair on the pool chain.
+	TokenIsToken0 bool `json:"tokenIsToken0"`
+	// TokenDecimals and QuoteTokenDecimals are the decimals of the pool tokens on the pool chain.
+	// They are checked against the pool tokens, as well as TokenIsToken0 when possible, when the commit plugin starts.
+	TokenDecimals      uint8 `json:"tokenDecimals"`
+	QuoteTokenDecimals uint8 `json:"quoteTokenDecimals"`
+	// TWAPWindowSeconds is the period over which the pool tick is averaged.
+	TWAPWindowSeconds uint32 `json:"twapWindowSeconds"`
 }
 
 // StaticPriceConfig specifies a price defined statically.
@@ -63,11 +162,8 @@ func (c *DynamicPriceGetterConfig) Validate() error {
 		if addr == utils.ZeroAddress {
 			return fmt.Errorf("token address is zero")
 		}
-		if v.AggregatorContractAddress == utils.ZeroAddress {
-			return fmt.Errorf("aggregator contract address is zero")
-		}
-		if v.ChainID == 0 {
-			return fmt.Errorf("chain id is zero")
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("aggregator price of token %s: %w", addr, err)
 		}
 	}
 
@@ -88,6 +184,158 @@ func (c *DynamicPriceGetterConfig) Validate() error {
 			}
 		}
 	}
+
+	for addr, v := range c.UniswapV3TWAPPrices {
+		if addr == utils.ZeroAddress {
+			return fmt.Errorf("token address is zero")
+		}
+		if _, exists := c.AggregatorPrices[addr]; exists {
+			return fmt.Errorf("token %s defined in both aggregator and uniswap v3 twap price rules", addr)
+		}
+		if _, exists := c.StaticPrices[addr]; exists {
+			return fmt.Errorf("token %s defined in both static and uniswap v3 twap price rules", addr)
+		}
+		if v.PoolAddress == utils.ZeroAddress {
+			return fmt.Errorf("pool address is zero")
+		}
+		if v.ChainID == 0 {
+			return fmt.Errorf("chain id is zero")
+		}
+		if v.TWAPWindowSeconds == 0 {
+			return fmt.Errorf("twap window of token %s is zero", addr)
+		}
+		_, isAgg := c.AggregatorPrices[v.QuoteToken]
+		_, isStatic := c.StaticPrices[v.QuoteToken]
+		if !isAgg && !isStatic {
+			return fmt.Errorf("quote token %s of token %s has no aggregator or static price rule", v.QuoteToken, addr)
+		}
+	}
+	return nil
+}
+
+// MedianPriceGetterConfig specifies the price sources of tokens (map keys) for a price getter that
+// reports the median of all sources, so that a single faulty source cannot move the price.
+type MedianPriceGetterConfig struct {
+	TokenPrices map[common.Address]MedianTokenPriceConfig `json:"tokenPrices"`
+}
+
+// MedianTokenPriceConfig specifies the price sources of a single token.
+type MedianTokenPriceConfig struct {
+	// AggregatorPrices lists the aggregators to read the token price from, they can be on different chains.
+	AggregatorPrices []AggregatorPriceConfig `json:"aggregatorPrices"`
+	// FallbackPrice is reported when not enough aggregators responded.
+	FallbackPrice *big.Int `json:"fallbackPrice,omitempty"`
+	// MinSources is the minimum number of aggregators that have to respond within MaxDeviationPPB of the median.
+	// Defaults to a majority of AggregatorPrices.
+	MinSources int `json:"minSources"`
+	// MaxDeviationPPB is the maximum deviation (in parts per billion) of a source from the median,
+	// deviating sources are ignored. Zero disables the check.
+	MaxDeviationPPB int64 `json:"maxDeviationPPB"`
+}
+
+// MinResponses returns the configured MinSources, or a majority of the aggregators if unset.
+func (c MedianTokenPriceConfig) MinResponses() int {
+	if c.MinSources > 0 {
+		return c.MinSources
+	}
+	return len(c.AggregatorPrices)/2 + 1
+}
+
+// UnmarshalJSON provides a custom un-marshaller to handle JSON embedded in Toml content.
+func (c *MedianPriceGetterConfig) UnmarshalJSON(data []byte) error {
+	type Alias MedianPriceGetterConfig
+	if bytes.HasQuotes(data) {
+		trimmed := string(bytes.TrimQuotes(data))
+		trimmed = strings.ReplaceAll(trimmed, "\\n", "")
+		trimmed = strings.ReplaceAll(trimmed, "\\t", "")
+		trimmed = strings.ReplaceAll(trimmed, "\\", "")
+		return json.Unmarshal([]byte(trimmed), (*Alias)(c))
+	}
+	return json.Unmarshal(data, (*Alias)(c))
+}
+
+func (c *MedianPriceGetterConfig) Validate() error {
+	if len(c.TokenPrices) == 0 {
+		return fmt.Errorf("no token prices configured")
+	}
+	for addr, v := range c.TokenPrices {
+		if addr == utils.ZeroAddress {
+			return fmt.Errorf("token address is zero")
+		}
+		if len(v.AggregatorPrices) == 0 {
+			return fmt.Errorf("no aggregators configured for token %s", addr)
+		}
+		for _, agg := range v.AggregatorPrices {
+			if err := agg.Validate(); err != nil {
+				return fmt.Errorf("aggregator price of token %s: %w", addr, err)
+			}
+			if agg.Fallback != "" || agg.FallbackPrice != nil {
+				return fmt.Errorf("aggregator price of token %s: fallback is not supported by the median price getter", addr)
+			}
+		}
+		if v.FallbackPrice != nil && v.FallbackPrice.Sign() <= 0 {
+			return fmt.Errorf("fallback price of token %s must be positive", addr)
+		}
+		if v.MinSources < 0 || v.MinSources > len(v.AggregatorPrices) {
+			return fmt.Errorf("minSources of token %s must be between 0 and %d", addr, len(v.AggregatorPrices))
+		}
+		if v.MaxDeviationPPB < 0 {
+			return fmt.Errorf("maxDeviationPPB of token %s is negative", addr)
+		}
+	}
+	return nil
+}
+
+const (
+	// PriceConsensusMedian reports the median of the observed prices, it is the default.
+	PriceConsensusMedian = "median"
+	// PriceConsensusTrimmedMean reports the mean of the observed prices, after discarding the f lowest and f highest.
+	// It reports the median when there are no more than 2f observations.
+	PriceConsensusTrimmedMean = "trimmed-mean"
+	// PriceConsensusWeightedMedian reports the median of the observed prices weighted by OracleWeights.
+	PriceConsensusWeightedMedian = "weighted-median"
+)
+
+// PriceConsensusConfig is the token price consensus policy of a lane. It is part of the report consensus, all the
+// nodes of a lane must use the same policy, otherwise they don't agree on the reported prices.
+type PriceConsensusConfig struct {
+	// Strategy is one of the PriceConsensus* values, empty defaults to PriceConsensusMedian.
+	Strategy string
+	// OracleWeights are the weights of the oracles, by OCR oracle ID, used by PriceConsensusWeightedMedian.
+	// Oracles without a weight have a weight of 1. The f largest weights must sum to less than half of the total weight,
+	// otherwise f faulty oracles could set the reported prices on their own.
+	OracleWeights map[uint8]uint32
+	// Tokens overrides the offchain config TokenPriceDeviationPPB and TokenPriceHeartBeat of destination tokens.
+	Tokens map[common.Address]TokenPriceUpdateConfig
+}
+
+// TokenPriceUpdateConfig is the update cadence of a single token price, zero values use the offchain config ones.
+type TokenPriceUpdateConfig struct {
+	// DeviationPPB is the deviation (in parts per billion) from the latest price triggering an update.
+	DeviationPPB uint32
+	// HeartBeatSeconds is the maximum age of the latest price before it's updated regardless of deviation.
+	HeartBeatSeconds uint32
+}
+
+func (c PriceConsensusConfig) Validate() error {
+	switch c.Strategy {
+	case "", PriceConsensusMedian, PriceConsensusTrimmedMean, PriceConsensusWeightedMedian:
+	default:
+		return fmt.Errorf("unknown strategy %q", c.Strategy)
+	}
+	if len(c.OracleWeights) > 0 && c.Strategy != PriceConsensusWeightedMedian {
+		return fmt.Errorf("oracle weights are only used by the %q strategy", PriceConsensusWeightedMedian)
+	}
+	for oracle, weight := range c.OracleWeights {
+		if weight == 0 {
+			return fmt.Errorf("weight of oracle %d is zero", oracle)
+		}
+	}
+	for token := range c.Tokens {
+		if token == utils.ZeroAddress {
+			return errors.New("token address is zero")
+		}
+	}
 	return nil
 }
 
@@ -95,6 +343,231 @@ func (c *DynamicPriceGetterConfig) Validate() error {
 type ExecutionPluginJobSpecConfig struct {
 	SourceStartBlock, DestStartBlock uint64 // Only for first time job add.
 	USDCConfig                       USDCConfig
+	// TokenDataProviders registers additional offchain token data providers, one per source token.
+	TokenDataProviders []TokenDataProviderConfig
+	// BatchOrdering selects the order pending messages are packed into execution batches in.
+	BatchOrdering BatchOrderingConfig
+	// ChainHealth configures which chain health checks stop the lane.
+	ChainHealth ChainHealthConfig
+	// DebugRounds is the number of latest rounds kept for debugging through the API, zero disables it.
+	DebugRounds uint
+}
+
+// Validate checks the token data provider, batch ordering, chain health and debug settings of the execution plugin config.
+func (c *ExecutionPluginJobSpecConfig) Validate() error {
+	if err := c.BatchOrdering.Validate(); err != nil {
+		return fmt.Errorf("batch ordering: %w", err)
+	}
+	if err := c.ChainHealth.Validate(); err != nil {
+		return fmt.Errorf("chain health: %w", err)
+	}
+	if err := ValidateDebugRounds(c.DebugRounds); err != nil {
+		return err
+	}
+
+	if c.USDCConfig != (USDCConfig{}) {
+		if err := c.USDCConfig.ValidateUSDCConfig(); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[common.Address]struct{}, len(c.TokenDataProviders))
+	if c.USDCConfig.AttestationAPI != "" {
+		seen[c.USDCConfig.SourceTokenAddress] = struct{}{}
+	}
+	for i, provider := range c.TokenDataProviders {
+		if err := provider.Validate(); err != nil {
+			return fmt.Errorf("token data provider %d: %w", i, err)
+		}
+		if _, exists := seen[provider.SourceTokenAddress]; exists {
+			return fmt.Errorf("token data provider %d: duplicate provider for token %s", i, provider.SourceTokenAddress)
+		}
+		seen[provider.SourceTokenAddress] = struct{}{}
+	}
+	return nil
+}
+
+// Chain health checks of a lane, see ChainHealthConfig.
+const (
+	// ChainHealthCheckSourceFinality fails when the source log poller saw a finality violation.
+	ChainHealthCheckSourceFinality = "source-finality"
+	// ChainHealthCheckDestFinality fails when the destination log poller saw a finality violation.
+	ChainHealthCheckDestFinality = "dest-finality"
+	// ChainHealthCheckCommitStoreDown fails when the commit store is paused or the destination RMN is cursed.
+	ChainHealthCheckCommitStoreDown = "commit-store-down"
+	// ChainHealthCheckSourceCursed fails when the source RMN is cursed.
+	ChainHealthCheckSourceCursed = "source-cursed"
+	// ChainHealthCheckSourceHeadLag and ChainHealthCheckDestHeadLag fail when the latest head seen by the headtracker
+	// of the chain is older than MaxHeadLagSeconds.
+	ChainHealthCheckSourceHeadLag = "source-head-lag"
+	ChainHealthCheckDestHeadLag   = "dest-head-lag"
+	// ChainHealthCheckSourceReplay and ChainHealthCheckDestReplay fail while the log poller of the chain is replaying,
+	// when the logs the plugins read may be incomplete.
+	ChainHealthCheckSourceReplay = "source-log-poller-replay"
+	ChainHealthCheckDestReplay   = "dest-log-poller-replay"
+)
+
+// DefaultFatalChainHealthChecks are the checks stopping the lane when ChainHealthConfig.FatalChecks is empty.
+var DefaultFatalChainHealthChecks = []string{
+	ChainHealthCheckSourceFinality,
+	ChainHealthCheckDestFinality,
+	ChainHealthCheckCommitStoreDown,
+	ChainHealthCheckSourceCursed,
+}
+
+var allChainHealthChecks = map[string]struct{}{
+	ChainHealthCheckSourceFinality:  {},
+	ChainHealthCheckDestFinality:    {},
+	ChainHealthCheckCommitStoreDown: {},
+	ChainHealthCheckSourceCursed:    {},
+	ChainHealthCheckSourceHeadLag:   {},
+	ChainHealthCheckDestHeadLag:     {},
+	ChainHealthCheckSourceReplay:    {},
+	ChainHealthCheckDestReplay:      {},
+}
+
+// ChainHealthConfig is the chain health policy of a lane. A failing fatal check stops the lane and marks it unhealthy
+// for StickyUnhealthySeconds, even if the check recovers in the meantime. The other checks are only reported in the
+// health report of the node.
+type ChainHealthConfig struct {
+	// FatalChecks are the ChainHealthCheck* values stopping the lane, defaults to DefaultFatalChainHealthChecks.
+	FatalChecks []string
+	// MaxHeadLagSeconds is the maximum age of the latest head of each chain, zero disables the head lag checks.
+	MaxHeadLagSeconds uint
+	// StickyUnhealthySeconds is how long the lane stays unhealthy after a fatal check failed, defaults to 30 minutes.
+	StickyUnhealthySeconds uint
+	// ClearableStickyUnhealthy allows admins to clear the sticky unhealthy status of the lane before it expires.
+	ClearableStickyUnhealthy bool
+}
+
+// ErrStickyUnhealthyNotClearable is returned when clearing the sticky unhealthy status of a lane not allowing it.
+var ErrStickyUnhealthyNotClearable = errors.New("sticky unhealthy status isn't clearable, ClearableStickyUnhealthy is disabled for the lane")
+
+func (c ChainHealthConfig) Validate() error {
+	seen := make(map[string]struct{}, len(c.FatalChecks))
+	for _, check := range c.FatalChecks {
+		if _, exists := allChainHealthChecks[check]; !exists {
+			return fmt.Errorf("unknown check %q", check)
+		}
+		if _, exists := seen[check]; exists {
+			return fmt.Errorf("duplicate check %q", check)
+		}
+		seen[check] = struct{}{}
+	}
+	_, sourceHeadLag := seen[ChainHealthCheckSourceHeadLag]
+	_, destHeadLag := seen[ChainHealthCheckDestHeadLag]
+	if (sourceHeadLag || destHeadLag) && c.MaxHeadLagSeconds == 0 {
+		return errors.New("MaxHeadLagSeconds is required for the head lag checks to be fatal")
+	}
+	return nil
+}
+
+// Fatal returns the set of checks stopping the lane.
+func (c ChainHealthConfig) Fatal() map[string]struct{} {
+	checks := c.FatalChecks
+	if len(checks) == 0 {
+		checks = DefaultFatalChainHealthChecks
+	}
+	fatal := make(map[string]struct{}, len(checks))
+	for _, check := range checks {
+		fatal[check] = struct{}{}
+	}
+	return fatal
+}
+
+const (
+	// BatchOrderingSequenceNumber packs messages in sequence number order, it is the default.
+	BatchOrderingSequenceNumber = "sequence-number"
+	// BatchOrderingFeePerGas packs the messages paying the highest fee per unit of execution gas first.
+	BatchOrderingFeePerGas = "fee-per-gas"
+	// BatchOrderingTokenValue packs the messages transferring the highest USD token value first.
+	BatchOrderingTokenValue = "token-value"
+)
+
+// BatchOrderingConfig is the execution batch ordering policy of a lane. Messages of the same sender always keep
+// their sequence number order, since the offRamp enforces their nonces. All the nodes of a lane must use the same
+// ordering, otherwise their observations diverge and batches are only agreed on for the messages they have in common.
+type BatchOrderingConfig struct {
+	// Policy is one of the BatchOrdering* values, empty defaults to BatchOrderingSequenceNumber.
+	Policy string
+	// PrioritySenders are packed before any other sender, whatever the Policy.
+	PrioritySenders []common.Address
+}
+
+func (c BatchOrderingConfig) Validate() error {
+	switch c.Policy {
+	case "", BatchOrderingSequenceNumber, BatchOrderingFeePerGas, BatchOrderingTokenValue:
+	default:
+		return fmt.Errorf("unknown policy %q", c.Policy)
+	}
+	seen := make(map[common.Address]struct{}, len(c.PrioritySenders))
+	for _, sender := range c.PrioritySenders {
+		if sender == utils.ZeroAddress {
+			return errors.New("priority sender can't be the zero address")
+		}
+		if _, exists := seen[sender]; exists {
+			return fmt.Errorf("duplicate priority sender %s", sender)
+		}
+		seen[sender] = struct{}{}
+	}
+	return nil
+}
+
+const (
+	// TokenDataProviderTypeUSDC selects the USDC/CCTP attestation reader, Config must be a USDCConfig.
+	TokenDataProviderTypeUSDC = "usdc"
+	// TokenDataProviderTypeHTTPAttestation selects the generic attestation reader, Config must be an HTTPAttestationConfig.
+	TokenDataProviderTypeHTTPAttestation = "http-attestation"
+)
+
+// TokenDataProviderConfig specifies the offchain token data provider of a single source token.
+type TokenDataProviderConfig struct {
+	// Type selects the tokendata.Reader implementation, e.g. TokenDataProviderTypeUSDC.
+	Type string
+	// SourceTokenAddress is the token on the source chain the provider returns token data for.
+	SourceTokenAddress common.Address
+	// Config holds the provider specific configuration, its format depends on Type.
+	Config json.RawMessage
+}
+
+func (c TokenDataProviderConfig) Validate() error {
+	if c.SourceTokenAddress == utils.ZeroAddress {
+		return errors.New("SourceTokenAddress is required")
+	}
+	switch c.Type {
+	case TokenDataProviderTypeUSDC:
+		usdcConfig, err := c.USDCConfig()
+		if err != nil {
+			return err
+		}
+		return usdcConfig.ValidateUSDCConfig()
+	case TokenDataProviderTypeHTTPAttestation:
+		attestationConfig, err := c.HTTPAttestationConfig()
+		if err != nil {
+			return err
+		}
+		return attestationConfig.Validate()
+	case "":
+		return errors.New("Type is required")
+	default:
+		return fmt.Errorf("unknown token data provider type %q", c.Type)
+	}
+}
+
+// USDCConfig decodes Config of a TokenDataProviderTypeUSDC provider. SourceTokenAddress
+// defaults to the address of the provider entry and must match it when set.
+func (c TokenDataProviderConfig) USDCConfig() (USDCConfig, error) {
+	var usdcConfig USDCConfig
+	if err := json.Unmarshal(c.Config, &usdcConfig); err != nil {
+		return USDCConfig{}, fmt.Errorf("invalid usdc config: %w", err)
+	}
+	if usdcConfig.SourceTokenAddress == utils.ZeroAddress {
+		usdcConfig.SourceTokenAddress = c.SourceTokenAddress
+	}
+	if usdcConfig.SourceTokenAddress != c.SourceTokenAddress {
+		return USDCConfig{}, fmt.Errorf("usdc config token %s does not match provider token %s", usdcConfig.SourceTokenAddress, c.SourceTokenAddress)
+	}
+	return usdcConfig, nil
 }
 
 type USDCConfig struct {
@@ -122,3 +595,90 @@ func (uc *USDCConfig) ValidateUSDCConfig() error {
 
 	return nil
 }
+
+// HTTPAttestationConfig decodes Config of a TokenDataProviderTypeHTTPAttestation provider.
+func (c TokenDataProviderConfig) HTTPAttestationConfig() (HTTPAttestationConfig, error) {
+	var attestationConfig HTTPAttestationConfig
+	if err := json.Unmarshal(c.Config, &attestationConfig); err != nil {
+		return HTTPAttestationConfig{}, fmt.Errorf("invalid http attestation config: %w", err)
+	}
+	return attestationConfig, nil
+}
+
+const (
+	// AttestationSourceMessage selects the attested source event message as the value of an AttestationTokenDataField.
+	AttestationSourceMessage = "$message"
+	// AttestationSourceMessageHash selects the keccak256 hash of the attested message as the value of an AttestationTokenDataField.
+	AttestationSourceMessageHash = "$messageHash"
+)
+
+// HTTPAttestationConfig configures a generic attestation provider: the source event emitted alongside
+// the CCIP message is hashed, the attestation API is polled for that hash and the response is abi encoded
+// into the token data.
+type HTTPAttestationConfig struct {
+	// SourceEventSignature is the signature of the source chain event carrying the attested message, e.g. "MessageSent(bytes)".
+	SourceEventSignature string
+	// SourceEventAddress is the source chain contract emitting SourceEventSignature.
+	SourceEventAddress common.Address
+	// SourceEventDataIsBytes must be set when the event data is a single abi encoded bytes value,
+	// the attested message is then the decoded value instead of the raw event data.
+	SourceEventDataIsBytes bool
+	// AttestationAPI is a text/template of the attestation URL. The template can use the fields
+	// MessageHash, MessageID and TxHash, e.g. "https://api.example.com/v1/attestations/{{.MessageHash}}".
+	AttestationAPI               string
+	AttestationAPITimeoutSeconds uint
+	// AttestationAPIIntervalMilliseconds can be set to -1 to disable or 0 to use a default interval.
+	AttestationAPIIntervalMilliseconds int
+	// StatusPath is the JSON path of the attestation status in the API response, e.g. "data.status".
+	StatusPath string
+	// SuccessStatus is the status of a complete attestation, any other status is treated as not ready.
+	SuccessStatus string
+	// ErrorPath optionally points to an error message in the API response.
+	ErrorPath string
+	// TokenData defines the fields of the token data, they are abi encoded as a tuple in the given order.
+	TokenData []AttestationTokenDataField
+}
+
+// AttestationTokenDataField specifies a single field of the token data of an HTTPAttestationConfig provider.
+type AttestationTokenDataField struct {
+	// Type is the abi type of the field, e.g. "bytes" or "uint256".
+	Type string
+	// Source is either AttestationSourceMessage, AttestationSourceMessageHash or a JSON path in the API response.
+	Source string
+}
+
+func (c HTTPAttestationConfig) Validate() error {
+	if c.SourceEventSignature == "" {
+		return errors.New("SourceEventSignature is required")
+	}
+	if c.SourceEventAddress == utils.ZeroAddress {
+		return errors.New("SourceEventAddress is required")
+	}
+	if c.AttestationAPI == "" {
+		return errors.New("AttestationAPI is required")
+	}
+	if _, err := template.New("attestationAPI").Option("missingkey=error").Parse(c.AttestationAPI); err != nil {
+		return fmt.Errorf("invalid AttestationAPI template: %w", err)
+	}
+	if c.AttestationAPIIntervalMilliseconds < -1 {
+		return errors.New("AttestationAPIIntervalMilliseconds must be -1 to disable, 0 for default or greater to define the exact interval")
+	}
+	if c.StatusPath == "" {
+		return errors.New("StatusPath is required")
+	}
+	if c.SuccessStatus == "" {
+		return errors.New("SuccessStatus is required")
+	}
+	if len(c.TokenData) == 0 {
+		return errors.New("TokenData is required")
+	}
+	for i, field := range c.TokenData {
+		if field.Source == "" {
+			return fmt.Errorf("TokenData field %d: Source is required", i)
+		}
+		if _, err := abi.NewType(field.Type, "", nil); err != nil {
+			return fmt.Errorf("TokenData field %d: invalid type %q: %w", i, field.Type, err)
+		}
+	}
+	return nil
+}
@@ -129,6 +129,61 @@ func TestCommitConfig(t *testing.T) {
 	}
 }
 
+func TestAggregatorPriceConfigValidate(t *testing.T) {
+	valid := func() AggregatorPriceConfig {
+		return AggregatorPriceConfig{
+			ChainID:                   1000,
+			AggregatorContractAddress: utils.RandomAddress(),
+			MaxAnswerAgeSeconds:       3600,
+			MinPrice:                  big.NewInt(1),
+			MaxPrice:                  big.NewInt(100),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c *AggregatorPriceConfig)
+		err    string
+	}{
+		{name: "default fallback", modify: func(c *AggregatorPriceConfig) {}},
+		{name: "skip fallback", modify: func(c *AggregatorPriceConfig) { c.Fallback = AggregatorPriceFallbackSkip }},
+		{name: "static fallback", modify: func(c *AggregatorPriceConfig) {
+			c.Fallback = AggregatorPriceFallbackStatic
+			c.FallbackPrice = big.NewInt(10)
+		}},
+		{name: "static fallback without price", modify: func(c *AggregatorPriceConfig) {
+			c.Fallback = AggregatorPriceFallbackStatic
+		}, err: "fallback price must be positive"},
+		{name: "fallback price without static fallback", modify: func(c *AggregatorPriceConfig) {
+			c.FallbackPrice = big.NewInt(10)
+		}, err: "fallback price is only used"},
+		{name: "unknown fallback", modify: func(c *AggregatorPriceConfig) { c.Fallback = "ignore" }, err: "unknown fallback"},
+		{name: "min greater than max", modify: func(c *AggregatorPriceConfig) { c.MinPrice = big.NewInt(101) }, err: "is greater than max price"},
+		{name: "negative min", modify: func(c *AggregatorPriceConfig) { c.MinPrice = big.NewInt(-1) }, err: "min price is negative"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := valid()
+			test.modify(&cfg)
+
+			// Verify the new fields survive the JSON round trip.
+			bts, err := json.Marshal(cfg)
+			require.NoError(t, err)
+			var parsed AggregatorPriceConfig
+			require.NoError(t, json.Unmarshal(bts, &parsed))
+			require.Equal(t, cfg, parsed)
+
+			err = cfg.Validate()
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestExecutionConfig(t *testing.T) {
 	exampleConfig := ExecutionPluginJobSpecConfig{
 		SourceStartBlock: 222,
@@ -205,6 +260,339 @@ func TestUSDCValidate(t *testing.T) {
 	}
 }
 
+func TestTokenDataProvidersValidate(t *testing.T) {
+	tokenAddr := utils.RandomAddress()
+	usdcConfig := func(token common.Address) json.RawMessage {
+		cfg, err := json.Marshal(USDCConfig{
+			AttestationAPI:                  "api",
+			SourceTokenAddress:              token,
+			SourceMessageTransmitterAddress: utils.RandomAddress(),
+		})
+		require.NoError(t, err)
+		return cfg
+	}
+
+	testcases := []struct {
+		name   string
+		config ExecutionPluginJobSpecConfig
+		err    string
+	}{
+		{
+			name:   "no providers",
+			config: ExecutionPluginJobSpecConfig{},
+		},
+		{
+			name: "valid usdc provider",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeUSDC, SourceTokenAddress: tokenAddr, Config: usdcConfig(tokenAddr)},
+				},
+			},
+		},
+		{
+			name: "usdc token defaults to provider token",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeUSDC, SourceTokenAddress: tokenAddr, Config: usdcConfig(utils.ZeroAddress)},
+				},
+			},
+		},
+		{
+			name: "usdc token mismatch",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeUSDC, SourceTokenAddress: tokenAddr, Config: usdcConfig(utils.RandomAddress())},
+				},
+			},
+			err: "does not match provider token",
+		},
+		{
+			name: "valid http attestation provider",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeHTTPAttestation, SourceTokenAddress: tokenAddr, Config: json.RawMessage(`{
+						"SourceEventSignature": "MessageSent(bytes)",
+						"SourceEventAddress": "` + utils.RandomAddress().Hex() + `",
+						"AttestationAPI": "https://api.example.com/v1/attestations/{{.MessageHash}}",
+						"StatusPath": "status",
+						"SuccessStatus": "complete",
+						"TokenData": [{"Type": "bytes", "Source": "$message"}, {"Type": "bytes", "Source": "attestation"}]
+					}`)},
+				},
+			},
+		},
+		{
+			name: "invalid http attestation provider",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeHTTPAttestation, SourceTokenAddress: tokenAddr, Config: json.RawMessage(`{
+						"SourceEventSignature": "MessageSent(bytes)",
+						"SourceEventAddress": "` + utils.RandomAddress().Hex() + `",
+						"AttestationAPI": "https://api.example.com/v1/attestations/{{.MessageHash}}",
+						"StatusPath": "status",
+						"SuccessStatus": "complete",
+						"TokenData": [{"Type": "bytes99", "Source": "attestation"}]
+					}`)},
+				},
+			},
+			err: "invalid type",
+		},
+		{
+			name: "missing token",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeUSDC, Config: usdcConfig(tokenAddr)},
+				},
+			},
+			err: "SourceTokenAddress is required",
+		},
+		{
+			name: "missing type",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{SourceTokenAddress: tokenAddr},
+				},
+			},
+			err: "Type is required",
+		},
+		{
+			name: "unknown type",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: "unknown", SourceTokenAddress: tokenAddr},
+				},
+			},
+			err: "unknown token data provider type",
+		},
+		{
+			name: "duplicate token",
+			config: ExecutionPluginJobSpecConfig{
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeUSDC, SourceTokenAddress: tokenAddr, Config: usdcConfig(tokenAddr)},
+					{Type: TokenDataProviderTypeUSDC, SourceTokenAddress: tokenAddr, Config: usdcConfig(tokenAddr)},
+				},
+			},
+			err: "duplicate provider for token",
+		},
+		{
+			name: "duplicate token with legacy usdc config",
+			config: ExecutionPluginJobSpecConfig{
+				USDCConfig: USDCConfig{
+					AttestationAPI:                  "api",
+					SourceTokenAddress:              tokenAddr,
+					SourceMessageTransmitterAddress: utils.RandomAddress(),
+				},
+				TokenDataProviders: []TokenDataProviderConfig{
+					{Type: TokenDataProviderTypeUSDC, SourceTokenAddress: tokenAddr, Config: usdcConfig(tokenAddr)},
+				},
+			},
+			err: "duplicate provider for token",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.config.Validate()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBatchOrderingValidate(t *testing.T) {
+	sender := utils.RandomAddress()
+
+	testcases := []struct {
+		name   string
+		config BatchOrderingConfig
+		err    string
+	}{
+		{
+			name:   "defaults to sequence number",
+			config: BatchOrderingConfig{},
+		},
+		{
+			name:   "fee per gas with priority senders",
+			config: BatchOrderingConfig{Policy: BatchOrderingFeePerGas, PrioritySenders: []common.Address{sender}},
+		},
+		{
+			name:   "token value",
+			config: BatchOrderingConfig{Policy: BatchOrderingTokenValue},
+		},
+		{
+			name:   "unknown policy",
+			config: BatchOrderingConfig{Policy: "random"},
+			err:    `unknown policy "random"`,
+		},
+		{
+			name:   "zero priority sender",
+			config: BatchOrderingConfig{PrioritySenders: []common.Address{utils.ZeroAddress}},
+			err:    "zero address",
+		},
+		{
+			name:   "duplicate priority sender",
+			config: BatchOrderingConfig{PrioritySenders: []common.Address{sender, sender}},
+			err:    "duplicate priority sender",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := (&ExecutionPluginJobSpecConfig{BatchOrdering: tc.config}).Validate()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChainHealthValidate(t *testing.T) {
+	testcases := []struct {
+		name   string
+		config ChainHealthConfig
+		err    string
+	}{
+		{
+			name:   "defaults",
+			config: ChainHealthConfig{},
+		},
+		{
+			name: "fatal head lag and replay",
+			config: ChainHealthConfig{
+				FatalChecks:       []string{ChainHealthCheckSourceFinality, ChainHealthCheckDestHeadLag, ChainHealthCheckSourceReplay},
+				MaxHeadLagSeconds: 60,
+			},
+		},
+		{
+			name:   "unknown check",
+			config: ChainHealthConfig{FatalChecks: []string{"gas-price"}},
+			err:    `unknown check "gas-price"`,
+		},
+		{
+			name:   "duplicate check",
+			config: ChainHealthConfig{FatalChecks: []string{ChainHealthCheckSourceCursed, ChainHealthCheckSourceCursed}},
+			err:    `duplicate check "source-cursed"`,
+		},
+		{
+			name:   "fatal head lag without max head lag",
+			config: ChainHealthConfig{FatalChecks: []string{ChainHealthCheckSourceHeadLag}},
+			err:    "MaxHeadLagSeconds is required",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := (&ExecutionPluginJobSpecConfig{ChainHealth: tc.config}).Validate()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("fatal checks default", func(t *testing.T) {
+		fatal := ChainHealthConfig{}.Fatal()
+		require.Len(t, fatal, len(DefaultFatalChainHealthChecks))
+		for _, check := range DefaultFatalChainHealthChecks {
+			require.Contains(t, fatal, check)
+		}
+		require.Equal(t, map[string]struct{}{ChainHealthCheckDestReplay: {}}, ChainHealthConfig{FatalChecks: []string{ChainHealthCheckDestReplay}}.Fatal())
+	})
+}
+
+func TestPriceConsensusValidate(t *testing.T) {
+	testcases := []struct {
+		name   string
+		config PriceConsensusConfig
+		err    string
+	}{
+		{
+			name:   "defaults",
+			config: PriceConsensusConfig{},
+		},
+		{
+			name: "weighted median with token overrides",
+			config: PriceConsensusConfig{
+				Strategy:      PriceConsensusWeightedMedian,
+				OracleWeights: map[uint8]uint32{0: 3, 2: 1},
+				Tokens: map[common.Address]TokenPriceUpdateConfig{
+					utils.RandomAddress(): {DeviationPPB: 1e6, HeartBeatSeconds: 60},
+				},
+			},
+		},
+		{
+			name:   "unknown strategy",
+			config: PriceConsensusConfig{Strategy: "mean"},
+			err:    `unknown strategy "mean"`,
+		},
+		{
+			name:   "weights without weighted median",
+			config: PriceConsensusConfig{Strategy: PriceConsensusTrimmedMean, OracleWeights: map[uint8]uint32{0: 3}},
+			err:    `oracle weights are only used by the "weighted-median" strategy`,
+		},
+		{
+			name:   "zero weight",
+			config: PriceConsensusConfig{Strategy: PriceConsensusWeightedMedian, OracleWeights: map[uint8]uint32{1: 0}},
+			err:    "weight of oracle 1 is zero",
+		},
+		{
+			name:   "zero token",
+			config: PriceConsensusConfig{Tokens: map[common.Address]TokenPriceUpdateConfig{{}: {DeviationPPB: 1}}},
+			err:    "token address is zero",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.config.Validate()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var cfg CommitPluginJobSpecConfig
+		require.NoError(t, json.Unmarshal([]byte(`{"priceConsensus": {
+			"Strategy": "weighted-median",
+			"OracleWeights": {"0": 2, "3": 1},
+			"Tokens": {"0x0820c05e1fba1244763a494a52272170c321cad3": {"DeviationPPB": 1000000, "HeartBeatSeconds": 600}}
+		}}`), &cfg))
+		require.Equal(t, PriceConsensusConfig{
+			Strategy:      PriceConsensusWeightedMedian,
+			OracleWeights: map[uint8]uint32{0: 2, 3: 1},
+			Tokens: map[common.Address]TokenPriceUpdateConfig{
+				common.HexToAddress("0x0820c05e1fba1244763a494a52272170c321cad3"): {DeviationPPB: 1e6, HeartBeatSeconds: 600},
+			},
+		}, cfg.PriceConsensus)
+	})
+}
+
+func TestDebugRoundsValidate(t *testing.T) {
+	require.NoError(t, ValidateDebugRounds(0))
+	require.NoError(t, ValidateDebugRounds(MaxDebugRounds))
+	require.Error(t, ValidateDebugRounds(MaxDebugRounds+1))
+
+	cfg := ExecutionPluginJobSpecConfig{DebugRounds: MaxDebugRounds + 1}
+	require.ErrorContains(t, cfg.Validate(), "debugRounds")
+}
+
 func TestUnmarshallDynamicPriceConfig(t *testing.T) {
 	jsonCfg := `
 {
@@ -232,3 +620,46 @@ func TestUnmarshallDynamicPriceConfig(t *testing.T) {
 	err = cfg.Validate()
 	require.NoError(t, err)
 }
+
+func TestUnmarshallMedianPriceConfig(t *testing.T) {
+	jsonCfg := `
+{
+	"tokenPrices": {
+		"0x0820c05e1fba1244763a494a52272170c321cad3": {
+			"aggregatorPrices": [
+				{
+					"chainID": "1000",
+					"contractAddress": "0xb8dabd288955d302d05ca6b011bb46dfa3ea7acf"
+				},
+				{
+					"chainID": "1337",
+					"contractAddress": "0xb80244cc8b0bb18db071c150b36e9bcb8310b236"
+				}
+			],
+			"fallbackPrice": 1000000000000000000,
+			"minSources": 2,
+			"maxDeviationPPB": 50000000
+		}
+	}
+}
+`
+	var cfg MedianPriceGetterConfig
+	err := json.Unmarshal([]byte(jsonCfg), &cfg)
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+
+	tokenCfg := cfg.TokenPrices[common.HexToAddress("0x0820c05e1fba1244763a494a52272170c321cad3")]
+	require.Len(t, tokenCfg.AggregatorPrices, 2)
+	require.Equal(t, 2, tokenCfg.MinResponses())
+	require.Equal(t, int64(50000000), tokenCfg.MaxDeviationPPB)
+	require.Equal(t, big.NewInt(1e18), tokenCfg.FallbackPrice)
+
+	tokenCfg.MinSources = 3
+	cfg.TokenPrices[common.HexToAddress("0x0820c05e1fba1244763a494a52272170c321cad3")] = tokenCfg
+	require.Error(t, cfg.Validate())
+
+	tokenCfg.MinSources = 2
+	tokenCfg.AggregatorPrices[0].Fallback = AggregatorPriceFallbackSkip
+	cfg.TokenPrices[common.HexToAddress("0x0820c05e1fba1244763a494a52272170c321cad3")] = tokenCfg
+	require.ErrorContains(t, cfg.Validate(), "fallback is not supported by the median price getter")
+}
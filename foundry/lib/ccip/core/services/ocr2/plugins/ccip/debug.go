@@ -0,0 +1,216 @@
+package ccip
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+var ErrDebugRecorderNotFound = errors.New("no running debug recorder for the job, debugRounds may be disabled")
+
+// DebugRound is what an oracle observed and decided during an OCR round.
+type DebugRound struct {
+	ConfigDigest string
+	Epoch        uint32
+	Round        uint8
+	UpdatedAt    time.Time
+	// Observation is the observation of the oracle, ObservationError why it couldn't observe.
+	Observation      json.RawMessage `json:",omitempty"`
+	ObservationError string          `json:",omitempty"`
+	// Observations are the observations received by the oracle as the leader of the round.
+	Observations []DebugObservation `json:",omitempty"`
+	// Consensus is what the leader agreed on from the observations, e.g. the commit interval.
+	Consensus any `json:",omitempty"`
+	// ShouldReport is false when no report was built, ReportError explains why when it's an error.
+	ShouldReport bool
+	ReportError  string `json:",omitempty"`
+	// Rejections are the reasons the finalized reports of the round were rejected.
+	Rejections []string `json:",omitempty"`
+}
+
+// DebugObservation is an observation received from another oracle.
+type DebugObservation struct {
+	Observer    commontypes.OracleID
+	Observation json.RawMessage
+}
+
+// DebugRecorder keeps the last rounds of a plugin in a ring buffer. A nil recorder records nothing.
+type DebugRecorder struct {
+	mu     sync.Mutex
+	rounds []DebugRound
+	// next is the index the next new round is written to, once the buffer is full.
+	next int
+}
+
+// NewDebugRecorder returns a recorder of the last n rounds, or nil when n is zero.
+func NewDebugRecorder(n uint) *DebugRecorder {
+	if n == 0 {
+		return nil
+	}
+	return &DebugRecorder{rounds: make([]DebugRound, 0, n)}
+}
+
+// RecordObservation records the observation of the oracle, or the error it failed with.
+func (r *DebugRecorder) RecordObservation(ts types.ReportTimestamp, observation types.Observation, err error) {
+	r.update(ts, func(round *DebugRound) {
+		round.Observation = debugJSON(observation)
+		round.ObservationError = errorString(err)
+	})
+}
+
+// RecordConsensus records what the leader agreed on from the observations of the round.
+func (r *DebugRecorder) RecordConsensus(ts types.ReportTimestamp, consensus any) {
+	r.update(ts, func(round *DebugRound) {
+		round.Consensus = consensus
+	})
+}
+
+// RecordReport records the observations received by the leader and whether it built a report from them.
+func (r *DebugRecorder) RecordReport(ts types.ReportTimestamp, observations []types.AttributedObservation, shouldReport bool, err error) {
+	r.update(ts, func(round *DebugRound) {
+		round.Observations = make([]DebugObservation, len(observations))
+		for i, obs := range observations {
+			round.Observations[i] = DebugObservation{Observer: obs.Observer, Observation: debugJSON(obs.Observation)}
+		}
+		round.ShouldReport = shouldReport
+		round.ReportError = errorString(err)
+	})
+}
+
+// RecordRejectedReport records why a finalized report of the round was rejected.
+func (r *DebugRecorder) RecordRejectedReport(ts types.ReportTimestamp, reason string) {
+	r.update(ts, func(round *DebugRound) {
+		round.Rejections = append(round.Rejections, reason)
+	})
+}
+
+// Rounds returns the recorded rounds, oldest first.
+func (r *DebugRecorder) Rounds() []DebugRound {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rounds := make([]DebugRound, 0, len(r.rounds))
+	rounds = append(rounds, r.rounds[r.next:]...)
+	return append(rounds, r.rounds[:r.next]...)
+}
+
+func (r *DebugRecorder) update(ts types.ReportTimestamp, f func(round *DebugRound)) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	round := r.round(ts)
+	f(round)
+	round.UpdatedAt = time.Now()
+}
+
+// round returns the recorded round of the timestamp, evicting the oldest round for it if it's a new one.
+func (r *DebugRecorder) round(ts types.ReportTimestamp) *DebugRound {
+	configDigest := ts.ConfigDigest.Hex()
+	// The round being updated is almost always one of the latest ones.
+	for i := len(r.rounds) - 1; i >= 0; i-- {
+		idx := (r.next + i) % len(r.rounds)
+		if round := &r.rounds[idx]; round.Epoch == ts.Epoch && round.Round == ts.Round && round.ConfigDigest == configDigest {
+			return round
+		}
+	}
+	newRound := DebugRound{ConfigDigest: configDigest, Epoch: ts.Epoch, Round: ts.Round}
+	if len(r.rounds) < cap(r.rounds) {
+		r.rounds = append(r.rounds, newRound)
+		return &r.rounds[len(r.rounds)-1]
+	}
+	r.rounds[r.next] = newRound
+	round := &r.rounds[r.next]
+	r.next = (r.next + 1) % len(r.rounds)
+	return round
+}
+
+// debugJSON returns the observation as is when it's JSON, as all the CCIP observations are, otherwise hex encoded.
+func debugJSON(observation []byte) json.RawMessage {
+	if len(observation) == 0 {
+		return nil
+	}
+	if json.Valid(observation) {
+		return json.RawMessage(observation)
+	}
+	encoded, _ := json.Marshal(hex.EncodeToString(observation))
+	return encoded
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// DebugRegistry keeps track of the debug recorders of the running CCIP jobs, so that their rounds can be
+// inspected through the API. A nil registry tracks nothing.
+type DebugRegistry struct {
+	recorders map[int32]*DebugRecorder
+	mu        sync.RWMutex
+}
+
+func NewDebugRegistry() *DebugRegistry {
+	return &DebugRegistry{recorders: make(map[int32]*DebugRecorder)}
+}
+
+// Track returns a service registering the recorder under the job ID while it's running.
+func (r *DebugRegistry) Track(jobID int32, recorder *DebugRecorder) job.ServiceCtx {
+	return &registeredDebugRecorder{registry: r, jobID: jobID, recorder: recorder}
+}
+
+// Rounds returns the rounds recorded for the job, oldest first.
+func (r *DebugRegistry) Rounds(jobID int32) ([]DebugRound, error) {
+	if r == nil {
+		return nil, ErrDebugRecorderNotFound
+	}
+	r.mu.RLock()
+	recorder, ok := r.recorders[jobID]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, ErrDebugRecorderNotFound
+	}
+	return recorder.Rounds(), nil
+}
+
+type registeredDebugRecorder struct {
+	registry *DebugRegistry
+	jobID    int32
+	recorder *DebugRecorder
+}
+
+func (s *registeredDebugRecorder) Start(context.Context) error {
+	if s.registry == nil || s.recorder == nil {
+		return nil
+	}
+	s.registry.mu.Lock()
+	defer s.registry.mu.Unlock()
+	if _, exists := s.registry.recorders[s.jobID]; exists {
+		return fmt.Errorf("debug recorder of job %d is already registered", s.jobID)
+	}
+	s.registry.recorders[s.jobID] = s.recorder
+	return nil
+}
+
+func (s *registeredDebugRecorder) Close() error {
+	if s.registry == nil || s.recorder == nil {
+		return nil
+	}
+	s.registry.mu.Lock()
+	defer s.registry.mu.Unlock()
+	delete(s.registry.recorders, s.jobID)
+	return nil
+}
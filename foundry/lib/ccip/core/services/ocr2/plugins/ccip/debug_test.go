@@ -0,0 +1,91 @@
+package ccip
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+func TestDebugRecorder(t *testing.T) {
+	recorder := NewDebugRecorder(2)
+	round1 := types.ReportTimestamp{Epoch: 1, Round: 1}
+	round2 := types.ReportTimestamp{Epoch: 1, Round: 2}
+	round3 := types.ReportTimestamp{Epoch: 2, Round: 1}
+
+	recorder.RecordObservation(round1, types.Observation(`{"interval":{"Min":1,"Max":2}}`), nil)
+	recorder.RecordReport(round1, []types.AttributedObservation{
+		{Observer: 1, Observation: types.Observation(`{"interval":{"Min":1,"Max":2}}`)},
+		{Observer: 2, Observation: types.Observation{0xde, 0xad}},
+	}, false, errors.New("Not enough valid observations to form consensus"))
+	recorder.RecordObservation(round2, nil, ErrChainIsNotHealthy)
+	recorder.RecordRejectedReport(round1, "stale report")
+
+	rounds := recorder.Rounds()
+	require.Len(t, rounds, 2)
+	assert.Equal(t, uint32(1), rounds[0].Epoch)
+	assert.Equal(t, uint8(1), rounds[0].Round)
+	assert.JSONEq(t, `{"interval":{"Min":1,"Max":2}}`, string(rounds[0].Observation))
+	require.Len(t, rounds[0].Observations, 2)
+	assert.JSONEq(t, `"dead"`, string(rounds[0].Observations[1].Observation), "invalid JSON observations are hex encoded")
+	assert.False(t, rounds[0].ShouldReport)
+	assert.Equal(t, "Not enough valid observations to form consensus", rounds[0].ReportError)
+	assert.Equal(t, []string{"stale report"}, rounds[0].Rejections)
+	assert.Equal(t, ErrChainIsNotHealthy.Error(), rounds[1].ObservationError)
+
+	// The oldest round is evicted by a new one
+	recorder.RecordConsensus(round3, []uint64{1, 2})
+	rounds = recorder.Rounds()
+	require.Len(t, rounds, 2)
+	assert.Equal(t, uint8(2), rounds[0].Round)
+	assert.Equal(t, uint32(2), rounds[1].Epoch)
+	assert.Equal(t, []uint64{1, 2}, rounds[1].Consensus)
+
+	_, err := json.Marshal(rounds)
+	require.NoError(t, err)
+}
+
+func TestDebugRecorder_disabled(t *testing.T) {
+	recorder := NewDebugRecorder(0)
+	require.Nil(t, recorder)
+	recorder.RecordObservation(types.ReportTimestamp{}, nil, nil)
+	recorder.RecordRejectedReport(types.ReportTimestamp{}, "stale report")
+	require.Empty(t, recorder.Rounds())
+}
+
+func TestDebugRegistry(t *testing.T) {
+	ctx := tests.Context(t)
+	registry := NewDebugRegistry()
+	recorder := NewDebugRecorder(1)
+	recorder.RecordRejectedReport(types.ReportTimestamp{Epoch: 1}, "stale report")
+
+	tracked := registry.Track(1, recorder)
+	_, err := registry.Rounds(1)
+	require.ErrorIs(t, err, ErrDebugRecorderNotFound)
+
+	require.NoError(t, tracked.Start(ctx))
+	rounds, err := registry.Rounds(1)
+	require.NoError(t, err)
+	require.Len(t, rounds, 1)
+	require.ErrorContains(t, registry.Track(1, NewDebugRecorder(1)).Start(ctx), "already registered")
+
+	require.NoError(t, tracked.Close())
+	_, err = registry.Rounds(1)
+	require.ErrorIs(t, err, ErrDebugRecorderNotFound)
+
+	// Disabled recorders aren't registered
+	require.NoError(t, registry.Track(2, nil).Start(ctx))
+	_, err = registry.Rounds(2)
+	require.ErrorIs(t, err, ErrDebugRecorderNotFound)
+
+	var nilRegistry *DebugRegistry
+	require.NoError(t, nilRegistry.Track(1, recorder).Start(ctx))
+	_, err = nilRegistry.Rounds(1)
+	require.ErrorIs(t, err, ErrDebugRecorderNotFound)
+}
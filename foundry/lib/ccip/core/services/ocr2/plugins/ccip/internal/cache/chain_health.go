@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -11,30 +13,50 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 )
 
 // ChainHealthcheck checks the health of the both source and destination chain.
 // Based on the values returned, CCIP can make a decision to stop or continue processing messages.
-// There are four things verified here:
+// There are four things verified by default:
 // 1. Source chain is healthy (this is verified by checking if source LogPoller saw finality violation)
 // 2. Dest chain is healthy (this is verified by checking if destination LogPoller saw finality violation)
 // 3. CommitStore is down (this is verified by checking if CommitStore is down and destination RMN is not cursed)
 // 4. Source chain is cursed (this is verified by checking if source RMN is not cursed)
 //
-// Whenever any of the above checks fail, the chain is considered unhealthy and the CCIP should stop
+// When the chain signals are provided, the head lag of the headtrackers and the replay status of the log pollers
+// are checked as well. Which of the checks are fatal is configured with ccipconfig.ChainHealthConfig, the results
+// of all of them are reported with their reasons through HealthReport.
+//
+// Whenever any of the fatal checks fail, the chain is considered unhealthy and the CCIP should stop
 // processing messages. Additionally, when the chain is unhealthy, this information is considered "sticky"
 // and is cached for a certain period of time based on defaultGlobalStatusExpirationDuration.
 // This may lead to some false-positives, but in this case we want to be extra cautious and avoid executing any reorged messages.
+// If the policy allows it, the sticky status can be cleared by an admin with ClearStickyUnhealthy.
 //
 // Additionally, to reduce the number of calls to the RPC, we refresh RMN state in the background based on defaultRMNStateRefreshInterval
 //
 //go:generate mockery --quiet --name ChainHealthcheck --filename chain_health_mock.go --case=underscore
 type ChainHealthcheck interface {
 	job.ServiceCtx
+	services.HealthReporter
 	IsHealthy(ctx context.Context) (bool, error)
+	// ClearStickyUnhealthy clears the sticky unhealthy status, it errors if the policy of the lane doesn't allow it.
+	ClearStickyUnhealthy() error
+}
+
+// ChainSignals are the signals of a chain checked besides the CCIP contracts, nil signals are not checked.
+type ChainSignals struct {
+	HeadTracker interface {
+		LatestChain() *evmtypes.Head
+	}
+	LogPoller interface {
+		ReplayInProgress() bool
+	}
 }
 
 const (
@@ -54,9 +76,19 @@ type chainHealthcheck struct {
 	globalStatusExpiration   time.Duration
 	rmnStatusRefreshInterval time.Duration
 
+	fatalChecks map[string]struct{}
+	maxHeadLag  time.Duration
+	clearable   bool
+
 	lggr        logger.Logger
 	onRamp      ccipdata.OnRampReader
 	commitStore ccipdata.CommitStoreReader
+	source      ChainSignals
+	dest        ChainSignals
+
+	// checks are the results of the latest checks, nil for the passing ones
+	checks   map[string]error
+	checksMu sync.RWMutex
 
 	services.StateMachine
 	wg               *sync.WaitGroup
@@ -64,42 +96,57 @@ type chainHealthcheck struct {
 	backgroundCancel context.CancelFunc
 }
 
-func NewChainHealthcheck(lggr logger.Logger, onRamp ccipdata.OnRampReader, commitStore ccipdata.CommitStoreReader) *chainHealthcheck {
-	ctx, cancel := context.WithCancel(context.Background())
-
-	ch := &chainHealthcheck{
-		// Different keys use different expiration times, so we don't need to worry about the default value
-		cache:                    cache.New(cache.NoExpiration, 0),
-		rmnStatusKey:             rmnStatusKey,
-		globalStatusKey:          globalStatusKey,
-		globalStatusExpiration:   defaultGlobalStatusExpirationDuration,
-		rmnStatusRefreshInterval: defaultRMNStateRefreshInterval,
-
-		lggr:        lggr,
-		onRamp:      onRamp,
-		commitStore: commitStore,
-
-		wg:               new(sync.WaitGroup),
-		backgroundCtx:    ctx,
-		backgroundCancel: cancel,
+func NewChainHealthcheck(
+	lggr logger.Logger,
+	onRamp ccipdata.OnRampReader,
+	commitStore ccipdata.CommitStoreReader,
+	cfg ccipconfig.ChainHealthConfig,
+	source ChainSignals,
+	dest ChainSignals,
+) *chainHealthcheck {
+	globalStatusExpiration := defaultGlobalStatusExpirationDuration
+	if cfg.StickyUnhealthySeconds > 0 {
+		globalStatusExpiration = time.Duration(cfg.StickyUnhealthySeconds) * time.Second
 	}
+
+	// Different keys use different expiration times, so we don't need to worry about the default value
+	ch := newChainHealthcheck(lggr, onRamp, commitStore, cfg, cache.New(cache.NoExpiration, 0), globalStatusExpiration, defaultRMNStateRefreshInterval)
+	ch.source = source
+	ch.dest = dest
 	return ch
 }
 
 // newChainHealthcheckWithCustomEviction is used for testing purposes only. It doesn't start background worker
 func newChainHealthcheckWithCustomEviction(lggr logger.Logger, onRamp ccipdata.OnRampReader, commitStore ccipdata.CommitStoreReader, globalStatusDuration time.Duration, rmnStatusRefreshInterval time.Duration) *chainHealthcheck {
+	return newChainHealthcheck(lggr, onRamp, commitStore, ccipconfig.ChainHealthConfig{}, cache.New(rmnStatusRefreshInterval, 0), globalStatusDuration, rmnStatusRefreshInterval)
+}
+
+func newChainHealthcheck(
+	lggr logger.Logger,
+	onRamp ccipdata.OnRampReader,
+	commitStore ccipdata.CommitStoreReader,
+	cfg ccipconfig.ChainHealthConfig,
+	c *cache.Cache,
+	globalStatusDuration time.Duration,
+	rmnStatusRefreshInterval time.Duration,
+) *chainHealthcheck {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &chainHealthcheck{
-		cache:                    cache.New(rmnStatusRefreshInterval, 0),
+		cache:                    c,
 		rmnStatusKey:             rmnStatusKey,
 		globalStatusKey:          globalStatusKey,
 		globalStatusExpiration:   globalStatusDuration,
 		rmnStatusRefreshInterval: rmnStatusRefreshInterval,
 
+		fatalChecks: cfg.Fatal(),
+		maxHeadLag:  time.Duration(cfg.MaxHeadLagSeconds) * time.Second,
+		clearable:   cfg.ClearableStickyUnhealthy,
+
 		lggr:        lggr,
 		onRamp:      onRamp,
 		commitStore: commitStore,
+		checks:      make(map[string]error),
 
 		wg:               new(sync.WaitGroup),
 		backgroundCtx:    ctx,
@@ -108,40 +155,64 @@ func newChainHealthcheckWithCustomEviction(lggr logger.Logger, onRamp ccipdata.O
 }
 
 type rmnResponse struct {
-	healthy bool
-	err     error
+	checks map[string]error
+	err    error
 }
 
 func (c *chainHealthcheck) IsHealthy(ctx context.Context) (bool, error) {
 	// Verify if flag is raised to indicate that the chain is not healthy
-	// If set to false then immediately return false without checking the chain
-	if cachedValue, found := c.cache.Get(c.globalStatusKey); found {
-		healthy, ok := cachedValue.(bool)
-		// If cached value is properly casted to bool and not healthy it means the sticky flag is raised
-		// and should be returned immediately
-		if !ok {
-			c.lggr.Criticalw("Failed to cast cached value to sticky healthcheck", "value", cachedValue)
-		} else if ok && !healthy {
-			return false, nil
-		}
+	// If set then immediately return false without checking the chain
+	if c.stickyUnhealthyReason() != nil {
+		return false, nil
 	}
 
 	// These checks are cheap and don't require any communication with the database or RPC
-	if healthy, err := c.checkIfReadersAreHealthy(ctx); err != nil {
+	readerChecks, err := c.checkIfReadersAreHealthy(ctx)
+	if err != nil {
 		return false, err
-	} else if !healthy {
-		c.markStickyStatusUnhealthy()
-		return healthy, nil
+	}
+	if !c.evaluate(readerChecks) {
+		return false, nil
 	}
 
 	// First call might initialize cache if it's not initialized yet. Otherwise, it will use the cached value
-	if healthy, err := c.checkIfRMNsAreHealthy(ctx); err != nil {
+	rmnChecks, err := c.checkIfRMNsAreHealthy(ctx)
+	if err != nil {
 		return false, err
-	} else if !healthy {
-		c.markStickyStatusUnhealthy()
-		return healthy, nil
 	}
-	return true, nil
+	return c.evaluate(rmnChecks), nil
+}
+
+// ClearStickyUnhealthy clears the sticky unhealthy status and the cached RMN state, so that the next IsHealthy call
+// checks the chains again.
+func (c *chainHealthcheck) ClearStickyUnhealthy() error {
+	if !c.clearable {
+		return ccipconfig.ErrStickyUnhealthyNotClearable
+	}
+	reason := c.stickyUnhealthyReason()
+	if reason == nil {
+		return nil
+	}
+	c.cache.Delete(c.globalStatusKey)
+	c.cache.Delete(c.rmnStatusKey)
+	c.lggr.Warnw("Sticky unhealthy status cleared by an admin", "reason", reason)
+	return nil
+}
+
+func (c *chainHealthcheck) Name() string { return c.lggr.Name() }
+
+// HealthReport reports the result of each check, keyed by the check name, and the sticky unhealthy status.
+func (c *chainHealthcheck) HealthReport() map[string]error {
+	report := map[string]error{c.Name(): c.Healthy()}
+
+	c.checksMu.RLock()
+	for check, err := range c.checks {
+		report[c.Name()+"."+check] = err
+	}
+	c.checksMu.RUnlock()
+
+	report[c.Name()+".sticky-unhealthy"] = c.stickyUnhealthyReason()
+	return report
 }
 
 func (c *chainHealthcheck) Start(context.Context) error {
@@ -183,46 +254,68 @@ func (c *chainHealthcheck) run() {
 	}()
 }
 
-func (c *chainHealthcheck) refresh(ctx context.Context) (bool, error) {
-	healthy, err := c.fetchRMNCurseState(ctx)
+func (c *chainHealthcheck) refresh(ctx context.Context) (map[string]error, error) {
+	checks, err := c.fetchRMNCurseState(ctx)
 	c.cache.Set(
 		c.rmnStatusKey,
-		rmnResponse{healthy, err},
+		rmnResponse{checks, err},
 		// Cache the value for 3 refresh intervals, this is just a defensive approach
 		// that will enforce the RMN state to be refreshed in case of bg worker hiccup (it should never happen)
 		3*c.rmnStatusRefreshInterval,
 	)
-	return healthy, err
+	return checks, err
 }
 
 // checkIfReadersAreHealthy checks if the source and destination chains are healthy by calling underlying LogPoller
-// These calls are cheap because they don't require any communication with the database or RPC, so we don't have
-// to cache the result of these calls.
-func (c *chainHealthcheck) checkIfReadersAreHealthy(ctx context.Context) (bool, error) {
+// and headtracker. These calls are cheap because they don't require any communication with the database or RPC,
+// so we don't have to cache the result of these calls.
+func (c *chainHealthcheck) checkIfReadersAreHealthy(ctx context.Context) (map[string]error, error) {
 	sourceChainHealthy, err := c.onRamp.IsSourceChainHealthy(ctx)
 	if err != nil {
-		return false, errors.Wrap(err, "onRamp IsSourceChainHealthy errored")
+		return nil, errors.Wrap(err, "onRamp IsSourceChainHealthy errored")
 	}
 
 	destChainHealthy, err := c.commitStore.IsDestChainHealthy(ctx)
 	if err != nil {
-		return false, errors.Wrap(err, "commitStore IsDestChainHealthy errored")
+		return nil, errors.Wrap(err, "commitStore IsDestChainHealthy errored")
+	}
+
+	checks := map[string]error{
+		ccipconfig.ChainHealthCheckSourceFinality: nil,
+		ccipconfig.ChainHealthCheckDestFinality:   nil,
+	}
+	if !sourceChainHealthy {
+		checks[ccipconfig.ChainHealthCheckSourceFinality] = errors.New("source log poller saw a finality violation")
+	}
+	if !destChainHealthy {
+		checks[ccipconfig.ChainHealthCheckDestFinality] = errors.New("destination log poller saw a finality violation")
 	}
+	c.checkSignals(checks, c.source, ccipconfig.ChainHealthCheckSourceHeadLag, ccipconfig.ChainHealthCheckSourceReplay)
+	c.checkSignals(checks, c.dest, ccipconfig.ChainHealthCheckDestHeadLag, ccipconfig.ChainHealthCheckDestReplay)
+	return checks, nil
+}
 
-	if !sourceChainHealthy || !destChainHealthy {
-		c.lggr.Criticalw(
-			"Lane processing is stopped because source or destination chain is reported unhealthy",
-			"sourceChainHealthy", sourceChainHealthy,
-			"destChainHealthy", destChainHealthy,
-		)
+func (c *chainHealthcheck) checkSignals(checks map[string]error, signals ChainSignals, headLagCheck, replayCheck string) {
+	if signals.HeadTracker != nil && c.maxHeadLag > 0 {
+		checks[headLagCheck] = nil
+		if head := signals.HeadTracker.LatestChain(); head == nil {
+			checks[headLagCheck] = errors.New("headtracker didn't see any head yet")
+		} else if lag := time.Since(head.Timestamp); lag > c.maxHeadLag {
+			checks[headLagCheck] = fmt.Errorf("latest head %d is %s old, more than the maximum of %s", head.Number, lag.Round(time.Second), c.maxHeadLag)
+		}
+	}
+	if signals.LogPoller != nil {
+		checks[replayCheck] = nil
+		if signals.LogPoller.ReplayInProgress() {
+			checks[replayCheck] = errors.New("log poller replay is in progress")
+		}
 	}
-	return sourceChainHealthy && destChainHealthy, nil
 }
 
-func (c *chainHealthcheck) checkIfRMNsAreHealthy(ctx context.Context) (bool, error) {
+func (c *chainHealthcheck) checkIfRMNsAreHealthy(ctx context.Context) (map[string]error, error) {
 	if cachedValue, found := c.cache.Get(c.rmnStatusKey); found {
 		rmn := cachedValue.(rmnResponse)
-		return rmn.healthy, rmn.err
+		return rmn.checks, rmn.err
 	}
 
 	// If the value is not found in the cache, fetch the RMN curse state in a sync manner for the first time
@@ -230,11 +323,64 @@ func (c *chainHealthcheck) checkIfRMNsAreHealthy(ctx context.Context) (bool, err
 	return c.refresh(ctx)
 }
 
-func (c *chainHealthcheck) markStickyStatusUnhealthy() {
-	c.cache.Set(c.globalStatusKey, false, c.globalStatusExpiration)
+// evaluate records the results of the checks and returns false if any of the fatal ones failed, raising the sticky
+// flag in that case. Failing checks which aren't fatal are only logged.
+func (c *chainHealthcheck) evaluate(checks map[string]error) bool {
+	c.checksMu.Lock()
+	defer c.checksMu.Unlock()
+
+	failing := make([]string, 0, len(checks))
+	for check, err := range checks {
+		if err != nil {
+			failing = append(failing, check)
+		}
+	}
+	sort.Strings(failing)
+
+	var fatal []string
+	for _, check := range failing {
+		if _, ok := c.fatalChecks[check]; ok {
+			fatal = append(fatal, check)
+		} else if c.checks[check] == nil {
+			c.lggr.Warnw("Chain health check is failing, lane processing continues because the check isn't fatal",
+				"check", check, "reason", checks[check])
+		}
+	}
+	for check, err := range checks {
+		c.checks[check] = err
+	}
+
+	if len(fatal) == 0 {
+		return true
+	}
+	reasons := make([]any, 0, 2*len(fatal))
+	for _, check := range fatal {
+		reasons = append(reasons, check, checks[check])
+	}
+	c.lggr.Criticalw("Lane processing is stopped because fatal chain health checks are failing", reasons...)
+	c.markStickyStatusUnhealthy(fmt.Errorf("%s: %w", fatal[0], checks[fatal[0]]))
+	return false
 }
 
-func (c *chainHealthcheck) fetchRMNCurseState(ctx context.Context) (bool, error) {
+func (c *chainHealthcheck) markStickyStatusUnhealthy(reason error) {
+	c.cache.Set(c.globalStatusKey, reason, c.globalStatusExpiration)
+}
+
+// stickyUnhealthyReason returns the reason of the sticky unhealthy status, nil if the sticky flag isn't raised.
+func (c *chainHealthcheck) stickyUnhealthyReason() error {
+	cachedValue, found := c.cache.Get(c.globalStatusKey)
+	if !found {
+		return nil
+	}
+	reason, ok := cachedValue.(error)
+	if !ok {
+		c.lggr.Criticalw("Failed to cast cached value to sticky healthcheck", "value", cachedValue)
+		return nil
+	}
+	return reason
+}
+
+func (c *chainHealthcheck) fetchRMNCurseState(ctx context.Context) (map[string]error, error) {
 	var (
 		eg                = new(errgroup.Group)
 		isCommitStoreDown bool
@@ -260,16 +406,18 @@ func (c *chainHealthcheck) fetchRMNCurseState(ctx context.Context) (bool, error)
 	})
 
 	if err := eg.Wait(); err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if isCommitStoreDown || isSourceCursed {
-		c.lggr.Criticalw(
-			"Lane processing is stopped because source chain is cursed or CommitStore is down",
-			"isCommitStoreDown", isCommitStoreDown,
-			"isSourceCursed", isSourceCursed,
-		)
-		return false, nil
+	checks := map[string]error{
+		ccipconfig.ChainHealthCheckCommitStoreDown: nil,
+		ccipconfig.ChainHealthCheckSourceCursed:    nil,
+	}
+	if isCommitStoreDown {
+		checks[ccipconfig.ChainHealthCheckCommitStoreDown] = errors.New("commit store is down or destination RMN is cursed")
+	}
+	if isSourceCursed {
+		checks[ccipconfig.ChainHealthCheckSourceCursed] = errors.New("source RMN is cursed")
 	}
-	return true, nil
+	return checks, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -14,8 +15,10 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
 )
 
@@ -238,6 +241,180 @@ func Test_RefreshingInBackground(t *testing.T) {
 	require.NoError(t, chainState.Close())
 }
 
+func Test_ChainHealthPolicy(t *testing.T) {
+	staleHead := &evmtypes.Head{Number: 100, Timestamp: time.Now().Add(-time.Hour)}
+	freshHead := &evmtypes.Head{Number: 200, Timestamp: time.Now()}
+
+	testCases := []struct {
+		name         string
+		cfg          ccipconfig.ChainHealthConfig
+		sourceCursed bool
+		sourceHead   *evmtypes.Head
+		destReplay   bool
+
+		expectedState  bool
+		expectedFailed []string
+	}{
+		{
+			name:          "all checks pass",
+			cfg:           ccipconfig.ChainHealthConfig{MaxHeadLagSeconds: 60},
+			sourceHead:    freshHead,
+			expectedState: true,
+		},
+		{
+			name:           "cursed source is fatal by default",
+			sourceCursed:   true,
+			sourceHead:     freshHead,
+			expectedState:  false,
+			expectedFailed: []string{ccipconfig.ChainHealthCheckSourceCursed},
+		},
+		{
+			name:           "cursed source is only reported when not fatal",
+			cfg:            ccipconfig.ChainHealthConfig{FatalChecks: []string{ccipconfig.ChainHealthCheckSourceFinality}},
+			sourceCursed:   true,
+			sourceHead:     freshHead,
+			expectedState:  true,
+			expectedFailed: []string{ccipconfig.ChainHealthCheckSourceCursed},
+		},
+		{
+			name:           "head lag isn't fatal by default",
+			cfg:            ccipconfig.ChainHealthConfig{MaxHeadLagSeconds: 60},
+			sourceHead:     staleHead,
+			expectedState:  true,
+			expectedFailed: []string{ccipconfig.ChainHealthCheckSourceHeadLag},
+		},
+		{
+			name: "fatal head lag",
+			cfg: ccipconfig.ChainHealthConfig{
+				FatalChecks:       []string{ccipconfig.ChainHealthCheckSourceHeadLag},
+				MaxHeadLagSeconds: 60,
+			},
+			sourceHead:     staleHead,
+			expectedState:  false,
+			expectedFailed: []string{ccipconfig.ChainHealthCheckSourceHeadLag},
+		},
+		{
+			name: "missing head with fatal head lag",
+			cfg: ccipconfig.ChainHealthConfig{
+				FatalChecks:       []string{ccipconfig.ChainHealthCheckSourceHeadLag},
+				MaxHeadLagSeconds: 60,
+			},
+			expectedState:  false,
+			expectedFailed: []string{ccipconfig.ChainHealthCheckSourceHeadLag},
+		},
+		{
+			name:           "replay isn't fatal by default",
+			sourceHead:     freshHead,
+			destReplay:     true,
+			expectedState:  true,
+			expectedFailed: []string{ccipconfig.ChainHealthCheckDestReplay},
+		},
+		{
+			name:           "fatal replay",
+			cfg:            ccipconfig.ChainHealthConfig{FatalChecks: []string{ccipconfig.ChainHealthCheckDestReplay}},
+			sourceHead:     freshHead,
+			destReplay:     true,
+			expectedState:  false,
+			expectedFailed: []string{ccipconfig.ChainHealthCheckDestReplay},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+			mockCommitStore := mocks.NewCommitStoreReader(t)
+			mockOnRamp := mocks.NewOnRampReader(t)
+			mockCommitStore.On("IsDown", ctx).Return(false, nil).Maybe()
+			mockCommitStore.On("IsDestChainHealthy", ctx).Return(true, nil).Maybe()
+			mockOnRamp.On("IsSourceCursed", ctx).Return(tc.sourceCursed, nil).Maybe()
+			mockOnRamp.On("IsSourceChainHealthy", ctx).Return(true, nil).Maybe()
+
+			chainState := NewChainHealthcheck(
+				logger.TestLogger(t).Named("ChainHealthcheck"),
+				mockOnRamp,
+				mockCommitStore,
+				tc.cfg,
+				ChainSignals{HeadTracker: &fakeHeadTracker{head: tc.sourceHead}, LogPoller: &fakeReplayStatus{}},
+				ChainSignals{LogPoller: &fakeReplayStatus{replaying: tc.destReplay}},
+			)
+
+			healthy, err := chainState.IsHealthy(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, healthy)
+
+			report := chainState.HealthReport()
+			var failed []string
+			for name, checkErr := range report {
+				if checkErr != nil && name != chainState.Name() && name != chainState.Name()+".sticky-unhealthy" {
+					failed = append(failed, strings.TrimPrefix(name, chainState.Name()+"."))
+				}
+			}
+			assert.ElementsMatch(t, tc.expectedFailed, failed)
+			if tc.expectedState {
+				assert.NoError(t, report[chainState.Name()+".sticky-unhealthy"])
+			} else {
+				assert.ErrorContains(t, report[chainState.Name()+".sticky-unhealthy"], tc.expectedFailed[0])
+			}
+			assert.NotContains(t, report, chainState.Name()+"."+ccipconfig.ChainHealthCheckDestHeadLag)
+		})
+	}
+}
+
+func Test_ClearStickyUnhealthy(t *testing.T) {
+	for _, clearable := range []bool{false, true} {
+		t.Run(fmt.Sprintf("clearable=%t", clearable), func(t *testing.T) {
+			ctx := tests.Context(t)
+			mockCommitStore := mocks.NewCommitStoreReader(t)
+			mockOnRamp := mocks.NewOnRampReader(t)
+			mockCommitStore.On("IsDown", ctx).Return(false, nil).Maybe()
+			mockCommitStore.On("IsDestChainHealthy", ctx).Return(true, nil).Maybe()
+			mockOnRamp.On("IsSourceChainHealthy", ctx).Return(true, nil).Maybe()
+			mockOnRamp.On("IsSourceCursed", ctx).Return(true, nil).Once()
+			mockOnRamp.On("IsSourceCursed", ctx).Return(false, nil).Maybe()
+
+			chainState := NewChainHealthcheck(
+				logger.TestLogger(t),
+				mockOnRamp,
+				mockCommitStore,
+				ccipconfig.ChainHealthConfig{ClearableStickyUnhealthy: clearable},
+				ChainSignals{},
+				ChainSignals{},
+			)
+
+			healthy, err := chainState.IsHealthy(ctx)
+			require.NoError(t, err)
+			require.False(t, healthy)
+
+			err = chainState.ClearStickyUnhealthy()
+			healthy, err2 := chainState.IsHealthy(ctx)
+			require.NoError(t, err2)
+			if clearable {
+				require.NoError(t, err)
+				assert.True(t, healthy)
+			} else {
+				require.ErrorIs(t, err, ccipconfig.ErrStickyUnhealthyNotClearable)
+				assert.False(t, healthy)
+			}
+		})
+	}
+}
+
+type fakeHeadTracker struct {
+	head *evmtypes.Head
+}
+
+func (f *fakeHeadTracker) LatestChain() *evmtypes.Head {
+	return f.head
+}
+
+type fakeReplayStatus struct {
+	replaying bool
+}
+
+func (f *fakeReplayStatus) ReplayInProgress() bool {
+	return f.replaying
+}
+
 func assertHealthy(t *testing.T, ch *chainHealthcheck, expected bool) {
 	assert.Eventually(t, func() bool {
 		healthy, err := ch.IsHealthy(testutils.Context(t))
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_0_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
 	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
@@ -114,6 +116,34 @@ func ExecReportToEthTxMeta(ctx context.Context, typ ccipconfig.ContractType, ver
 	}
 }
 
+// ManuallyExecuteCalldata returns the calldata of the manuallyExecute call of an offRamp of the given version,
+// for the given encoded execution report and gas limit overrides.
+func ManuallyExecuteCalldata(typ ccipconfig.ContractType, ver semver.Version, encodedReport []byte, gasLimitOverrides []*big.Int) ([]byte, error) {
+	if typ != ccipconfig.EVM2EVMOffRamp {
+		return nil, errors.Errorf("expected %v got %v", ccipconfig.EVM2EVMOffRamp, typ)
+	}
+	var offRampABI abi.ABI
+	switch ver.String() {
+	case ccipdata.V1_0_0, ccipdata.V1_1_0:
+		offRampABI = abihelpers.MustParseABI(evm_2_evm_offramp_1_0_0.EVM2EVMOffRampABI)
+	case ccipdata.V1_2_0:
+		offRampABI = abihelpers.MustParseABI(evm_2_evm_offramp_1_2_0.EVM2EVMOffRampABI)
+	case ccipdata.V1_5_0:
+		offRampABI = abihelpers.MustParseABI(evm_2_evm_offramp.EVM2EVMOffRampABI)
+	default:
+		return nil, errors.Errorf("got unexpected version %v", ver.String())
+	}
+
+	report, err := abihelpers.MustGetMethodInputs(ccipdata.ManuallyExecute, offRampABI)[:1].Unpack(encodedReport)
+	if err != nil {
+		return nil, errors.Wrap(err, "unpack execution report")
+	}
+	if len(report) == 0 {
+		return nil, errors.New("assumptionViolation: expected at least one element")
+	}
+	return offRampABI.Pack(ccipdata.ManuallyExecute, report[0], gasLimitOverrides)
+}
+
 func execReportToEthTxMeta(execReport cciptypes.ExecReport) (*txmgr.TxMeta, error) {
 	msgIDs := make([]string, len(execReport.Messages))
 	for i, msg := range execReport.Messages {
@@ -1,21 +1,29 @@
 package factory
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	mocks2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
 	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_0_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
 )
 
 func TestOffRamp(t *testing.T) {
@@ -42,3 +50,52 @@ func TestOffRamp(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestManuallyExecuteCalldata(t *testing.T) {
+	ctx := testutils.Context(t)
+	report := cciptypes.ExecReport{
+		Messages: []cciptypes.EVM2EVMMessage{{
+			SequenceNumber: 10,
+			GasLimit:       big.NewInt(200_000),
+			Sender:         cciptypes.Address(utils.RandomAddress().String()),
+			Receiver:       cciptypes.Address(utils.RandomAddress().String()),
+			FeeToken:       cciptypes.Address(utils.RandomAddress().String()),
+			FeeTokenAmount: big.NewInt(1),
+			MessageID:      cciptypes.Hash{1},
+		}},
+		OffchainTokenData: [][][]byte{{}},
+		Proofs:            [][32]byte{{2}},
+		ProofFlagBits:     big.NewInt(0),
+	}
+	gasLimitOverrides := []*big.Int{big.NewInt(500_000)}
+
+	for versionStr, abiStr := range map[string]string{
+		ccipdata.V1_2_0: evm_2_evm_offramp_1_2_0.EVM2EVMOffRampABI,
+		ccipdata.V1_5_0: evm_2_evm_offramp.EVM2EVMOffRampABI,
+	} {
+		offRampABI := abihelpers.MustParseABI(abiStr)
+		args := abihelpers.MustGetMethodInputs(ccipdata.ManuallyExecute, offRampABI)[:1]
+		encodedReport, err := v1_2_0.EncodeExecutionReport(ctx, args, report)
+		require.NoError(t, err)
+
+		calldata, err := ManuallyExecuteCalldata(ccipconfig.EVM2EVMOffRamp, *semver.MustParse(versionStr), encodedReport, gasLimitOverrides)
+		require.NoError(t, err)
+
+		method := offRampABI.Methods[ccipdata.ManuallyExecute]
+		require.Equal(t, method.ID, calldata[:4])
+		unpacked, err := method.Inputs.Unpack(calldata[4:])
+		require.NoError(t, err)
+		decodedReport, err := abi.Arguments{args[0]}.Pack(unpacked[0])
+		require.NoError(t, err)
+		decoded, err := v1_2_0.DecodeExecReport(ctx, args, decodedReport)
+		require.NoError(t, err)
+		assert.Equal(t, report.Messages[0].MessageID, decoded.Messages[0].MessageID)
+		assert.Equal(t, report.Proofs, decoded.Proofs)
+		assert.Equal(t, gasLimitOverrides, unpacked[1])
+	}
+
+	_, err := ManuallyExecuteCalldata(ccipconfig.CommitStore, *semver.MustParse(ccipdata.V1_2_0), nil, nil)
+	assert.Error(t, err)
+	_, err = ManuallyExecuteCalldata(ccipconfig.EVM2EVMOffRamp, *semver.MustParse("0.0.1"), nil, nil)
+	assert.Error(t, err)
+}
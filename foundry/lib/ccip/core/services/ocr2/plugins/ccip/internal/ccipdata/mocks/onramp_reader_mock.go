@@ -119,6 +119,36 @@ func (_m *OnRampReader) GetSendRequestsBetweenSeqNums(ctx context.Context, seqNu
 	return r0, r1
 }
 
+// GetSendRequestsByTxHash provides a mock function with given fields: ctx, txHash
+func (_m *OnRampReader) GetSendRequestsByTxHash(ctx context.Context, txHash ccip.Hash) ([]ccip.EVM2EVMMessageWithTxMeta, error) {
+	ret := _m.Called(ctx, txHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSendRequestsByTxHash")
+	}
+
+	var r0 []ccip.EVM2EVMMessageWithTxMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ccip.Hash) ([]ccip.EVM2EVMMessageWithTxMeta, error)); ok {
+		return rf(ctx, txHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ccip.Hash) []ccip.EVM2EVMMessageWithTxMeta); ok {
+		r0 = rf(ctx, txHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.EVM2EVMMessageWithTxMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ccip.Hash) error); ok {
+		r1 = rf(ctx, txHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsSourceChainHealthy provides a mock function with given fields: ctx
 func (_m *OnRampReader) IsSourceChainHealthy(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -1,6 +1,8 @@
 package ccipdata
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/core/types"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
@@ -19,4 +21,7 @@ const (
 //go:generate mockery --quiet --name OnRampReader --filename onramp_reader_mock.go --case=underscore
 type OnRampReader interface {
 	cciptypes.OnRampReader
+	//TODO Move to chainlink-common
+	// GetSendRequestsByTxHash returns the send requests of the given source chain transaction.
+	GetSendRequestsByTxHash(ctx context.Context, txHash cciptypes.Hash) ([]cciptypes.EVM2EVMMessageWithTxMeta, error)
 }
@@ -0,0 +1,101 @@
+package ccipdata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+const (
+	TOKEN_EVENT_FILTER_NAME = "Token data event"
+)
+
+var _ TokenEventReader = &TokenEventReaderImpl{}
+
+// TokenEventReader reads the source chain events that offchain token data providers attest to.
+//
+//go:generate mockery --quiet --name TokenEventReader --filename token_event_reader_mock.go --case=underscore
+type TokenEventReader interface {
+	// GetEventDataPriorToLogIndexInTx returns the data of the specified event emitted before logIndex.
+	// It follows the same selection rules as USDCReader.GetUSDCMessagePriorToLogIndexInTx,
+	// if tokenIndexOffset is 0 the last event before logIndex is selected.
+	GetEventDataPriorToLogIndexInTx(ctx context.Context, logIndex int64, tokenIndexOffset int, txHash string) ([]byte, error)
+}
+
+type TokenEventReaderImpl struct {
+	eventSig common.Hash
+	address  common.Address
+	lp       logpoller.LogPoller
+	filter   logpoller.Filter
+	lggr     logger.Logger
+}
+
+func (r *TokenEventReaderImpl) Close(qopts ...pg.QOpt) error {
+	return r.lp.UnregisterFilter(context.Background(), r.filter.Name)
+}
+
+func (r *TokenEventReaderImpl) RegisterFilters(qopts ...pg.QOpt) error {
+	return r.lp.RegisterFilter(context.Background(), r.filter)
+}
+
+func (r *TokenEventReaderImpl) GetEventDataPriorToLogIndexInTx(ctx context.Context, logIndex int64, tokenIndexOffset int, txHash string) ([]byte, error) {
+	logs, err := r.lp.IndexedLogsByTxHash(ctx, r.eventSig, r.address, common.HexToHash(txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	eventsData := make([][]byte, 0)
+	for _, current := range logs {
+		if current.LogIndex < logIndex {
+			eventsData = append(eventsData, current.Data)
+		}
+	}
+
+	eventIndex := (len(eventsData) - 1) - tokenIndexOffset
+	if eventIndex < 0 || eventIndex >= len(eventsData) {
+		r.lggr.Errorw("token event not found",
+			"logIndex", logIndex,
+			"eventsData", len(eventsData),
+			"txHash", txHash,
+			"eventIndex", eventIndex,
+		)
+		return nil, errors.Errorf("token event index %d is not valid", eventIndex)
+	}
+	return eventsData[eventIndex], nil
+}
+
+func NewTokenEventReader(lggr logger.Logger, jobID string, eventSig common.Hash, address common.Address, lp logpoller.LogPoller, registerFilters bool, qopts ...pg.QOpt) (*TokenEventReaderImpl, error) {
+	r := &TokenEventReaderImpl{
+		eventSig: eventSig,
+		address:  address,
+		lp:       lp,
+		lggr:     lggr,
+		filter: logpoller.Filter{
+			Name:      logpoller.FilterName(TOKEN_EVENT_FILTER_NAME, jobID, eventSig.Hex(), address.Hex()),
+			EventSigs: []common.Hash{eventSig},
+			Addresses: []common.Address{address},
+			Retention: CommitExecLogsRetention,
+		},
+	}
+
+	if registerFilters {
+		if err := r.RegisterFilters(qopts...); err != nil {
+			return nil, fmt.Errorf("register filters: %w", err)
+		}
+	}
+	return r, nil
+}
+
+func CloseTokenEventReader(lggr logger.Logger, jobID string, eventSig common.Hash, address common.Address, lp logpoller.LogPoller, qopts ...pg.QOpt) error {
+	r, err := NewTokenEventReader(lggr, jobID, eventSig, address, lp, false)
+	if err != nil {
+		return err
+	}
+	return r.Close(qopts...)
+}
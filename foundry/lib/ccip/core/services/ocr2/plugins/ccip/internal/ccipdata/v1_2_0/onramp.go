@@ -174,6 +174,27 @@ func (o *OnRamp) GetSendRequestsBetweenSeqNums(ctx context.Context, seqNumMin, s
 	return res, nil
 }
 
+func (o *OnRamp) GetSendRequestsByTxHash(ctx context.Context, txHash cciptypes.Hash) ([]cciptypes.EVM2EVMMessageWithTxMeta, error) {
+	logs, err := o.lp.IndexedLogsByTxHash(ctx, o.sendRequestedEventSig, o.address, common.Hash(txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	parsedLogs, err := ccipdata.ParseLogs[cciptypes.EVM2EVMMessage](logs, o.lggr, o.logToMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]cciptypes.EVM2EVMMessageWithTxMeta, 0, len(parsedLogs))
+	for _, log := range parsedLogs {
+		res = append(res, cciptypes.EVM2EVMMessageWithTxMeta{
+			TxMeta:         log.TxMeta,
+			EVM2EVMMessage: log.Data,
+		})
+	}
+	return res, nil
+}
+
 func (o *OnRamp) RouterAddress(context.Context) (cciptypes.Address, error) {
 	config, err := o.onRamp.GetDynamicConfig(nil)
 	if err != nil {
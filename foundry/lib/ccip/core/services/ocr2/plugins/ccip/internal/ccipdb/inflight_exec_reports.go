@@ -0,0 +1,99 @@
+package ccipdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// InflightExecReport is an execution report accepted by the execution plugin whose transmission isn't reflected
+// onchain yet. Reports are persisted so that a restarted node doesn't observe their messages again.
+type InflightExecReport struct {
+	Messages  []cciptypes.EVM2EVMMessage
+	CreatedAt time.Time
+}
+
+//go:generate mockery --quiet --name InflightExecReportsORM --output ./mocks/ --case=underscore
+
+type InflightExecReportsORM interface {
+	// InsertReport stores the inflight report of the given messages of the lane, keyed by their first sequence number.
+	InsertReport(ctx context.Context, destChainSelector uint64, offRamp string, report InflightExecReport) error
+	// GetReports returns the inflight reports of the lane created after the given time, oldest first.
+	GetReports(ctx context.Context, destChainSelector uint64, offRamp string, createdAfter time.Time) ([]InflightExecReport, error)
+	// DeleteReports deletes the inflight reports of the lane created before the given time.
+	DeleteReports(ctx context.Context, destChainSelector uint64, offRamp string, createdBefore time.Time) error
+}
+
+type inflightExecReportsORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ InflightExecReportsORM = (*inflightExecReportsORM)(nil)
+
+func NewInflightExecReportsORM(ds sqlutil.DataSource) InflightExecReportsORM {
+	return &inflightExecReportsORM{ds: ds}
+}
+
+type inflightExecReportRow struct {
+	Messages  []byte    `db:"messages"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (o *inflightExecReportsORM) InsertReport(ctx context.Context, destChainSelector uint64, offRamp string, report InflightExecReport) error {
+	if len(report.Messages) == 0 {
+		return fmt.Errorf("inflight report has no messages")
+	}
+	messages, err := json.Marshal(report.Messages)
+	if err != nil {
+		return fmt.Errorf("failed to encode inflight report messages: %w", err)
+	}
+	_, err = o.ds.ExecContext(ctx, `
+INSERT INTO ccip_inflight_exec_reports (dest_chain_selector, off_ramp, min_sequence_number, messages, created_at)
+VALUES ($1::numeric, $2, $3, $4, $5)
+ON CONFLICT (dest_chain_selector, off_ramp, min_sequence_number) DO UPDATE SET
+	messages = EXCLUDED.messages,
+	created_at = EXCLUDED.created_at`,
+		strconv.FormatUint(destChainSelector, 10), offRamp, report.Messages[0].SequenceNumber, messages, report.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert inflight report %d: %w", report.Messages[0].SequenceNumber, err)
+	}
+	return nil
+}
+
+func (o *inflightExecReportsORM) GetReports(ctx context.Context, destChainSelector uint64, offRamp string, createdAfter time.Time) ([]InflightExecReport, error) {
+	var rows []inflightExecReportRow
+	err := o.ds.SelectContext(ctx, &rows, `
+SELECT messages, created_at FROM ccip_inflight_exec_reports
+WHERE dest_chain_selector = $1::numeric AND off_ramp = $2 AND created_at > $3
+ORDER BY created_at, min_sequence_number`,
+		strconv.FormatUint(destChainSelector, 10), offRamp, createdAfter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inflight reports: %w", err)
+	}
+
+	reports := make([]InflightExecReport, 0, len(rows))
+	for _, row := range rows {
+		report := InflightExecReport{CreatedAt: row.CreatedAt}
+		if err := json.Unmarshal(row.Messages, &report.Messages); err != nil {
+			return nil, fmt.Errorf("failed to decode inflight report messages: %w", err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (o *inflightExecReportsORM) DeleteReports(ctx context.Context, destChainSelector uint64, offRamp string, createdBefore time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `
+DELETE FROM ccip_inflight_exec_reports
+WHERE dest_chain_selector = $1::numeric AND off_ramp = $2 AND created_at <= $3`,
+		strconv.FormatUint(destChainSelector, 10), offRamp, createdBefore)
+	if err != nil {
+		return fmt.Errorf("failed to delete inflight reports: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package ccipdb_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+)
+
+func TestInflightExecReportsORM(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm := ccipdb.NewInflightExecReportsORM(pgtest.NewSqlxDB(t))
+
+	destChainSelector := uint64(16015286601757825753)
+	offRamp := "0x2222222222222222222222222222222222222222"
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	old := ccipdb.InflightExecReport{
+		Messages:  []cciptypes.EVM2EVMMessage{{SequenceNumber: 1, GasLimit: big.NewInt(200_000), Sender: "0x3333333333333333333333333333333333333333"}},
+		CreatedAt: now.Add(-time.Hour),
+	}
+	recent := ccipdb.InflightExecReport{
+		Messages: []cciptypes.EVM2EVMMessage{
+			{SequenceNumber: 2, TokenAmounts: []cciptypes.TokenAmount{{Token: "0x4444444444444444444444444444444444444444", Amount: big.NewInt(10)}}},
+			{SequenceNumber: 3, Data: []byte("data")},
+		},
+		CreatedAt: now,
+	}
+	require.NoError(t, orm.InsertReport(ctx, destChainSelector, offRamp, old))
+	require.NoError(t, orm.InsertReport(ctx, destChainSelector, offRamp, recent))
+	require.NoError(t, orm.InsertReport(ctx, destChainSelector+1, offRamp, recent))
+	require.Error(t, orm.InsertReport(ctx, destChainSelector, offRamp, ccipdb.InflightExecReport{CreatedAt: now}))
+
+	reports, err := orm.GetReports(ctx, destChainSelector, offRamp, now.Add(-2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, old.Messages, reports[0].Messages)
+	assert.Equal(t, recent.Messages, reports[1].Messages)
+	assert.True(t, recent.CreatedAt.Equal(reports[1].CreatedAt))
+
+	reports, err = orm.GetReports(ctx, destChainSelector, offRamp, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+
+	require.NoError(t, orm.DeleteReports(ctx, destChainSelector, offRamp, now.Add(-time.Minute)))
+	reports, err = orm.GetReports(ctx, destChainSelector, offRamp, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, recent.Messages, reports[0].Messages)
+
+	// The reports of other lanes are left alone.
+	reports, err = orm.GetReports(ctx, destChainSelector+1, offRamp, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+}
@@ -32,6 +32,12 @@ func (o ObservedOnRampReader) GetSendRequestsBetweenSeqNums(ctx context.Context,
 	})
 }
 
+func (o ObservedOnRampReader) GetSendRequestsByTxHash(ctx context.Context, txHash cciptypes.Hash) ([]cciptypes.EVM2EVMMessageWithTxMeta, error) {
+	return withObservedInteractionAndResults(o.metric, "GetSendRequestsByTxHash", func() ([]cciptypes.EVM2EVMMessageWithTxMeta, error) {
+		return o.OnRampReader.GetSendRequestsByTxHash(ctx, txHash)
+	})
+}
+
 func (o ObservedOnRampReader) RouterAddress(ctx context.Context) (cciptypes.Address, error) {
 	return withObservedInteraction(o.metric, "RouterAddress", func() (cciptypes.Address, error) {
 		return o.OnRampReader.RouterAddress(ctx)
@@ -5,13 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/erc20"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/gethwrappers2/generated/offchainaggregator"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
@@ -20,6 +25,18 @@ import (
 
 const latestRoundDataMethodName = "latestRoundData"
 
+// Reasons of an aggregator answer rejection.
+const (
+	rejectReasonStale    = "stale"
+	rejectReasonBelowMin = "belowMin"
+	rejectReasonAboveMax = "aboveMax"
+)
+
+var rejectedAnswers = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ccip_price_getter_rejected_answers",
+	Help: "Number of aggregator answers rejected because they are stale or out of the configured price bounds",
+}, []string{"chainID", "token", "reason"})
+
 func init() {
 	// Ensure existence of latestRoundData method on the Aggregator contract.
 	aggregatorABI, err := abi.JSON(strings.NewReader(offchainaggregator.OffchainAggregatorABI))
@@ -41,10 +58,14 @@ func NewDynamicPriceGetterClient(batchCaller rpclib.EvmBatchCaller) DynamicPrice
 	}
 }
 
+var _ TokenPriceSkipper = (*DynamicPriceGetter)(nil)
+
 type DynamicPriceGetter struct {
 	cfg           config.DynamicPriceGetterConfig
 	evmClients    map[uint64]DynamicPriceGetterClient
 	aggregatorAbi abi.ABI
+	poolAbi       abi.ABI
+	erc20Abi      abi.ABI
 }
 
 func NewDynamicPriceGetterConfig(configJson string) (config.DynamicPriceGetterConfig, error) {
@@ -70,10 +91,28 @@ func NewDynamicPriceGetter(cfg config.DynamicPriceGetterConfig, evmClients map[u
 	if err != nil {
 		return nil, fmt.Errorf("parse offchainaggregator abi: %w", err)
 	}
-	priceGetter := DynamicPriceGetter{cfg, evmClients, aggregatorAbi}
+	poolAbi, err := abi.JSON(strings.NewReader(uniswapV3PoolABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse uniswap v3 pool abi: %w", err)
+	}
+	erc20Abi, err := abi.JSON(strings.NewReader(erc20.ERC20ABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse erc20 abi: %w", err)
+	}
+	priceGetter := DynamicPriceGetter{cfg, evmClients, aggregatorAbi, poolAbi, erc20Abi}
 	return &priceGetter, nil
 }
 
+// SkipsRejectedPrices implements the TokenPriceSkipper interface.
+func (d *DynamicPriceGetter) SkipsRejectedPrices() bool {
+	for _, aggCfg := range d.cfg.AggregatorPrices {
+		if aggCfg.FallbackOrDefault() == config.AggregatorPriceFallbackSkip {
+			return true
+		}
+	}
+	return false
+}
+
 // FilterForConfiguredTokens implements the PriceGetter interface.
 // It filters a list of token addresses for only those that have a price resolution rule configured on the PriceGetterConfig
 func (d *DynamicPriceGetter) FilterConfiguredTokens(ctx context.Context, tokens []cciptypes.Address) (configured []cciptypes.Address, unconfigured []cciptypes.Address, err error) {
@@ -89,6 +128,8 @@ func (d *DynamicPriceGetter) FilterConfiguredTokens(ctx context.Context, tokens
 			configured = append(configured, tk)
 		} else if _, isStatic := d.cfg.StaticPrices[evmAddr]; isStatic {
 			configured = append(configured, tk)
+		} else if _, isTWAP := d.cfg.UniswapV3TWAPPrices[evmAddr]; isTWAP {
+			configured = append(configured, tk)
 		} else {
 			unconfigured = append(unconfigured, tk)
 		}
@@ -97,37 +138,68 @@ func (d *DynamicPriceGetter) FilterConfiguredTokens(ctx context.Context, tokens
 }
 
 // TokenPricesUSD implements the PriceGetter interface.
-// It returns static prices stored in the price getter, and batch calls to aggregators (on per chain) for aggregator-based prices.
+// It returns static prices stored in the price getter, and batch calls to aggregators and pools (one per chain) for
+// aggregator-based and TWAP-based prices.
 func (d *DynamicPriceGetter) TokenPricesUSD(ctx context.Context, tokens []cciptypes.Address) (map[cciptypes.Address]*big.Int, error) {
 	prices := make(map[cciptypes.Address]*big.Int, len(tokens))
 
-	batchCallsPerChain := make(map[uint64][]rpclib.EvmCall)
-
-	// required to maintain the order of the batched rpc calls for mapping the results
-	batchCallsTokensOrder := make(map[uint64][]common.Address)
-
 	evmAddrs, err := ccipcalc.GenericAddrsToEvm(tokens...)
 	if err != nil {
 		return nil, err
 	}
 
+	// Aggregator answers are also read for the quote tokens of the TWAP prices, even if not requested.
+	var aggTokens, twapTokens []common.Address
+	aggTokensSet := make(map[common.Address]struct{})
+	addAggToken := func(tk common.Address) {
+		if _, exists := aggTokensSet[tk]; !exists {
+			aggTokensSet[tk] = struct{}{}
+			aggTokens = append(aggTokens, tk)
+		}
+	}
 	for _, tk := range evmAddrs {
-		if aggCfg, isAgg := d.cfg.AggregatorPrices[tk]; isAgg {
-			// Batch calls for aggregator-based token prices (one per chain).
-			batchCallsPerChain[aggCfg.ChainID] = append(batchCallsPerChain[aggCfg.ChainID], rpclib.NewEvmCall(
-				d.aggregatorAbi,
-				latestRoundDataMethodName,
-				aggCfg.AggregatorContractAddress,
-			))
-			batchCallsTokensOrder[aggCfg.ChainID] = append(batchCallsTokensOrder[aggCfg.ChainID], tk)
+		if _, isAgg := d.cfg.AggregatorPrices[tk]; isAgg {
+			addAggToken(tk)
 		} else if staticCfg, isStatic := d.cfg.StaticPrices[tk]; isStatic {
 			// Fill static prices.
 			prices[ccipcalc.EvmAddrToGeneric(tk)] = staticCfg.Price
+		} else if twapCfg, isTWAP := d.cfg.UniswapV3TWAPPrices[tk]; isTWAP {
+			twapTokens = append(twapTokens, tk)
+			if _, isAggQuote := d.cfg.AggregatorPrices[twapCfg.QuoteToken]; isAggQuote {
+				addAggToken(twapCfg.QuoteToken)
+			}
 		} else {
 			return nil, fmt.Errorf("no price resolution rule for token %s", tk.Hex())
 		}
 	}
 
+	// Batch calls for aggregator-based token prices (one per chain), followed by the TWAP ones.
+	batchCallsPerChain := make(map[uint64][]rpclib.EvmCall)
+	// required to maintain the order of the batched rpc calls for mapping the results
+	batchCallsAggTokensOrder := make(map[uint64][]common.Address)
+	batchCallsTWAPTokensOrder := make(map[uint64][]common.Address)
+	for _, tk := range aggTokens {
+		aggCfg := d.cfg.AggregatorPrices[tk]
+		batchCallsPerChain[aggCfg.ChainID] = append(batchCallsPerChain[aggCfg.ChainID], rpclib.NewEvmCall(
+			d.aggregatorAbi,
+			latestRoundDataMethodName,
+			aggCfg.AggregatorContractAddress,
+		))
+		batchCallsAggTokensOrder[aggCfg.ChainID] = append(batchCallsAggTokensOrder[aggCfg.ChainID], tk)
+	}
+	for _, tk := range twapTokens {
+		twapCfg := d.cfg.UniswapV3TWAPPrices[tk]
+		batchCallsPerChain[twapCfg.ChainID] = append(batchCallsPerChain[twapCfg.ChainID], rpclib.NewEvmCall(
+			d.poolAbi,
+			observeMethodName,
+			twapCfg.PoolAddress,
+			observeSecondsAgos(twapCfg),
+		))
+		batchCallsTWAPTokensOrder[twapCfg.ChainID] = append(batchCallsTWAPTokensOrder[twapCfg.ChainID], tk)
+	}
+
+	aggPrices := make(map[common.Address]*big.Int, len(aggTokens))
+	twapTicks := make(map[common.Address]int64, len(twapTokens))
 	for chainID, batchCalls := range batchCallsPerChain {
 		client, exists := d.evmClients[chainID]
 		if !exists {
@@ -135,30 +207,107 @@ func (d *DynamicPriceGetter) TokenPricesUSD(ctx context.Context, tokens []ccipty
 		}
 
 		evmCaller := client.BatchCaller
-		tokensOrder := batchCallsTokensOrder[chainID]
+		aggTokensOrder := batchCallsAggTokensOrder[chainID]
+		twapTokensOrder := batchCallsTWAPTokensOrder[chainID]
 
 		resultsPerChain, err := evmCaller.BatchCall(ctx, 0, batchCalls)
 		if err != nil {
 			return nil, fmt.Errorf("batch call: %w", err)
 		}
+		if len(resultsPerChain) != len(batchCalls) {
+			return nil, fmt.Errorf("batch call returned %d results for %d calls", len(resultsPerChain), len(batchCalls))
+		}
+		aggResults, twapResults := resultsPerChain[:len(aggTokensOrder)], resultsPerChain[len(aggTokensOrder):]
 
-		// latestRoundData function has multiple outputs, we want the second one (idx=1)
-		latestRounds, err := rpclib.ParseOutputs[*big.Int](resultsPerChain, func(d rpclib.DataAndErr) (*big.Int, error) {
+		// latestRoundData function has multiple outputs, we want the answer (idx=1) and its update time (idx=3)
+		latestRounds, err := rpclib.ParseOutputs[*big.Int](aggResults, func(d rpclib.DataAndErr) (*big.Int, error) {
 			return rpclib.ParseOutput[*big.Int](d, 1)
 		})
 		if err != nil {
 			return nil, fmt.Errorf("parse outputs: %w", err)
 		}
+		updatedAts, err := rpclib.ParseOutputs[*big.Int](aggResults, func(d rpclib.DataAndErr) (*big.Int, error) {
+			return rpclib.ParseOutput[*big.Int](d, 3)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("parse outputs: %w", err)
+		}
+
+		now := time.Now()
+		for i, tk := range aggTokensOrder {
+			aggCfg := d.cfg.AggregatorPrices[tk]
+			reason := rejectAnswer(aggCfg, latestRounds[i], updatedAts[i], now)
+			if reason == "" {
+				// Prices are already in wei (10e18) when coming from aggregator, no conversion needed.
+				aggPrices[tk] = latestRounds[i]
+				continue
+			}
+
+			rejectedAnswers.WithLabelValues(strconv.FormatUint(chainID, 10), tk.Hex(), reason).Inc()
+			switch aggCfg.FallbackOrDefault() {
+			case config.AggregatorPriceFallbackSkip:
+			case config.AggregatorPriceFallbackStatic:
+				aggPrices[tk] = aggCfg.FallbackPrice
+			default:
+				return nil, fmt.Errorf("aggregator answer %s of token %s rejected: %s", latestRounds[i], tk.Hex(), reason)
+			}
+		}
+
+		// observe function has multiple outputs, we want the tick cumulatives (idx=0)
+		tickCumulatives, err := rpclib.ParseOutputs[[]*big.Int](twapResults, func(d rpclib.DataAndErr) ([]*big.Int, error) {
+			return rpclib.ParseOutput[[]*big.Int](d, 0)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("parse outputs: %w", err)
+		}
+		for i, tk := range twapTokensOrder {
+			tick, err := twapTick(tickCumulatives[i], d.cfg.UniswapV3TWAPPrices[tk].TWAPWindowSeconds)
+			if err != nil {
+				return nil, fmt.Errorf("twap tick of token %s: %w", tk.Hex(), err)
+			}
+			twapTicks[tk] = tick
+		}
+	}
 
-		for i := range tokensOrder {
-			// Prices are already in wei (10e18) when coming from aggregator, no conversion needed.
-			prices[ccipcalc.EvmAddrToGeneric(tokensOrder[i])] = latestRounds[i]
+	for _, tk := range evmAddrs {
+		if price, isAgg := aggPrices[tk]; isAgg {
+			prices[ccipcalc.EvmAddrToGeneric(tk)] = price
+		}
+	}
+	for _, tk := range twapTokens {
+		twapCfg := d.cfg.UniswapV3TWAPPrices[tk]
+		quotePrice := aggPrices[twapCfg.QuoteToken]
+		if staticCfg, isStatic := d.cfg.StaticPrices[twapCfg.QuoteToken]; isStatic {
+			quotePrice = staticCfg.Price
+		}
+		// The quote token price was skipped by its aggregator fallback, the token is skipped as well.
+		if quotePrice == nil {
+			continue
 		}
+		prices[ccipcalc.EvmAddrToGeneric(tk)] = twapPriceUSD(twapCfg, twapTicks[tk], quotePrice)
 	}
 
 	return prices, nil
 }
 
+// rejectAnswer returns the reason why an aggregator answer updated at updatedAt (unix seconds)
+// does not satisfy the configured age and price bounds, or an empty string if the answer is valid.
+func rejectAnswer(cfg config.AggregatorPriceConfig, answer *big.Int, updatedAt *big.Int, now time.Time) string {
+	if cfg.MaxAnswerAgeSeconds > 0 {
+		maxAge := time.Duration(cfg.MaxAnswerAgeSeconds) * time.Second
+		if updatedAt == nil || now.Sub(time.Unix(updatedAt.Int64(), 0)) > maxAge {
+			return rejectReasonStale
+		}
+	}
+	if cfg.MinPrice != nil && answer.Cmp(cfg.MinPrice) < 0 {
+		return rejectReasonBelowMin
+	}
+	if cfg.MaxPrice != nil && answer.Cmp(cfg.MaxPrice) > 0 {
+		return rejectReasonAboveMax
+	}
+	return ""
+}
+
 func (d *DynamicPriceGetter) Close() error {
 	return nil
 }
@@ -2,9 +2,12 @@ package pricegetter
 
 import (
 	"math/big"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -51,6 +54,14 @@ func TestDynamicPriceGetter(t *testing.T) {
 			name:  "no_aggregator_for_token",
 			param: testParamNoAggregatorForToken(t),
 		},
+		{
+			name:  "aggregator_and_twap_valid",
+			param: testParamAggregatorAndTWAPValid(t),
+		},
+		{
+			name:  "twap_quote_token_without_price",
+			param: testParamTWAPQuoteTokenWithoutPrice(t),
+		},
 	}
 
 	for _, test := range tests {
@@ -90,6 +101,96 @@ func TestDynamicPriceGetter(t *testing.T) {
 	}
 }
 
+func TestDynamicPriceGetter_RejectedAnswers(t *testing.T) {
+	const chainID = uint64(101)
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name           string
+		aggCfg         config.AggregatorPriceConfig
+		answer         int64
+		updatedAt      int64
+		expectedPrice  *big.Int
+		expectedReason string
+		expectedErr    bool
+	}{
+		{
+			name:          "fresh answer within bounds",
+			aggCfg:        config.AggregatorPriceConfig{MaxAnswerAgeSeconds: 60, MinPrice: big.NewInt(10), MaxPrice: big.NewInt(1000)},
+			answer:        100,
+			updatedAt:     now - 10,
+			expectedPrice: big.NewInt(100),
+		},
+		{
+			name:           "stale answer errors by default",
+			aggCfg:         config.AggregatorPriceConfig{MaxAnswerAgeSeconds: 60},
+			answer:         100,
+			updatedAt:      now - 3600,
+			expectedReason: rejectReasonStale,
+			expectedErr:    true,
+		},
+		{
+			name:           "answer below min is skipped",
+			aggCfg:         config.AggregatorPriceConfig{MinPrice: big.NewInt(10), Fallback: config.AggregatorPriceFallbackSkip},
+			answer:         9,
+			updatedAt:      now,
+			expectedReason: rejectReasonBelowMin,
+		},
+		{
+			name: "answer above max uses the fallback price",
+			aggCfg: config.AggregatorPriceConfig{
+				MaxPrice:      big.NewInt(1000),
+				Fallback:      config.AggregatorPriceFallbackStatic,
+				FallbackPrice: big.NewInt(500),
+			},
+			answer:         1001,
+			updatedAt:      now,
+			expectedPrice:  big.NewInt(500),
+			expectedReason: rejectReasonAboveMax,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tk := utils.RandomAddress()
+			test.aggCfg.ChainID = chainID
+			test.aggCfg.AggregatorContractAddress = utils.RandomAddress()
+			cfg := config.DynamicPriceGetterConfig{
+				AggregatorPrices: map[common.Address]config.AggregatorPriceConfig{tk: test.aggCfg},
+			}
+			evmClients := map[uint64]DynamicPriceGetterClient{
+				chainID: mockClientFromRound(t, aggregator_v3_interface.LatestRoundData{
+					RoundId:         big.NewInt(1),
+					Answer:          big.NewInt(test.answer),
+					StartedAt:       big.NewInt(test.updatedAt),
+					UpdatedAt:       big.NewInt(test.updatedAt),
+					AnsweredInRound: big.NewInt(1),
+				}),
+			}
+			pg, err := NewDynamicPriceGetter(cfg, evmClients)
+			require.NoError(t, err)
+
+			token := cciptypes.Address(tk.String())
+			prices, err := pg.TokenPricesUSD(testutils.Context(t), []cciptypes.Address{token})
+			if test.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedPrice, prices[token])
+			}
+
+			for _, reason := range []string{rejectReasonStale, rejectReasonBelowMin, rejectReasonAboveMax} {
+				expectedCount := 0.0
+				if reason == test.expectedReason {
+					expectedCount = 1
+				}
+				counter := rejectedAnswers.WithLabelValues(strconv.FormatUint(chainID, 10), tk.Hex(), reason)
+				assert.Equal(t, expectedCount, promtestutil.ToFloat64(counter), reason)
+			}
+		})
+	}
+}
+
 func testParamAggregatorOnly(t *testing.T) testParameters {
 	tk1 := utils.RandomAddress()
 	tk2 := utils.RandomAddress()
@@ -345,6 +446,92 @@ func testParamNoAggregatorForToken(t *testing.T) testParameters {
 	}
 }
 
+func testParamAggregatorAndTWAPValid(t *testing.T) testParameters {
+	tk1 := utils.RandomAddress()
+	tk2 := utils.RandomAddress()
+	tk3 := utils.RandomAddress()
+	cfg := config.DynamicPriceGetterConfig{
+		AggregatorPrices: map[common.Address]config.AggregatorPriceConfig{
+			tk1: {
+				ChainID:                   101,
+				AggregatorContractAddress: utils.RandomAddress(),
+			},
+		},
+		UniswapV3TWAPPrices: map[common.Address]config.UniswapV3TWAPPriceConfig{
+			// Same chain as the quote token aggregator, 1:1 pool.
+			tk2: {
+				ChainID:            101,
+				PoolAddress:        utils.RandomAddress(),
+				QuoteToken:         tk1,
+				TokenIsToken0:      true,
+				TokenDecimals:      18,
+				QuoteTokenDecimals: 18,
+				TWAPWindowSeconds:  600,
+			},
+			// Other chain, 1 token = 1e12 quote token units.
+			tk3: {
+				ChainID:            102,
+				PoolAddress:        utils.RandomAddress(),
+				QuoteToken:         tk1,
+				TokenIsToken0:      true,
+				TokenDecimals:      18,
+				QuoteTokenDecimals: 6,
+				TWAPWindowSeconds:  1800,
+			},
+		},
+	}
+	answer := big.NewInt(1396818990)
+	observeOutputs := func(tickCumulatives ...int64) rpclib.DataAndErr {
+		cumulatives := make([]*big.Int, len(tickCumulatives))
+		for i, c := range tickCumulatives {
+			cumulatives[i] = big.NewInt(c)
+		}
+		return rpclib.DataAndErr{Outputs: []any{cumulatives, []*big.Int{big.NewInt(0), big.NewInt(0)}}}
+	}
+
+	caller101 := rpclibmocks.NewEvmBatchCaller(t)
+	caller101.On("BatchCall", mock.Anything, uint64(0), mock.MatchedBy(func(calls []rpclib.EvmCall) bool {
+		return len(calls) == 2 && calls[0].MethodName() == latestRoundDataMethodName && calls[1].MethodName() == observeMethodName
+	})).Return([]rpclib.DataAndErr{
+		{Outputs: []any{big.NewInt(1000), answer, big.NewInt(1704896575), big.NewInt(1704896575), big.NewInt(1000)}},
+		observeOutputs(5000, 5000),
+	}, nil)
+	caller102 := rpclibmocks.NewEvmBatchCaller(t)
+	caller102.On("BatchCall", mock.Anything, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{
+		observeOutputs(-1800, -1800),
+	}, nil)
+
+	return testParameters{
+		cfg: cfg,
+		evmClients: map[uint64]DynamicPriceGetterClient{
+			uint64(101): NewDynamicPriceGetterClient(caller101),
+			uint64(102): NewDynamicPriceGetterClient(caller102),
+		},
+		expectedTokenPrices: map[common.Address]big.Int{
+			tk2: *answer,
+			tk3: *new(big.Int).Mul(answer, big.NewInt(1e12)),
+		},
+	}
+}
+
+func testParamTWAPQuoteTokenWithoutPrice(t *testing.T) testParameters {
+	tk1 := utils.RandomAddress()
+	cfg := config.DynamicPriceGetterConfig{
+		UniswapV3TWAPPrices: map[common.Address]config.UniswapV3TWAPPriceConfig{
+			tk1: {
+				ChainID:           101,
+				PoolAddress:       utils.RandomAddress(),
+				QuoteToken:        utils.RandomAddress(),
+				TWAPWindowSeconds: 600,
+			},
+		},
+	}
+	return testParameters{
+		cfg:                        cfg,
+		invalidConfigErrorExpected: true,
+	}
+}
+
 func mockClientFromRound(t *testing.T, round aggregator_v3_interface.LatestRoundData) DynamicPriceGetterClient {
 	return DynamicPriceGetterClient{
 		BatchCaller: mockCallerFromRound(t, round),
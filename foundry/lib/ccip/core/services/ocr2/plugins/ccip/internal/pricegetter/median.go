@@ -0,0 +1,180 @@
+package pricegetter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/gethwrappers2/generated/offchainaggregator"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
+)
+
+// MedianPriceGetter is a PriceGetter reading every token price from several aggregators, possibly on
+// different chains, and reporting their median. Aggregators that fail to respond or that deviate too much
+// from the median are ignored, the fallback price is used when not enough aggregators are left.
+type MedianPriceGetter struct {
+	lggr          logger.Logger
+	cfg           config.MedianPriceGetterConfig
+	evmClients    map[uint64]DynamicPriceGetterClient
+	aggregatorAbi abi.ABI
+}
+
+// aggregatorSource identifies the aggregator of a single token price source.
+type aggregatorSource struct {
+	token     common.Address
+	sourceIdx int
+}
+
+// NewMedianPriceGetter builds a MedianPriceGetter from a configuration and a map of chain ID to batch callers.
+// A batch caller should be provided for all the chains of the configured aggregators.
+func NewMedianPriceGetter(lggr logger.Logger, cfg config.MedianPriceGetterConfig, evmClients map[uint64]DynamicPriceGetterClient) (*MedianPriceGetter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("validating median price getter config: %w", err)
+	}
+	aggregatorAbi, err := abi.JSON(strings.NewReader(offchainaggregator.OffchainAggregatorABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse offchainaggregator abi: %w", err)
+	}
+	return &MedianPriceGetter{
+		lggr:          lggr,
+		cfg:           cfg,
+		evmClients:    evmClients,
+		aggregatorAbi: aggregatorAbi,
+	}, nil
+}
+
+// FilterConfiguredTokens implements the PriceGetter interface.
+func (m *MedianPriceGetter) FilterConfiguredTokens(ctx context.Context, tokens []cciptypes.Address) (configured []cciptypes.Address, unconfigured []cciptypes.Address, err error) {
+	configured = []cciptypes.Address{}
+	unconfigured = []cciptypes.Address{}
+	for _, tk := range tokens {
+		evmAddr, err := ccipcalc.GenericAddrToEvm(tk)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, ok := m.cfg.TokenPrices[evmAddr]; ok {
+			configured = append(configured, tk)
+		} else {
+			unconfigured = append(unconfigured, tk)
+		}
+	}
+	return configured, unconfigured, nil
+}
+
+// TokenPricesUSD implements the PriceGetter interface.
+// All the aggregators are batch called (one batch per chain) and the median of the responding sources is returned per token.
+func (m *MedianPriceGetter) TokenPricesUSD(ctx context.Context, tokens []cciptypes.Address) (map[cciptypes.Address]*big.Int, error) {
+	evmAddrs, err := ccipcalc.GenericAddrsToEvm(tokens...)
+	if err != nil {
+		return nil, err
+	}
+
+	batchCallsPerChain := make(map[uint64][]rpclib.EvmCall)
+	// required to maintain the order of the batched rpc calls for mapping the results
+	batchCallsSourcesOrder := make(map[uint64][]aggregatorSource)
+	for _, tk := range evmAddrs {
+		tokenCfg, ok := m.cfg.TokenPrices[tk]
+		if !ok {
+			return nil, fmt.Errorf("no price resolution rule for token %s", tk.Hex())
+		}
+		for i, aggCfg := range tokenCfg.AggregatorPrices {
+			batchCallsPerChain[aggCfg.ChainID] = append(batchCallsPerChain[aggCfg.ChainID], rpclib.NewEvmCall(
+				m.aggregatorAbi,
+				latestRoundDataMethodName,
+				aggCfg.AggregatorContractAddress,
+			))
+			batchCallsSourcesOrder[aggCfg.ChainID] = append(batchCallsSourcesOrder[aggCfg.ChainID], aggregatorSource{token: tk, sourceIdx: i})
+		}
+	}
+
+	sourcePrices := make(map[common.Address][]*big.Int, len(evmAddrs))
+	for chainID, batchCalls := range batchCallsPerChain {
+		client, exists := m.evmClients[chainID]
+		if !exists {
+			return nil, fmt.Errorf("evm caller for chain %d not found", chainID)
+		}
+
+		// A failing chain only removes its aggregators from the sources, the other chains can still provide the median.
+		results, err := client.BatchCaller.BatchCall(ctx, 0, batchCalls)
+		if err != nil {
+			m.lggr.Warnw("Batch call to aggregators failed", "chainID", chainID, "err", err)
+			continue
+		}
+
+		now := time.Now()
+		for i, source := range batchCallsSourcesOrder[chainID] {
+			if i >= len(results) {
+				break
+			}
+			aggCfg := m.cfg.TokenPrices[source.token].AggregatorPrices[source.sourceIdx]
+			// latestRoundData function has multiple outputs, we want the answer (idx=1) and its update time (idx=3)
+			answer, err := rpclib.ParseOutput[*big.Int](results[i], 1)
+			if err != nil || answer == nil || answer.Sign() <= 0 {
+				m.lggr.Warnw("Ignoring aggregator price", "token", source.token, "chainID", chainID,
+					"aggregator", aggCfg.AggregatorContractAddress, "answer", answer, "err", err)
+				continue
+			}
+			updatedAt, err := rpclib.ParseOutput[*big.Int](results[i], 3)
+			if err != nil {
+				m.lggr.Warnw("Ignoring aggregator price", "token", source.token, "chainID", chainID,
+					"aggregator", aggCfg.AggregatorContractAddress, "answer", answer, "err", err)
+				continue
+			}
+			if reason := rejectAnswer(aggCfg, answer, updatedAt, now); reason != "" {
+				rejectedAnswers.WithLabelValues(strconv.FormatUint(chainID, 10), source.token.Hex(), reason).Inc()
+				m.lggr.Warnw("Ignoring rejected aggregator price", "token", source.token, "chainID", chainID,
+					"aggregator", aggCfg.AggregatorContractAddress, "answer", answer, "updatedAt", updatedAt, "reason", reason)
+				continue
+			}
+			sourcePrices[source.token] = append(sourcePrices[source.token], answer)
+		}
+	}
+
+	prices := make(map[cciptypes.Address]*big.Int, len(evmAddrs))
+	for _, tk := range evmAddrs {
+		price, err := medianPrice(m.cfg.TokenPrices[tk], sourcePrices[tk])
+		if err != nil {
+			return nil, fmt.Errorf("token %s: %w", tk.Hex(), err)
+		}
+		prices[ccipcalc.EvmAddrToGeneric(tk)] = price
+	}
+	return prices, nil
+}
+
+// medianPrice returns the median of the sources within the maximum deviation of the median of all sources.
+// The fallback price is returned when less than the minimum number of sources agree.
+func medianPrice(cfg config.MedianTokenPriceConfig, sources []*big.Int) (*big.Int, error) {
+	agreeing := sources
+	if median := ccipcalc.BigIntSortedMiddle(sources); median != nil && cfg.MaxDeviationPPB > 0 {
+		agreeing = make([]*big.Int, 0, len(sources))
+		for _, price := range sources {
+			if !ccipcalc.Deviates(price, median, cfg.MaxDeviationPPB) {
+				agreeing = append(agreeing, price)
+			}
+		}
+	}
+
+	if len(agreeing) >= cfg.MinResponses() {
+		return ccipcalc.BigIntSortedMiddle(agreeing), nil
+	}
+	if cfg.FallbackPrice != nil {
+		return cfg.FallbackPrice, nil
+	}
+	return nil, fmt.Errorf("%d out of %d sources agree, at least %d required", len(agreeing), len(cfg.AggregatorPrices), cfg.MinResponses())
+}
+
+func (m *MedianPriceGetter) Close() error {
+	return nil
+}
@@ -0,0 +1,165 @@
+package pricegetter
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib/rpclibmocks"
+)
+
+func aggregatorAnswer(answer int64) rpclib.DataAndErr {
+	return rpclib.DataAndErr{
+		Outputs: []any{big.NewInt(1), big.NewInt(answer), big.NewInt(1704896575), big.NewInt(1704896575), big.NewInt(1)},
+	}
+}
+
+func mockClientFromResults(t *testing.T, results []rpclib.DataAndErr, err error) DynamicPriceGetterClient {
+	caller := rpclibmocks.NewEvmBatchCaller(t)
+	caller.On("BatchCall", mock.Anything, uint64(0), mock.Anything).Return(results, err).Maybe()
+	return NewDynamicPriceGetterClient(caller)
+}
+
+func TestMedianPriceGetter(t *testing.T) {
+	tk := utils.RandomAddress()
+	tokenCfg := func(minSources int, maxDeviationPPB int64, fallback *big.Int) config.MedianPriceGetterConfig {
+		return config.MedianPriceGetterConfig{
+			TokenPrices: map[common.Address]config.MedianTokenPriceConfig{
+				tk: {
+					AggregatorPrices: []config.AggregatorPriceConfig{
+						{ChainID: 101, AggregatorContractAddress: utils.RandomAddress()},
+						{ChainID: 101, AggregatorContractAddress: utils.RandomAddress()},
+						{ChainID: 102, AggregatorContractAddress: utils.RandomAddress()},
+					},
+					FallbackPrice:   fallback,
+					MinSources:      minSources,
+					MaxDeviationPPB: maxDeviationPPB,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		cfg           config.MedianPriceGetterConfig
+		evmClients    map[uint64]DynamicPriceGetterClient
+		expectedPrice *big.Int
+		expectedErr   bool
+	}{
+		{
+			name: "median of all sources",
+			cfg:  tokenCfg(0, 0, nil),
+			evmClients: map[uint64]DynamicPriceGetterClient{
+				101: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(100), aggregatorAnswer(300)}, nil),
+				102: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(200)}, nil),
+			},
+			expectedPrice: big.NewInt(200),
+		},
+		{
+			name: "single outlier does not move the price",
+			cfg:  tokenCfg(2, 1e8, nil),
+			evmClients: map[uint64]DynamicPriceGetterClient{
+				101: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(1000), aggregatorAnswer(1_000_000)}, nil),
+				102: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(1010)}, nil),
+			},
+			expectedPrice: big.NewInt(1010),
+		},
+		{
+			name: "failing chain and call are ignored",
+			cfg:  tokenCfg(1, 0, nil),
+			evmClients: map[uint64]DynamicPriceGetterClient{
+				101: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(500), {Err: errors.New("reverted")}}, nil),
+				102: mockClientFromResults(t, nil, errors.New("rpc down")),
+			},
+			expectedPrice: big.NewInt(500),
+		},
+		{
+			name: "fallback price when not enough sources",
+			cfg:  tokenCfg(2, 0, big.NewInt(42)),
+			evmClients: map[uint64]DynamicPriceGetterClient{
+				101: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(500), {Err: errors.New("reverted")}}, nil),
+				102: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(0)}, nil),
+			},
+			expectedPrice: big.NewInt(42),
+		},
+		{
+			name: "error when not enough sources agree",
+			cfg:  tokenCfg(2, 1e7, nil),
+			evmClients: map[uint64]DynamicPriceGetterClient{
+				101: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(100), aggregatorAnswer(200)}, nil),
+				102: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(400)}, nil),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "stale and out of bounds sources are ignored",
+			cfg: func() config.MedianPriceGetterConfig {
+				cfg := tokenCfg(1, 0, nil)
+				tokenPrice := cfg.TokenPrices[tk]
+				tokenPrice.AggregatorPrices[0].MaxAnswerAgeSeconds = 60
+				tokenPrice.AggregatorPrices[1].MaxPrice = big.NewInt(1000)
+				return cfg
+			}(),
+			evmClients: map[uint64]DynamicPriceGetterClient{
+				101: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(100), aggregatorAnswer(2000)}, nil),
+				102: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(300)}, nil),
+			},
+			expectedPrice: big.NewInt(300),
+		},
+		{
+			name: "missing chain client",
+			cfg:  tokenCfg(0, 0, nil),
+			evmClients: map[uint64]DynamicPriceGetterClient{
+				101: mockClientFromResults(t, []rpclib.DataAndErr{aggregatorAnswer(100), aggregatorAnswer(200)}, nil),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := testutils.Context(t)
+			pg, err := NewMedianPriceGetter(logger.TestLogger(t), test.cfg, test.evmClients)
+			require.NoError(t, err)
+
+			unconfiguredTk := cciptypes.Address(utils.RandomAddress().String())
+			configuredTk := cciptypes.Address(tk.String())
+			cfgTokens, uncfgTokens, err := pg.FilterConfiguredTokens(ctx, []cciptypes.Address{configuredTk, unconfiguredTk})
+			require.NoError(t, err)
+			assert.Equal(t, []cciptypes.Address{configuredTk}, cfgTokens)
+			assert.Equal(t, []cciptypes.Address{unconfiguredTk}, uncfgTokens)
+
+			prices, err := pg.TokenPricesUSD(ctx, []cciptypes.Address{configuredTk})
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPrice, prices[configuredTk])
+		})
+	}
+}
+
+func TestMedianPriceGetter_InvalidConfig(t *testing.T) {
+	_, err := NewMedianPriceGetter(logger.TestLogger(t), config.MedianPriceGetterConfig{
+		TokenPrices: map[common.Address]config.MedianTokenPriceConfig{
+			utils.RandomAddress(): {
+				AggregatorPrices: []config.AggregatorPriceConfig{{ChainID: 101, AggregatorContractAddress: utils.RandomAddress()}},
+				MinSources:       2,
+			},
+		},
+	}, nil)
+	require.Error(t, err)
+}
@@ -6,3 +6,10 @@ import cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 type PriceGetter interface {
 	cciptypes.PriceGetter
 }
+
+// TokenPriceSkipper is implemented by the PriceGetters that can leave the tokens whose price they rejected out of
+// TokenPricesUSD. The commit plugin then observes the latest committed price of these tokens.
+type TokenPriceSkipper interface {
+	// SkipsRejectedPrices returns true if the price getter is configured to skip rejected prices.
+	SkipsRejectedPrices() bool
+}
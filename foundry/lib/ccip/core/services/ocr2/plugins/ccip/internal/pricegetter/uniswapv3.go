@@ -0,0 +1,178 @@
+package pricegetter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
+)
+
+const (
+	observeMethodName  = "observe"
+	token0MethodName   = "token0"
+	token1MethodName   = "token1"
+	decimalsMethodName = "decimals"
+
+	// uniswapV3PoolABI is the subset of the Uniswap V3 pool ABI used to read the pool tokens and the tick cumulatives.
+	uniswapV3PoolABI = `[{"inputs":[{"internalType":"uint32[]","name":"secondsAgos","type":"uint32[]"}],"name":"observe","outputs":[{"internalType":"int56[]","name":"tickCumulatives","type":"int56[]"},{"internalType":"uint160[]","name":"secondsPerLiquidityCumulativeX128s","type":"uint160[]"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"token0","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"token1","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}]`
+
+	// tickPricePrecision is the precision (in bits) of the floating point tick to price conversion.
+	tickPricePrecision = 256
+)
+
+// VerifyUniswapV3Pools reads the tokens of the configured Uniswap V3 pools and their decimals, and checks them against
+// the TokenIsToken0, TokenDecimals and QuoteTokenDecimals of the TWAP price rules, which are operator supplied.
+func (d *DynamicPriceGetter) VerifyUniswapV3Pools(ctx context.Context) error {
+	twapTokensPerChain := make(map[uint64][]common.Address)
+	for tk, twapCfg := range d.cfg.UniswapV3TWAPPrices {
+		twapTokensPerChain[twapCfg.ChainID] = append(twapTokensPerChain[twapCfg.ChainID], tk)
+	}
+
+	for chainID, twapTokens := range twapTokensPerChain {
+		client, exists := d.evmClients[chainID]
+		if !exists {
+			return fmt.Errorf("evm caller for chain %d not found", chainID)
+		}
+
+		// Read token0 and token1 of every pool, then the decimals of these tokens.
+		calls := make([]rpclib.EvmCall, 0, 2*len(twapTokens))
+		for _, tk := range twapTokens {
+			poolAddress := d.cfg.UniswapV3TWAPPrices[tk].PoolAddress
+			calls = append(calls,
+				rpclib.NewEvmCall(d.poolAbi, token0MethodName, poolAddress),
+				rpclib.NewEvmCall(d.poolAbi, token1MethodName, poolAddress),
+			)
+		}
+		results, err := client.BatchCaller.BatchCall(ctx, 0, calls)
+		if err != nil {
+			return fmt.Errorf("batch call: %w", err)
+		}
+		poolTokens, err := rpclib.ParseOutputs[common.Address](results, func(d rpclib.DataAndErr) (common.Address, error) {
+			return rpclib.ParseOutput[common.Address](d, 0)
+		})
+		if err != nil {
+			return fmt.Errorf("parse outputs: %w", err)
+		}
+		if len(poolTokens) != len(calls) {
+			return fmt.Errorf("batch call returned %d results for %d calls", len(poolTokens), len(calls))
+		}
+
+		calls = make([]rpclib.EvmCall, 0, len(poolTokens))
+		for _, poolToken := range poolTokens {
+			calls = append(calls, rpclib.NewEvmCall(d.erc20Abi, decimalsMethodName, poolToken))
+		}
+		results, err = client.BatchCaller.BatchCall(ctx, 0, calls)
+		if err != nil {
+			return fmt.Errorf("batch call: %w", err)
+		}
+		decimals, err := rpclib.ParseOutputs[uint8](results, func(d rpclib.DataAndErr) (uint8, error) {
+			return rpclib.ParseOutput[uint8](d, 0)
+		})
+		if err != nil {
+			return fmt.Errorf("parse outputs: %w", err)
+		}
+		if len(decimals) != len(calls) {
+			return fmt.Errorf("batch call returned %d results for %d calls", len(decimals), len(calls))
+		}
+
+		for i, tk := range twapTokens {
+			twapCfg := d.cfg.UniswapV3TWAPPrices[tk]
+			if err := verifyUniswapV3Pool(tk, twapCfg, poolTokens[2*i:2*i+2], decimals[2*i:2*i+2]); err != nil {
+				return fmt.Errorf("uniswap v3 pool %s of token %s: %w", twapCfg.PoolAddress.Hex(), tk.Hex(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// verifyUniswapV3Pool checks the TWAP price rule of token against the tokens of its pool (token0 and token1) and their decimals.
+func verifyUniswapV3Pool(token common.Address, cfg config.UniswapV3TWAPPriceConfig, poolTokens []common.Address, decimals []uint8) error {
+	tokenIdx, quoteIdx := 1, 0
+	if cfg.TokenIsToken0 {
+		tokenIdx, quoteIdx = 0, 1
+	}
+	// Price rules are keyed by the token addresses on the destination chain, which only match the pool tokens
+	// when the pool is on the same chain.
+	if poolTokens[quoteIdx] == token || poolTokens[tokenIdx] == cfg.QuoteToken {
+		return fmt.Errorf("tokenIsToken0 is %t but the token is token%d of the pool", cfg.TokenIsToken0, quoteIdx)
+	}
+	if decimals[tokenIdx] != cfg.TokenDecimals {
+		return fmt.Errorf("token%d has %d decimals but tokenDecimals is %d", tokenIdx, decimals[tokenIdx], cfg.TokenDecimals)
+	}
+	if decimals[quoteIdx] != cfg.QuoteTokenDecimals {
+		return fmt.Errorf("token%d has %d decimals but quoteTokenDecimals is %d", quoteIdx, decimals[quoteIdx], cfg.QuoteTokenDecimals)
+	}
+	return nil
+}
+
+// observeSecondsAgos returns the observe() arguments reading the tick cumulatives at the start and the end of the TWAP window.
+func observeSecondsAgos(cfg config.UniswapV3TWAPPriceConfig) []uint32 {
+	return []uint32{cfg.TWAPWindowSeconds, 0}
+}
+
+// twapTick returns the time-weighted average tick from the tick cumulatives returned by observe(),
+// rounded towards negative infinity like the Uniswap OracleLibrary.
+func twapTick(tickCumulatives []*big.Int, windowSeconds uint32) (int64, error) {
+	if len(tickCumulatives) != 2 || tickCumulatives[0] == nil || tickCumulatives[1] == nil {
+		return 0, fmt.Errorf("expected 2 tick cumulatives, got %v", tickCumulatives)
+	}
+	delta := new(big.Int).Sub(tickCumulatives[1], tickCumulatives[0])
+	// Div performs Euclidean division, which rounds towards negative infinity for a positive divisor.
+	tick := new(big.Int).Div(delta, big.NewInt(int64(windowSeconds)))
+	if !tick.IsInt64() {
+		return 0, fmt.Errorf("tick %s out of range", tick)
+	}
+	return tick.Int64(), nil
+}
+
+// twapPriceUSD returns the USD price (1e18 per full token) of the pool token given the TWAP tick and the USD price of the quote token.
+// The pool price at a tick is 1.0001^tick units of token1 per unit of token0, both in their smallest denomination.
+func twapPriceUSD(cfg config.UniswapV3TWAPPriceConfig, tick int64, quotePriceUSD *big.Int) *big.Int {
+	if !cfg.TokenIsToken0 {
+		tick = -tick
+	}
+	quotePerToken := tickToRatio(tick)
+
+	price := new(big.Float).SetPrec(tickPricePrecision).SetInt(quotePriceUSD)
+	price.Mul(price, quotePerToken)
+	price.Mul(price, new(big.Float).SetPrec(tickPricePrecision).SetInt(pow10(cfg.TokenDecimals)))
+	price.Quo(price, new(big.Float).SetPrec(tickPricePrecision).SetInt(pow10(cfg.QuoteTokenDecimals)))
+
+	res, _ := price.Int(nil)
+	return res
+}
+
+// tickToRatio computes 1.0001^tick by exponentiation by squaring.
+func tickToRatio(tick int64) *big.Float {
+	// 1.0001 is not exactly representable as a float64, build it from integers instead.
+	base := new(big.Float).SetPrec(tickPricePrecision).Quo(
+		new(big.Float).SetPrec(tickPricePrecision).SetInt64(10001),
+		new(big.Float).SetPrec(tickPricePrecision).SetInt64(10000),
+	)
+
+	negative := tick < 0
+	if negative {
+		tick = -tick
+	}
+
+	ratio := new(big.Float).SetPrec(tickPricePrecision).SetInt64(1)
+	for ; tick > 0; tick >>= 1 {
+		if tick&1 == 1 {
+			ratio.Mul(ratio, base)
+		}
+		base.Mul(base, base)
+	}
+
+	if negative {
+		ratio.Quo(new(big.Float).SetPrec(tickPricePrecision).SetInt64(1), ratio)
+	}
+	return ratio
+}
+
+func pow10(exp uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
@@ -0,0 +1,196 @@
+package pricegetter
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib/rpclibmocks"
+)
+
+func TestTwapTick(t *testing.T) {
+	tests := []struct {
+		name            string
+		tickCumulatives []*big.Int
+		window          uint32
+		expectedTick    int64
+		expectedErr     bool
+	}{
+		{name: "positive", tickCumulatives: []*big.Int{big.NewInt(1000), big.NewInt(1000 + 600*201000)}, window: 600, expectedTick: 201000},
+		{name: "negative exact", tickCumulatives: []*big.Int{big.NewInt(0), big.NewInt(-600)}, window: 600, expectedTick: -1},
+		{name: "negative rounded down", tickCumulatives: []*big.Int{big.NewInt(0), big.NewInt(-601)}, window: 600, expectedTick: -2},
+		{name: "positive rounded down", tickCumulatives: []*big.Int{big.NewInt(0), big.NewInt(1199)}, window: 600, expectedTick: 1},
+		{name: "missing cumulative", tickCumulatives: []*big.Int{big.NewInt(0)}, window: 600, expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tick, err := twapTick(test.tickCumulatives, test.window)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedTick, tick)
+		})
+	}
+}
+
+func TestTwapPriceUSD(t *testing.T) {
+	usdPrice := func(usd float64) float64 { return usd * 1e18 }
+
+	tests := []struct {
+		name          string
+		cfg           config.UniswapV3TWAPPriceConfig
+		tick          int64
+		quotePriceUSD *big.Int
+		expectedPrice float64
+	}{
+		{
+			name:          "zero tick only scales decimals",
+			cfg:           config.UniswapV3TWAPPriceConfig{TokenIsToken0: true, TokenDecimals: 18, QuoteTokenDecimals: 6},
+			tick:          0,
+			quotePriceUSD: big.NewInt(1e18),
+			expectedPrice: usdPrice(1e12),
+		},
+		{
+			// USDC (token0, 6 decimals) / WETH (token1, 18 decimals) pool, the price of WETH is derived from USDC.
+			name:          "token is token1",
+			cfg:           config.UniswapV3TWAPPriceConfig{TokenIsToken0: false, TokenDecimals: 18, QuoteTokenDecimals: 6},
+			tick:          201000,
+			quotePriceUSD: big.NewInt(1e18),
+			expectedPrice: usdPrice(1e12 / math.Pow(1.0001, 201000)),
+		},
+		{
+			// Same pool, the price of USDC is derived from WETH.
+			name:          "token is token0",
+			cfg:           config.UniswapV3TWAPPriceConfig{TokenIsToken0: true, TokenDecimals: 6, QuoteTokenDecimals: 18},
+			tick:          201000,
+			quotePriceUSD: new(big.Int).Mul(big.NewInt(1866), big.NewInt(1e18)),
+			expectedPrice: usdPrice(1866 * math.Pow(1.0001, 201000) / 1e12),
+		},
+		{
+			name:          "negative tick",
+			cfg:           config.UniswapV3TWAPPriceConfig{TokenIsToken0: true, TokenDecimals: 18, QuoteTokenDecimals: 18},
+			tick:          -6932,
+			quotePriceUSD: big.NewInt(2e18),
+			expectedPrice: usdPrice(2 * math.Pow(1.0001, -6932)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			price := twapPriceUSD(test.cfg, test.tick, test.quotePriceUSD)
+			actual, _ := new(big.Float).SetInt(price).Float64()
+			assert.InEpsilon(t, test.expectedPrice, actual, 1e-9)
+		})
+	}
+}
+
+func TestDynamicPriceGetter_VerifyUniswapV3Pools(t *testing.T) {
+	const chainID = uint64(101)
+	// Pool tokens on the pool chain, token0 has 6 decimals and token1 18.
+	poolToken0, poolToken1 := utils.RandomAddress(), utils.RandomAddress()
+	quoteToken := utils.RandomAddress()
+
+	tests := []struct {
+		name        string
+		token       common.Address
+		twapCfg     config.UniswapV3TWAPPriceConfig
+		expectedErr string
+	}{
+		{
+			name:    "token is token1",
+			token:   utils.RandomAddress(),
+			twapCfg: config.UniswapV3TWAPPriceConfig{TokenIsToken0: false, TokenDecimals: 18, QuoteTokenDecimals: 6},
+		},
+		{
+			name:    "token on the pool chain is token0",
+			token:   poolToken0,
+			twapCfg: config.UniswapV3TWAPPriceConfig{TokenIsToken0: true, TokenDecimals: 6, QuoteTokenDecimals: 18},
+		},
+		{
+			name:        "token on the pool chain is not token0",
+			token:       poolToken1,
+			twapCfg:     config.UniswapV3TWAPPriceConfig{TokenIsToken0: true, TokenDecimals: 6, QuoteTokenDecimals: 18},
+			expectedErr: "tokenIsToken0 is true but the token is token1 of the pool",
+		},
+		{
+			name:        "quote token on the pool chain is not token0",
+			token:       utils.RandomAddress(),
+			twapCfg:     config.UniswapV3TWAPPriceConfig{QuoteToken: poolToken1, TokenIsToken0: false, TokenDecimals: 18, QuoteTokenDecimals: 6},
+			expectedErr: "tokenIsToken0 is false but the token is token0 of the pool",
+		},
+		{
+			name:        "wrong token decimals",
+			token:       utils.RandomAddress(),
+			twapCfg:     config.UniswapV3TWAPPriceConfig{TokenIsToken0: false, TokenDecimals: 6, QuoteTokenDecimals: 6},
+			expectedErr: "token1 has 18 decimals but tokenDecimals is 6",
+		},
+		{
+			name:        "wrong quote token decimals",
+			token:       utils.RandomAddress(),
+			twapCfg:     config.UniswapV3TWAPPriceConfig{TokenIsToken0: false, TokenDecimals: 18, QuoteTokenDecimals: 18},
+			expectedErr: "token0 has 6 decimals but quoteTokenDecimals is 18",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			twapCfg := test.twapCfg
+			twapCfg.ChainID = chainID
+			twapCfg.PoolAddress = utils.RandomAddress()
+			twapCfg.TWAPWindowSeconds = 600
+			if twapCfg.QuoteToken == (common.Address{}) {
+				twapCfg.QuoteToken = quoteToken
+			}
+			cfg := config.DynamicPriceGetterConfig{
+				StaticPrices: map[common.Address]config.StaticPriceConfig{
+					twapCfg.QuoteToken: {ChainID: chainID, Price: big.NewInt(1e18)},
+				},
+				UniswapV3TWAPPrices: map[common.Address]config.UniswapV3TWAPPriceConfig{test.token: twapCfg},
+			}
+
+			caller := rpclibmocks.NewEvmBatchCaller(t)
+			caller.On("BatchCall", mock.Anything, uint64(0), mock.MatchedBy(func(calls []rpclib.EvmCall) bool {
+				return len(calls) == 2 && calls[0].MethodName() == token0MethodName && calls[1].MethodName() == token1MethodName
+			})).Return([]rpclib.DataAndErr{{Outputs: []any{poolToken0}}, {Outputs: []any{poolToken1}}}, nil).Once()
+			caller.On("BatchCall", mock.Anything, uint64(0), mock.MatchedBy(func(calls []rpclib.EvmCall) bool {
+				return len(calls) == 2 && calls[0].MethodName() == decimalsMethodName && calls[1].MethodName() == decimalsMethodName
+			})).Return([]rpclib.DataAndErr{{Outputs: []any{uint8(6)}}, {Outputs: []any{uint8(18)}}}, nil).Once()
+
+			pg, err := NewDynamicPriceGetter(cfg, map[uint64]DynamicPriceGetterClient{chainID: NewDynamicPriceGetterClient(caller)})
+			require.NoError(t, err)
+
+			err = pg.VerifyUniswapV3Pools(testutils.Context(t))
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("missing evm caller", func(t *testing.T) {
+		cfg := config.DynamicPriceGetterConfig{
+			StaticPrices: map[common.Address]config.StaticPriceConfig{
+				quoteToken: {ChainID: chainID, Price: big.NewInt(1e18)},
+			},
+			UniswapV3TWAPPrices: map[common.Address]config.UniswapV3TWAPPriceConfig{
+				utils.RandomAddress(): {ChainID: chainID, PoolAddress: utils.RandomAddress(), QuoteToken: quoteToken, TWAPWindowSeconds: 600},
+			},
+		}
+		pg, err := NewDynamicPriceGetter(cfg, map[uint64]DynamicPriceGetterClient{})
+		require.NoError(t, err)
+		require.ErrorContains(t, pg.VerifyUniswapV3Pools(testutils.Context(t)), "evm caller for chain 101 not found")
+	})
+}
@@ -1,10 +1,14 @@
 package ccip
 
 import (
+	"math/big"
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 )
 
 var (
@@ -20,6 +24,36 @@ var (
 		Name: "ccip_sequence_number_counter",
 		Help: "Sequence number of the last message processed by the plugin",
 	}, []string{"plugin", "source", "dest", "ocrPhase"})
+
+	laneMessageLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "ccip_lane_message_latency_seconds",
+		Help: "Time from the onRamp block of a message's send request to the block including its commit or execution report",
+		// 10s to ~11h
+		Buckets: prometheus.ExponentialBuckets(10, 2, 13),
+	}, []string{"source", "dest", "stage"})
+	laneExecGasUsed = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "ccip_lane_exec_gas_used_per_message",
+		Help: "Destination gas spent per executed message, from the receipts of the execution transactions sent by the node",
+		// 25k to ~25M
+		Buckets: prometheus.ExponentialBuckets(25_000, 2, 11),
+	}, []string{"source", "dest"})
+	laneExecGasLimitUsage = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ccip_lane_exec_gas_used_to_gas_limit_ratio",
+		Help:    "Destination gas spent per executed message divided by the gasLimit requested by its sender",
+		Buckets: prometheus.ExponentialBuckets(0.125, 2, 8),
+	}, []string{"source", "dest"})
+	laneExecFeePerGas = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "ccip_lane_exec_fee_paid_per_gas",
+		Help: "Fee paid by the sender of an executed message, converted to the smallest denomination of the destination native token, per unit of destination gas spent executing it",
+		// 1e6 to 1e17
+		Buckets: prometheus.ExponentialBuckets(1e6, 10, 12),
+	}, []string{"source", "dest", "feeToken"})
+)
+
+// Stages of a message's lifecycle its latency is measured at.
+const (
+	LaneStageCommit = "commit"
+	LaneStageExec   = "exec"
 )
 
 type ocrPhase string
@@ -79,6 +113,53 @@ func (p *pluginMetricsCollector) SequenceNumber(phase ocrPhase, seqNr uint64) {
 		Set(float64(seqNr))
 }
 
+// LaneMetricsCollector collects the end-to-end metrics of the messages of a lane.
+type LaneMetricsCollector interface {
+	// MessageLatency records the time from the send request of a message to the inclusion of its report at the stage.
+	MessageLatency(stage string, sentAt, includedAt time.Time)
+	// MessageExecutionGas records the destination gas spent executing a message, against its requested gas limit
+	// and the fee paid by the sender converted to the destination native token, nil when its price is unknown.
+	MessageExecutionGas(gasUsed uint64, gasLimit *big.Int, feeToken cciptypes.Address, feeInDestNative *big.Int)
+}
+
+type laneMetricsCollector struct {
+	source, dest string
+}
+
+func NewLaneMetricsCollector(sourceChainId, destChainId int64) *laneMetricsCollector {
+	return &laneMetricsCollector{
+		source: strconv.FormatInt(sourceChainId, 10),
+		dest:   strconv.FormatInt(destChainId, 10),
+	}
+}
+
+func (l *laneMetricsCollector) MessageLatency(stage string, sentAt, includedAt time.Time) {
+	laneMessageLatency.
+		WithLabelValues(l.source, l.dest, stage).
+		Observe(includedAt.Sub(sentAt).Seconds())
+}
+
+func (l *laneMetricsCollector) MessageExecutionGas(gasUsed uint64, gasLimit *big.Int, feeToken cciptypes.Address, feeInDestNative *big.Int) {
+	laneExecGasUsed.
+		WithLabelValues(l.source, l.dest).
+		Observe(float64(gasUsed))
+	if gasUsed == 0 {
+		return
+	}
+	if gasLimit != nil && gasLimit.Sign() > 0 {
+		ratio, _ := new(big.Float).Quo(new(big.Float).SetUint64(gasUsed), new(big.Float).SetInt(gasLimit)).Float64()
+		laneExecGasLimitUsage.
+			WithLabelValues(l.source, l.dest).
+			Observe(ratio)
+	}
+	if feeInDestNative != nil {
+		feePerGas, _ := new(big.Float).Quo(new(big.Float).SetInt(feeInDestNative), new(big.Float).SetUint64(gasUsed)).Float64()
+		laneExecFeePerGas.
+			WithLabelValues(l.source, l.dest, string(feeToken)).
+			Observe(feePerGas)
+	}
+}
+
 var (
 	// NoopMetricsCollector is a no-op implementation of PluginMetricsCollector
 	NoopMetricsCollector PluginMetricsCollector = noop{}
@@ -97,3 +178,14 @@ func (d noop) UnexpiredCommitRoots(int) {
 
 func (d noop) SequenceNumber(ocrPhase, uint64) {
 }
+
+// NoopLaneMetricsCollector is a no-op implementation of LaneMetricsCollector
+var NoopLaneMetricsCollector LaneMetricsCollector = noopLane{}
+
+type noopLane struct{}
+
+func (noopLane) MessageLatency(string, time.Time, time.Time) {
+}
+
+func (noopLane) MessageExecutionGas(uint64, *big.Int, cciptypes.Address, *big.Int) {
+}
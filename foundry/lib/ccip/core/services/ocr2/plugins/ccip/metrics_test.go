@@ -1,10 +1,15 @@
 package ccip
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -45,3 +50,43 @@ func Test_UnexpiredCommitRoots(t *testing.T) {
 	collector.UnexpiredCommitRoots(5)
 	assert.Equal(t, float64(5), testutil.ToFloat64(unexpiredCommitRoots.WithLabelValues("test", "1337", "2337")))
 }
+
+func Test_LaneMessageLatency(t *testing.T) {
+	collector := NewLaneMetricsCollector(sourceChainId, destChainId)
+	sentAt := time.Now()
+
+	collector.MessageLatency(LaneStageCommit, sentAt, sentAt.Add(time.Minute))
+	collector.MessageLatency(LaneStageExec, sentAt, sentAt.Add(time.Hour))
+
+	commits := histogramByLabels(t, laneMessageLatency, "1337", "2337", LaneStageCommit)
+	assert.Equal(t, uint64(1), commits.GetSampleCount())
+	assert.Equal(t, float64(60), commits.GetSampleSum())
+	execs := histogramByLabels(t, laneMessageLatency, "1337", "2337", LaneStageExec)
+	assert.Equal(t, uint64(1), execs.GetSampleCount())
+	assert.Equal(t, float64(3600), execs.GetSampleSum())
+}
+
+func Test_LaneMessageExecutionGas(t *testing.T) {
+	collector := NewLaneMetricsCollector(destChainId, sourceChainId)
+
+	collector.MessageExecutionGas(100_000, big.NewInt(200_000), "0xfee", big.NewInt(1e15))
+	assert.Equal(t, float64(100_000), histogramByLabels(t, laneExecGasUsed, "2337", "1337").GetSampleSum())
+	assert.Equal(t, 0.5, histogramByLabels(t, laneExecGasLimitUsage, "2337", "1337").GetSampleSum())
+	assert.Equal(t, float64(1e10), histogramByLabels(t, laneExecFeePerGas, "2337", "1337", "0xfee").GetSampleSum())
+
+	// Ratios aren't recorded without gas used or gas limit
+	collector.MessageExecutionGas(0, big.NewInt(200_000), "0xfee", big.NewInt(1e15))
+	collector.MessageExecutionGas(100_000, nil, "0xfee", nil)
+	assert.Equal(t, uint64(3), histogramByLabels(t, laneExecGasUsed, "2337", "1337").GetSampleCount())
+	assert.Equal(t, uint64(1), histogramByLabels(t, laneExecGasLimitUsage, "2337", "1337").GetSampleCount())
+	assert.Equal(t, uint64(1), histogramByLabels(t, laneExecFeePerGas, "2337", "1337", "0xfee").GetSampleCount())
+}
+
+func histogramByLabels(t *testing.T, histogramVec *prometheus.HistogramVec, labels ...string) *io_prometheus_client.Histogram {
+	observer, err := histogramVec.GetMetricWithLabelValues(labels...)
+	require.NoError(t, err)
+
+	pb := &io_prometheus_client.Metric{}
+	require.NoError(t, observer.(prometheus.Histogram).Write(pb))
+	return pb.GetHistogram()
+}
@@ -0,0 +1,190 @@
+package msgtracker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// Status is the stage of its lifecycle a CCIP message is at.
+type Status string
+
+const (
+	// StatusSent messages were sent on the source chain, but are not committed yet.
+	StatusSent Status = "sent"
+	// StatusCommitted messages were committed on the destination chain, in the root of a commit report.
+	StatusCommitted Status = "committed"
+	// StatusSnoozed messages are committed, but their root was snoozed by the execution plugin, e.g. because
+	// the messages didn't fit the rate limits or weren't profitable to execute yet.
+	StatusSnoozed Status = "snoozed"
+	// StatusSuccess and StatusFailure messages were executed on the destination chain. Failed messages can
+	// still be manually executed, they are tracked until their execution succeeds.
+	StatusSuccess Status = "success"
+	StatusFailure Status = "failure"
+)
+
+// Final returns true if the message reached the end of its lifecycle.
+func (s Status) Final() bool {
+	return s == StatusSuccess
+}
+
+// Executed returns true if the message was executed on the destination chain, successfully or not.
+func (s Status) Executed() bool {
+	return s == StatusSuccess || s == StatusFailure
+}
+
+// Message is the tracked status of a CCIP message on a lane.
+type Message struct {
+	MessageID           string      `db:"message_id"`
+	SourceChainSelector uint64      `db:"source_chain_selector"`
+	DestChainSelector   uint64      `db:"dest_chain_selector"`
+	OnRamp              string      `db:"on_ramp"`
+	OffRamp             string      `db:"off_ramp"`
+	SequenceNumber      uint64      `db:"sequence_number"`
+	Sender              string      `db:"sender"`
+	Receiver            string      `db:"receiver"`
+	Status              Status      `db:"status"`
+	MerkleRoot          null.String `db:"merkle_root"`
+	SendTxHash          string      `db:"send_tx_hash"`
+	CommitTxHash        null.String `db:"commit_tx_hash"`
+	ExecutionTxHash     null.String `db:"execution_tx_hash"`
+	SentAt              time.Time   `db:"sent_at"`
+	CreatedAt           time.Time   `db:"created_at"`
+	UpdatedAt           time.Time   `db:"updated_at"`
+}
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+
+type ORM interface {
+	// UpsertMessages inserts the given sent or committed messages. Messages already tracked are only
+	// updated to move them from sent to committed, and to fill in the commit of the messages executed before
+	// their commit was tracked.
+	UpsertMessages(ctx context.Context, msgs []Message) error
+	// MarkSnoozed marks the committed messages of the given merkle root as snoozed.
+	MarkSnoozed(ctx context.Context, merkleRoot string) error
+	// MarkUnsnoozed moves the snoozed messages of the given merkle root back to committed.
+	MarkUnsnoozed(ctx context.Context, merkleRoot string) error
+	// MarkExecuted records the execution of the message with the given sequence number on the lane, it returns
+	// true if the status of the message changed. A failed message can be marked as executed again once it succeeded.
+	MarkExecuted(ctx context.Context, destChainSelector uint64, offRamp string, seqNr uint64, status Status, txHash string) (bool, error)
+	// LatestSequenceNumber returns the highest sequence number tracked on the lane, and false if none is.
+	LatestSequenceNumber(ctx context.Context, destChainSelector uint64, offRamp string) (uint64, bool, error)
+	// PendingSequenceNumbers returns the sequence numbers of the messages of the lane that aren't successfully executed yet.
+	PendingSequenceNumbers(ctx context.Context, destChainSelector uint64, offRamp string) ([]uint64, error)
+	// FindMessage returns the message with the given ID, or sql.ErrNoRows.
+	FindMessage(ctx context.Context, messageID string) (Message, error)
+	// FindMessagesBySendTxHash returns the messages sent by the given source chain transaction.
+	FindMessagesBySendTxHash(ctx context.Context, txHash string) ([]Message, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) UpsertMessages(ctx context.Context, msgs []Message) error {
+	for _, msg := range msgs {
+		_, err := o.ds.ExecContext(ctx, `
+INSERT INTO ccip_messages (message_id, source_chain_selector, dest_chain_selector, on_ramp, off_ramp, sequence_number,
+	sender, receiver, status, merkle_root, send_tx_hash, commit_tx_hash, sent_at, created_at, updated_at)
+VALUES ($1, $2::numeric, $3::numeric, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW(), NOW())
+ON CONFLICT (message_id) DO UPDATE SET
+	status = CASE WHEN ccip_messages.status = 'sent' THEN EXCLUDED.status ELSE ccip_messages.status END,
+	merkle_root = COALESCE(ccip_messages.merkle_root, EXCLUDED.merkle_root),
+	commit_tx_hash = COALESCE(ccip_messages.commit_tx_hash, EXCLUDED.commit_tx_hash),
+	updated_at = NOW()
+WHERE EXCLUDED.status <> 'sent' AND (ccip_messages.status = 'sent' OR ccip_messages.merkle_root IS NULL)`,
+			msg.MessageID, strconv.FormatUint(msg.SourceChainSelector, 10), strconv.FormatUint(msg.DestChainSelector, 10),
+			msg.OnRamp, msg.OffRamp, msg.SequenceNumber, msg.Sender, msg.Receiver, msg.Status,
+			msg.MerkleRoot, msg.SendTxHash, msg.CommitTxHash, msg.SentAt)
+		if err != nil {
+			return fmt.Errorf("failed to upsert message %s: %w", msg.MessageID, err)
+		}
+	}
+	return nil
+}
+
+func (o *orm) MarkSnoozed(ctx context.Context, merkleRoot string) error {
+	_, err := o.ds.ExecContext(ctx, `
+UPDATE ccip_messages SET status = 'snoozed', updated_at = NOW()
+WHERE merkle_root = $1 AND status = 'committed'`, merkleRoot)
+	if err != nil {
+		return fmt.Errorf("failed to mark messages of root %s as snoozed: %w", merkleRoot, err)
+	}
+	return nil
+}
+
+func (o *orm) MarkUnsnoozed(ctx context.Context, merkleRoot string) error {
+	_, err := o.ds.ExecContext(ctx, `
+UPDATE ccip_messages SET status = 'committed', updated_at = NOW()
+WHERE merkle_root = $1 AND status = 'snoozed'`, merkleRoot)
+	if err != nil {
+		return fmt.Errorf("failed to mark messages of root %s as unsnoozed: %w", merkleRoot, err)
+	}
+	return nil
+}
+
+func (o *orm) MarkExecuted(ctx context.Context, destChainSelector uint64, offRamp string, seqNr uint64, status Status, txHash string) (bool, error) {
+	if !status.Executed() {
+		return false, fmt.Errorf("%s is not an execution status", status)
+	}
+	res, err := o.ds.ExecContext(ctx, `
+UPDATE ccip_messages SET status = $4, execution_tx_hash = $5, updated_at = NOW()
+WHERE dest_chain_selector = $1::numeric AND off_ramp = $2 AND sequence_number = $3 AND status NOT IN ('success', $4)`,
+		strconv.FormatUint(destChainSelector, 10), offRamp, seqNr, status, txHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark message %d as executed: %w", seqNr, err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+func (o *orm) LatestSequenceNumber(ctx context.Context, destChainSelector uint64, offRamp string) (uint64, bool, error) {
+	var seqNr null.Int
+	err := o.ds.GetContext(ctx, &seqNr, `
+SELECT MAX(sequence_number) FROM ccip_messages WHERE dest_chain_selector = $1::numeric AND off_ramp = $2`,
+		strconv.FormatUint(destChainSelector, 10), offRamp)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get latest sequence number: %w", err)
+	}
+	return uint64(seqNr.Int64), seqNr.Valid, nil
+}
+
+func (o *orm) PendingSequenceNumbers(ctx context.Context, destChainSelector uint64, offRamp string) ([]uint64, error) {
+	var seqNrs []uint64
+	err := o.ds.SelectContext(ctx, &seqNrs, `
+SELECT sequence_number FROM ccip_messages
+WHERE dest_chain_selector = $1::numeric AND off_ramp = $2 AND status <> 'success'
+ORDER BY sequence_number`,
+		strconv.FormatUint(destChainSelector, 10), offRamp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending sequence numbers: %w", err)
+	}
+	return seqNrs, nil
+}
+
+func (o *orm) FindMessage(ctx context.Context, messageID string) (msg Message, err error) {
+	err = o.ds.GetContext(ctx, &msg, `SELECT * FROM ccip_messages WHERE message_id = $1`, messageID)
+	return msg, err
+}
+
+func (o *orm) FindMessagesBySendTxHash(ctx context.Context, txHash string) (msgs []Message, err error) {
+	err = o.ds.SelectContext(ctx, &msgs, `SELECT * FROM ccip_messages WHERE send_tx_hash = $1 ORDER BY sequence_number`, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find messages sent by %s: %w", txHash, err)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,141 @@
+package msgtracker_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
+)
+
+func TestORM(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm := msgtracker.NewORM(pgtest.NewSqlxDB(t))
+
+	_, ok, err := orm.LatestSequenceNumber(ctx, destChainSelector, string(offRampAddress))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	sent := []msgtracker.Message{trackedMessage(1, msgtracker.StatusSent, "0xsend1"), trackedMessage(2, msgtracker.StatusSent, "0xsend2")}
+	require.NoError(t, orm.UpsertMessages(ctx, sent))
+
+	latest, ok, err := orm.LatestSequenceNumber(ctx, destChainSelector, string(offRampAddress))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), latest)
+
+	msgs, err := orm.FindMessagesBySendTxHash(ctx, "0xsend2")
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, uint64(2), msgs[0].SequenceNumber)
+
+	// Committing moves the messages from sent to committed.
+	committed := trackedMessage(1, msgtracker.StatusCommitted, "0xsend1")
+	committed.MerkleRoot = null.StringFrom("0xroot")
+	committed.CommitTxHash = null.StringFrom("0xcommit")
+	require.NoError(t, orm.UpsertMessages(ctx, []msgtracker.Message{committed}))
+
+	msg, err := orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusCommitted, msg.Status)
+	assert.Equal(t, destChainSelector, msg.DestChainSelector)
+	assert.Equal(t, "0xroot", msg.MerkleRoot.String)
+
+	// Tracking a message as sent again doesn't regress it.
+	require.NoError(t, orm.UpsertMessages(ctx, sent[:1]))
+	msg, err = orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusCommitted, msg.Status)
+
+	require.NoError(t, orm.MarkSnoozed(ctx, "0xroot"))
+	msg, err = orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusSnoozed, msg.Status)
+
+	// Snoozed messages are committed again once the root is unsnoozed, and can be snoozed again.
+	require.NoError(t, orm.MarkUnsnoozed(ctx, "0xroot"))
+	msg, err = orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusCommitted, msg.Status)
+
+	require.NoError(t, orm.MarkSnoozed(ctx, "0xroot"))
+	msg, err = orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusSnoozed, msg.Status)
+
+	updated, err := orm.MarkExecuted(ctx, destChainSelector, string(offRampAddress), 1, msgtracker.StatusFailure, "0xexec")
+	require.NoError(t, err)
+	assert.True(t, updated)
+	msg, err = orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusFailure, msg.Status)
+	assert.Equal(t, "0xexec", msg.ExecutionTxHash.String)
+
+	// Failed messages stay pending until they are manually executed.
+	pending, err := orm.PendingSequenceNumbers(ctx, destChainSelector, string(offRampAddress))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, pending)
+
+	updated, err = orm.MarkExecuted(ctx, destChainSelector, string(offRampAddress), 1, msgtracker.StatusFailure, "0xexec")
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	updated, err = orm.MarkExecuted(ctx, destChainSelector, string(offRampAddress), 1, msgtracker.StatusSuccess, "0xmanualexec")
+	require.NoError(t, err)
+	assert.True(t, updated)
+	msg, err = orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusSuccess, msg.Status)
+	assert.Equal(t, "0xmanualexec", msg.ExecutionTxHash.String)
+
+	pending, err = orm.PendingSequenceNumbers(ctx, destChainSelector, string(offRampAddress))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2}, pending)
+
+	_, err = orm.MarkExecuted(ctx, destChainSelector, string(offRampAddress), 2, msgtracker.StatusSnoozed, "0xexec")
+	require.Error(t, err)
+
+	_, err = orm.FindMessage(ctx, trackedMessage(3, msgtracker.StatusSent, "").MessageID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_UpsertMessagesExecutedBeforeCommit(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm := msgtracker.NewORM(pgtest.NewSqlxDB(t))
+
+	sent := trackedMessage(1, msgtracker.StatusSent, "0xsend1")
+	require.NoError(t, orm.UpsertMessages(ctx, []msgtracker.Message{sent}))
+
+	// The execution is tracked before the commit.
+	updated, err := orm.MarkExecuted(ctx, destChainSelector, string(offRampAddress), 1, msgtracker.StatusSuccess, "0xexec")
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	committed := trackedMessage(1, msgtracker.StatusCommitted, "0xsend1")
+	committed.MerkleRoot = null.StringFrom("0xroot")
+	committed.CommitTxHash = null.StringFrom("0xcommit")
+	require.NoError(t, orm.UpsertMessages(ctx, []msgtracker.Message{committed}))
+
+	// The commit is backfilled without moving the message back to committed.
+	msg, err := orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, msgtracker.StatusSuccess, msg.Status)
+	assert.Equal(t, "0xroot", msg.MerkleRoot.String)
+	assert.Equal(t, "0xcommit", msg.CommitTxHash.String)
+	assert.Equal(t, "0xexec", msg.ExecutionTxHash.String)
+
+	// A commit already recorded isn't overwritten.
+	recommitted := committed
+	recommitted.MerkleRoot = null.StringFrom("0xotherroot")
+	recommitted.CommitTxHash = null.StringFrom("0xothercommit")
+	require.NoError(t, orm.UpsertMessages(ctx, []msgtracker.Message{recommitted}))
+	msg, err = orm.FindMessage(ctx, committed.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, "0xroot", msg.MerkleRoot.String)
+	assert.Equal(t, "0xcommit", msg.CommitTxHash.String)
+}
@@ -0,0 +1,428 @@
+package msgtracker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+const (
+	defaultPollInterval = 30 * time.Second
+	// maxSentMessagesPerPoll limits the number of new sent messages fetched from the onRamp in a single poll.
+	maxSentMessagesPerPoll = 500
+	// maxExecutionSeqNrSpan limits the sequence number range the execution state changes are fetched for in a single poll.
+	maxExecutionSeqNrSpan = 500
+)
+
+// Tracker tracks the lifecycle of the messages of a lane, from their onRamp send request to their execution on the offRamp.
+type Tracker interface {
+	job.ServiceCtx
+	// Snoozed records that the execution plugin snoozed the given commit root.
+	Snoozed(ctx context.Context, merkleRoot [32]byte)
+	// Unsnoozed records that the execution plugin considers the given commit root again, once its snooze expired.
+	Unsnoozed(ctx context.Context, merkleRoot [32]byte)
+	// TokenPrices records the latest USD prices read by the execution plugin, of the source fee tokens and of the
+	// destination native token. They convert the fees paid by the senders to the destination native token.
+	TokenPrices(sourceTokenPricesUSD map[cciptypes.Address]*big.Int, destNativePriceUSD *big.Int)
+}
+
+// ReceiptFinder finds the receipts of the destination chain transactions sent by the node, it is implemented by the
+// txmgr of the chain. Executions transmitted by other nodes have no receipt.
+type ReceiptFinder interface {
+	// FindReceiptByTxHash returns the receipt of the given transaction, or sql.ErrNoRows.
+	FindReceiptByTxHash(ctx context.Context, hash common.Hash) (txmgrtypes.ChainReceipt[common.Hash, common.Hash], error)
+}
+
+type tracker struct {
+	lggr        logger.Logger
+	orm         ORM
+	onRamp      ccipdata.OnRampReader
+	commitStore ccipdata.CommitStoreReader
+	offRamp     ccipdata.OffRampReader
+	receipts    ReceiptFinder
+	metrics     ccip.LaneMetricsCollector
+
+	sourceChainSelector uint64
+	destChainSelector   uint64
+	onRampAddress       string
+	offRampAddress      string
+	pollInterval        time.Duration
+
+	// commitsSince is the block timestamp commit reports are fetched from, it is initialized
+	// to the permissionless execution threshold on the first poll. The latency of the messages
+	// committed before that first poll isn't measured, it was by the previous run of the tracker.
+	commitsSince time.Time
+	// executionsFrom is the sequence number the next poll checks the executions from. Pending messages are
+	// checked round-robin, maxExecutionSeqNrSpan at a time, so that old messages left unexecuted don't widen the range.
+	executionsFrom uint64
+
+	pricesMu             sync.RWMutex
+	sourceTokenPricesUSD map[cciptypes.Address]*big.Int
+	destNativePriceUSD   *big.Int
+
+	services.StateMachine
+	wg     sync.WaitGroup
+	stopCh services.StopChan
+}
+
+func NewTracker(
+	lggr logger.Logger,
+	orm ORM,
+	onRamp ccipdata.OnRampReader,
+	commitStore ccipdata.CommitStoreReader,
+	offRamp ccipdata.OffRampReader,
+	receipts ReceiptFinder,
+	metrics ccip.LaneMetricsCollector,
+	sourceChainSelector uint64,
+	destChainSelector uint64,
+	onRampAddress cciptypes.Address,
+	offRampAddress cciptypes.Address,
+) Tracker {
+	return newTracker(lggr, orm, onRamp, commitStore, offRamp, receipts, metrics, sourceChainSelector, destChainSelector, onRampAddress, offRampAddress, defaultPollInterval)
+}
+
+func newTracker(
+	lggr logger.Logger,
+	orm ORM,
+	onRamp ccipdata.OnRampReader,
+	commitStore ccipdata.CommitStoreReader,
+	offRamp ccipdata.OffRampReader,
+	receipts ReceiptFinder,
+	metrics ccip.LaneMetricsCollector,
+	sourceChainSelector uint64,
+	destChainSelector uint64,
+	onRampAddress cciptypes.Address,
+	offRampAddress cciptypes.Address,
+	pollInterval time.Duration,
+) *tracker {
+	return &tracker{
+		lggr:                lggr.Named("MessageTracker"),
+		orm:                 orm,
+		onRamp:              onRamp,
+		commitStore:         commitStore,
+		offRamp:             offRamp,
+		receipts:            receipts,
+		metrics:             metrics,
+		sourceChainSelector: sourceChainSelector,
+		destChainSelector:   destChainSelector,
+		onRampAddress:       string(onRampAddress),
+		offRampAddress:      string(offRampAddress),
+		pollInterval:        pollInterval,
+		stopCh:              make(services.StopChan),
+	}
+}
+
+func (t *tracker) Start(context.Context) error {
+	return t.StartOnce("MessageTracker", func() error {
+		t.wg.Add(1)
+		go t.run()
+		return nil
+	})
+}
+
+func (t *tracker) Close() error {
+	return t.StopOnce("MessageTracker", func() error {
+		close(t.stopCh)
+		t.wg.Wait()
+		return nil
+	})
+}
+
+func (t *tracker) Snoozed(ctx context.Context, merkleRoot [32]byte) {
+	if err := t.orm.MarkSnoozed(ctx, hexutil.Encode(merkleRoot[:])); err != nil {
+		t.lggr.Errorw("Failed to mark root as snoozed", "root", hexutil.Encode(merkleRoot[:]), "err", err)
+	}
+}
+
+func (t *tracker) Unsnoozed(ctx context.Context, merkleRoot [32]byte) {
+	if err := t.orm.MarkUnsnoozed(ctx, hexutil.Encode(merkleRoot[:])); err != nil {
+		t.lggr.Errorw("Failed to mark root as unsnoozed", "root", hexutil.Encode(merkleRoot[:]), "err", err)
+	}
+}
+
+func (t *tracker) TokenPrices(sourceTokenPricesUSD map[cciptypes.Address]*big.Int, destNativePriceUSD *big.Int) {
+	t.pricesMu.Lock()
+	defer t.pricesMu.Unlock()
+	t.sourceTokenPricesUSD = sourceTokenPricesUSD
+	t.destNativePriceUSD = destNativePriceUSD
+}
+
+// feeInDestNative converts the fee paid by a sender to the smallest denomination of the destination native token,
+// it returns nil until the execution plugin read the prices of the fee token.
+func (t *tracker) feeInDestNative(feeToken cciptypes.Address, feeTokenAmount *big.Int) *big.Int {
+	t.pricesMu.RLock()
+	defer t.pricesMu.RUnlock()
+	feeTokenPrice, ok := t.sourceTokenPricesUSD[feeToken]
+	if !ok || feeTokenAmount == nil || t.destNativePriceUSD == nil || t.destNativePriceUSD.Sign() <= 0 {
+		return nil
+	}
+	// Both prices are in USD per 1e18 of the smallest denomination of the tokens, the scale cancels out.
+	fee := new(big.Int).Mul(feeTokenAmount, feeTokenPrice)
+	return fee.Div(fee, t.destNativePriceUSD)
+}
+
+func (t *tracker) run() {
+	defer t.wg.Done()
+	ctx, cancel := t.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(t.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := t.poll(ctx); err != nil && ctx.Err() == nil {
+			t.lggr.Errorw("Failed to track messages", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll tracks the newly committed, sent and executed messages of the lane.
+func (t *tracker) poll(ctx context.Context) error {
+	if err := t.trackCommits(ctx); err != nil {
+		return fmt.Errorf("track commits: %w", err)
+	}
+	if err := t.trackSent(ctx); err != nil {
+		return fmt.Errorf("track sent messages: %w", err)
+	}
+	if err := t.trackExecutions(ctx); err != nil {
+		return fmt.Errorf("track executions: %w", err)
+	}
+	return nil
+}
+
+func (t *tracker) trackCommits(ctx context.Context) error {
+	measureLatency := true
+	if t.commitsSince.IsZero() {
+		onchainConfig, err := t.offRamp.OnchainConfig(ctx)
+		if err != nil {
+			return err
+		}
+		t.commitsSince = time.Now().Add(-onchainConfig.PermissionLessExecutionThresholdSeconds)
+		measureLatency = false
+	}
+	// Reports at commitsSince were already fetched by the previous poll
+	measuredSince := t.commitsSince
+
+	reports, err := t.commitStore.GetAcceptedCommitReportsGteTimestamp(ctx, t.commitsSince, 0)
+	if err != nil {
+		return err
+	}
+	for _, report := range reports {
+		sendRequests, err := t.onRamp.GetSendRequestsBetweenSeqNums(ctx, report.Interval.Min, report.Interval.Max, false)
+		if err != nil {
+			return err
+		}
+
+		msgs := make([]Message, len(sendRequests))
+		for i, req := range sendRequests {
+			msgs[i] = t.newMessage(req, StatusCommitted)
+			msgs[i].MerkleRoot = null.StringFrom(hexutil.Encode(report.MerkleRoot[:]))
+			msgs[i].CommitTxHash = null.StringFrom(report.TxHash)
+		}
+		if err = t.orm.UpsertMessages(ctx, msgs); err != nil {
+			return err
+		}
+
+		ts := time.UnixMilli(report.BlockTimestampUnixMilli)
+		if measureLatency && ts.After(measuredSince) {
+			for _, msg := range msgs {
+				t.metrics.MessageLatency(ccip.LaneStageCommit, msg.SentAt, ts)
+			}
+		}
+		if ts.After(t.commitsSince) {
+			t.commitsSince = ts
+		}
+	}
+	return nil
+}
+
+func (t *tracker) trackSent(ctx context.Context) error {
+	latest, ok, err := t.orm.LatestSequenceNumber(ctx, t.destChainSelector, t.offRampAddress)
+	if err != nil {
+		return err
+	}
+	next := latest + 1
+	if !ok {
+		// Nothing is tracked yet, messages that are already committed are picked up with the commit reports.
+		next, err = t.commitStore.GetExpectedNextSequenceNumber(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	sendRequests, err := t.onRamp.GetSendRequestsBetweenSeqNums(ctx, next, next+maxSentMessagesPerPoll-1, false)
+	if err != nil {
+		return err
+	}
+	msgs := make([]Message, len(sendRequests))
+	for i, req := range sendRequests {
+		msgs[i] = t.newMessage(req, StatusSent)
+	}
+	return t.orm.UpsertMessages(ctx, msgs)
+}
+
+func (t *tracker) trackExecutions(ctx context.Context) error {
+	pending, err := t.orm.PendingSequenceNumbers(ctx, t.destChainSelector, t.offRampAddress)
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+
+	// Check the pending messages from executionsFrom on, and wrap around to the oldest one.
+	first := sort.Search(len(pending), func(i int) bool { return pending[i] >= t.executionsFrom })
+	if first == len(pending) {
+		first = 0
+	}
+	minSeqNr := pending[first]
+	maxSeqNr := min(minSeqNr+maxExecutionSeqNrSpan-1, pending[len(pending)-1])
+	pending = pending[first:]
+	pending = pending[:sort.Search(len(pending), func(i int) bool { return pending[i] > maxSeqNr })]
+
+	stateChanges, err := t.offRamp.GetExecutionStateChangesBetweenSeqNums(ctx, minSeqNr, maxSeqNr, 0)
+	if err != nil {
+		return err
+	}
+	t.executionsFrom = maxSeqNr + 1
+	// A failed message can be executed again, manually, only its latest state change is relevant.
+	latestStateChanges := make(map[uint64]cciptypes.ExecutionStateChangedWithTxMeta, len(stateChanges))
+	for _, stateChange := range stateChanges {
+		latestStateChanges[stateChange.SequenceNumber] = stateChange
+	}
+
+	var executed []cciptypes.ExecutionStateChangedWithTxMeta
+	for _, seqNr := range pending {
+		stateChange, ok := latestStateChanges[seqNr]
+		// Wait for the execution to be finalized, it could otherwise be reorged out.
+		if !ok || !stateChange.Finalized {
+			continue
+		}
+
+		state, err := t.offRamp.GetExecutionState(ctx, stateChange.SequenceNumber)
+		if err != nil {
+			return err
+		}
+		var status Status
+		switch cciptypes.MessageExecutionState(state) {
+		case cciptypes.ExecutionStateSuccess:
+			status = StatusSuccess
+		case cciptypes.ExecutionStateFailure:
+			status = StatusFailure
+		default:
+			continue
+		}
+
+		updated, err := t.orm.MarkExecuted(ctx, t.destChainSelector, t.offRampAddress, stateChange.SequenceNumber, status, stateChange.TxHash)
+		if err != nil {
+			return err
+		}
+		// Failed messages stay pending, they are only measured once.
+		if updated {
+			executed = append(executed, stateChange)
+		}
+	}
+
+	if len(executed) > 0 {
+		t.measureExecutions(ctx, executed, stateChanges)
+	}
+	return nil
+}
+
+// measureExecutions records the latency and gas metrics of the newly executed messages. The gas spent by an
+// execution transaction is split evenly between the messages it executed, the ones of stateChanges.
+func (t *tracker) measureExecutions(ctx context.Context, executed, stateChanges []cciptypes.ExecutionStateChangedWithTxMeta) {
+	minSeqNr, maxSeqNr := executed[0].SequenceNumber, executed[0].SequenceNumber
+	for _, stateChange := range executed {
+		minSeqNr = min(minSeqNr, stateChange.SequenceNumber)
+		maxSeqNr = max(maxSeqNr, stateChange.SequenceNumber)
+	}
+	sendRequests, err := t.onRamp.GetSendRequestsBetweenSeqNums(ctx, minSeqNr, maxSeqNr, false)
+	if err != nil {
+		t.lggr.Warnw("Failed to get the send requests of executed messages", "err", err)
+		return
+	}
+	sent := make(map[uint64]cciptypes.EVM2EVMMessageWithTxMeta, len(sendRequests))
+	for _, req := range sendRequests {
+		sent[req.SequenceNumber] = req
+	}
+
+	txMessages := make(map[string]uint64)
+	for _, stateChange := range stateChanges {
+		txMessages[stateChange.TxHash]++
+	}
+	receipts := make(map[string]txmgrtypes.ChainReceipt[common.Hash, common.Hash])
+
+	for _, stateChange := range executed {
+		req, ok := sent[stateChange.SequenceNumber]
+		if !ok {
+			continue
+		}
+		t.metrics.MessageLatency(ccip.LaneStageExec, time.UnixMilli(req.BlockTimestampUnixMilli), time.UnixMilli(stateChange.BlockTimestampUnixMilli))
+
+		receipt, ok := receipts[stateChange.TxHash]
+		if !ok {
+			receipt, err = t.receipts.FindReceiptByTxHash(ctx, common.HexToHash(stateChange.TxHash))
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				t.lggr.Warnw("Failed to find the receipt of an execution", "txHash", stateChange.TxHash, "err", err)
+			}
+			receipts[stateChange.TxHash] = receipt
+		}
+		if receipt == nil {
+			// The execution was transmitted by another node
+			continue
+		}
+		t.metrics.MessageExecutionGas(receipt.GetFeeUsed()/txMessages[stateChange.TxHash], req.GasLimit, req.FeeToken, t.feeInDestNative(req.FeeToken, req.FeeTokenAmount))
+	}
+}
+
+func (t *tracker) newMessage(req cciptypes.EVM2EVMMessageWithTxMeta, status Status) Message {
+	return Message{
+		MessageID:           req.MessageID.String(),
+		SourceChainSelector: t.sourceChainSelector,
+		DestChainSelector:   t.destChainSelector,
+		OnRamp:              t.onRampAddress,
+		OffRamp:             t.offRampAddress,
+		SequenceNumber:      req.SequenceNumber,
+		Sender:              string(req.Sender),
+		Receiver:            string(req.Receiver),
+		Status:              status,
+		SendTxHash:          req.TxHash,
+		SentAt:              time.UnixMilli(req.BlockTimestampUnixMilli),
+	}
+}
+
+// ParseMessageID returns the message ID in the format it is tracked with: 0x prefixed lower case hex.
+func ParseMessageID(id string) (string, error) {
+	if !strings.HasPrefix(id, "0x") {
+		id = "0x" + id
+	}
+	b, err := hexutil.Decode(strings.ToLower(id))
+	if err != nil {
+		return "", fmt.Errorf("invalid message ID %q: %w", id, err)
+	}
+	if len(b) != len(cciptypes.Hash{}) {
+		return "", fmt.Errorf("invalid message ID %q: expected %d bytes, got %d", id, len(cciptypes.Hash{}), len(b))
+	}
+	return cciptypes.Hash(b).String(), nil
+}
@@ -0,0 +1,40 @@
+package msgtracker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+// pendingORM only tracks the pending sequence numbers of a lane.
+type pendingORM struct {
+	ORM
+	pending []uint64
+}
+
+func (o *pendingORM) PendingSequenceNumbers(context.Context, uint64, string) ([]uint64, error) {
+	return o.pending, nil
+}
+
+func TestTracker_trackExecutionsRoundRobin(t *testing.T) {
+	ctx := testutils.Context(t)
+	offRamp := ccipdatamocks.NewOffRampReader(t)
+
+	// Message 1 is left unexecuted, far behind the recent messages.
+	orm := &pendingORM{pending: []uint64{1, 2_000, 2_001, 2_600}}
+	tr := newTracker(logger.TestLogger(t), orm, nil, nil, offRamp, nil, nil, 1, 2, "0x1", "0x2", defaultPollInterval)
+
+	for _, seqNrRange := range [][2]uint64{{1, 500}, {2_000, 2_499}, {2_600, 2_600}, {1, 500}} {
+		offRamp.On("GetExecutionStateChangesBetweenSeqNums", mock.Anything, seqNrRange[0], seqNrRange[1], 0).
+			Return([]cciptypes.ExecutionStateChangedWithTxMeta{}, nil).Once()
+		require.NoError(t, tr.trackExecutions(ctx))
+	}
+}
@@ -0,0 +1,207 @@
+package msgtracker_test
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker/mocks"
+)
+
+const (
+	sourceChainSelector = uint64(5009297550715157269)
+	destChainSelector   = uint64(16015286601757825753)
+	onRampAddress       = cciptypes.Address("0x1111111111111111111111111111111111111111")
+	offRampAddress      = cciptypes.Address("0x2222222222222222222222222222222222222222")
+)
+
+func sendRequest(seqNr uint64, txHash string) cciptypes.EVM2EVMMessageWithTxMeta {
+	return cciptypes.EVM2EVMMessageWithTxMeta{
+		TxMeta: cciptypes.TxMeta{TxHash: txHash, BlockTimestampUnixMilli: 1_700_000_000_000},
+		EVM2EVMMessage: cciptypes.EVM2EVMMessage{
+			SequenceNumber: seqNr,
+			MessageID:      cciptypes.Hash{byte(seqNr)},
+			Sender:         "0x3333333333333333333333333333333333333333",
+			Receiver:       "0x4444444444444444444444444444444444444444",
+		},
+	}
+}
+
+func trackedMessage(seqNr uint64, status msgtracker.Status, txHash string) msgtracker.Message {
+	return msgtracker.Message{
+		MessageID:           cciptypes.Hash{byte(seqNr)}.String(),
+		SourceChainSelector: sourceChainSelector,
+		DestChainSelector:   destChainSelector,
+		OnRamp:              string(onRampAddress),
+		OffRamp:             string(offRampAddress),
+		SequenceNumber:      seqNr,
+		Sender:              "0x3333333333333333333333333333333333333333",
+		Receiver:            "0x4444444444444444444444444444444444444444",
+		Status:              status,
+		SendTxHash:          txHash,
+		SentAt:              time.UnixMilli(1_700_000_000_000),
+	}
+}
+
+func TestTracker(t *testing.T) {
+	orm := mocks.NewORM(t)
+	onRamp := ccipdatamocks.NewOnRampReader(t)
+	commitStore := ccipdatamocks.NewCommitStoreReader(t)
+	offRamp := ccipdatamocks.NewOffRampReader(t)
+
+	root := [32]byte{0xaa}
+	offRamp.On("OnchainConfig", mock.Anything).Return(cciptypes.ExecOnchainConfig{PermissionLessExecutionThresholdSeconds: time.Hour}, nil)
+
+	// Messages 1 and 2 are committed.
+	commitStore.On("GetAcceptedCommitReportsGteTimestamp", mock.Anything, mock.Anything, 0).Return([]cciptypes.CommitStoreReportWithTxMeta{{
+		TxMeta: cciptypes.TxMeta{TxHash: "0xcommit", BlockTimestampUnixMilli: time.Now().UnixMilli()},
+		CommitStoreReport: cciptypes.CommitStoreReport{
+			Interval:   cciptypes.CommitStoreInterval{Min: 1, Max: 2},
+			MerkleRoot: root,
+		},
+	}}, nil)
+	sentReqs := []cciptypes.EVM2EVMMessageWithTxMeta{sendRequest(1, "0xsend1"), sendRequest(2, "0xsend2")}
+	for i := range sentReqs {
+		sentReqs[i].GasLimit = big.NewInt(200_000)
+		sentReqs[i].FeeToken = "0x5555555555555555555555555555555555555555"
+		sentReqs[i].FeeTokenAmount = big.NewInt(1e15)
+	}
+	onRamp.On("GetSendRequestsBetweenSeqNums", mock.Anything, uint64(1), uint64(2), false).Return(sentReqs, nil)
+	committed := []msgtracker.Message{trackedMessage(1, msgtracker.StatusCommitted, "0xsend1"), trackedMessage(2, msgtracker.StatusCommitted, "0xsend2")}
+	for i := range committed {
+		committed[i].MerkleRoot = null.StringFrom(hexutil.Encode(root[:]))
+		committed[i].CommitTxHash = null.StringFrom("0xcommit")
+	}
+	orm.On("UpsertMessages", mock.Anything, committed).Return(nil)
+
+	// Message 3 is only sent.
+	orm.On("LatestSequenceNumber", mock.Anything, destChainSelector, string(offRampAddress)).Return(uint64(2), true, nil)
+	onRamp.On("GetSendRequestsBetweenSeqNums", mock.Anything, uint64(3), uint64(502), false).
+		Return([]cciptypes.EVM2EVMMessageWithTxMeta{sendRequest(3, "0xsend3")}, nil)
+	orm.On("UpsertMessages", mock.Anything, []msgtracker.Message{trackedMessage(3, msgtracker.StatusSent, "0xsend3")}).Return(nil)
+
+	// Messages 1 and 2 were executed by the same transaction, the execution of message 3 isn't finalized yet.
+	orm.On("PendingSequenceNumbers", mock.Anything, destChainSelector, string(offRampAddress)).Return([]uint64{1, 2, 3}, nil)
+	offRamp.On("GetExecutionStateChangesBetweenSeqNums", mock.Anything, uint64(1), uint64(3), 0).Return([]cciptypes.ExecutionStateChangedWithTxMeta{
+		{TxMeta: cciptypes.TxMeta{TxHash: "0xexec1"}, ExecutionStateChanged: cciptypes.ExecutionStateChanged{SequenceNumber: 1, Finalized: true}},
+		{TxMeta: cciptypes.TxMeta{TxHash: "0xexec1"}, ExecutionStateChanged: cciptypes.ExecutionStateChanged{SequenceNumber: 2, Finalized: true}},
+		{TxMeta: cciptypes.TxMeta{TxHash: "0xexec3"}, ExecutionStateChanged: cciptypes.ExecutionStateChanged{SequenceNumber: 3, Finalized: false}},
+	}, nil)
+	offRamp.On("GetExecutionState", mock.Anything, uint64(1)).Return(uint8(cciptypes.ExecutionStateSuccess), nil)
+	offRamp.On("GetExecutionState", mock.Anything, uint64(2)).Return(uint8(cciptypes.ExecutionStateFailure), nil)
+	orm.On("MarkExecuted", mock.Anything, destChainSelector, string(offRampAddress), uint64(1), msgtracker.StatusSuccess, "0xexec1").
+		Return(true, nil).Once()
+	orm.On("MarkExecuted", mock.Anything, destChainSelector, string(offRampAddress), uint64(2), msgtracker.StatusFailure, "0xexec1").
+		Return(true, nil).Once()
+
+	// The execution transaction was sent by this node.
+	receipts := fakeReceipts{common.HexToHash("0xexec1"): {GasUsed: 300_000}}
+	metrics := newFakeLaneMetrics()
+
+	tracker := msgtracker.NewTracker(logger.TestLogger(t), orm, onRamp, commitStore, offRamp, receipts, metrics, sourceChainSelector, destChainSelector, onRampAddress, offRampAddress)
+	// The fee token is worth half of the destination native token
+	tracker.TokenPrices(map[cciptypes.Address]*big.Int{"0x5555555555555555555555555555555555555555": big.NewInt(2e18)}, big.NewInt(4e18))
+	require.NoError(t, tracker.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, tracker.Close()) })
+
+	for range sentReqs {
+		select {
+		case gas := <-metrics.gas:
+			// The gas of the execution transaction is split between the messages it executed
+			assert.Equal(t, uint64(150_000), gas.gasUsed)
+			assert.Equal(t, big.NewInt(200_000), gas.gasLimit)
+			assert.Equal(t, cciptypes.Address("0x5555555555555555555555555555555555555555"), gas.feeToken)
+			assert.Equal(t, big.NewInt(5e14), gas.feeInDestNative)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for the execution to be measured")
+		}
+	}
+	// Commits of the first poll aren't measured, they were by the previous run of the tracker
+	for range sentReqs {
+		assert.Equal(t, ccip.LaneStageExec, (<-metrics.latency).stage)
+	}
+	assert.Empty(t, metrics.latency)
+}
+
+type fakeReceipts map[common.Hash]*evmtypes.Receipt
+
+func (f fakeReceipts) FindReceiptByTxHash(_ context.Context, hash common.Hash) (txmgrtypes.ChainReceipt[common.Hash, common.Hash], error) {
+	if r, ok := f[hash]; ok {
+		return r, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+type latencyObservation struct {
+	stage              string
+	sentAt, includedAt time.Time
+}
+
+type gasObservation struct {
+	gasUsed         uint64
+	gasLimit        *big.Int
+	feeToken        cciptypes.Address
+	feeInDestNative *big.Int
+}
+
+type fakeLaneMetrics struct {
+	latency chan latencyObservation
+	gas     chan gasObservation
+}
+
+func newFakeLaneMetrics() *fakeLaneMetrics {
+	return &fakeLaneMetrics{latency: make(chan latencyObservation, 100), gas: make(chan gasObservation, 100)}
+}
+
+func (f *fakeLaneMetrics) MessageLatency(stage string, sentAt, includedAt time.Time) {
+	f.latency <- latencyObservation{stage, sentAt, includedAt}
+}
+
+func (f *fakeLaneMetrics) MessageExecutionGas(gasUsed uint64, gasLimit *big.Int, feeToken cciptypes.Address, feeInDestNative *big.Int) {
+	f.gas <- gasObservation{gasUsed, gasLimit, feeToken, feeInDestNative}
+}
+
+func TestTracker_Snoozed(t *testing.T) {
+	orm := mocks.NewORM(t)
+	tracker := msgtracker.NewTracker(logger.TestLogger(t), orm, nil, nil, nil, nil, ccip.NoopLaneMetricsCollector, sourceChainSelector, destChainSelector, onRampAddress, offRampAddress)
+
+	root := [32]byte{0xbb}
+	orm.On("MarkSnoozed", mock.Anything, hexutil.Encode(root[:])).Return(nil).Once()
+	tracker.Snoozed(testutils.Context(t), root)
+
+	orm.On("MarkUnsnoozed", mock.Anything, hexutil.Encode(root[:])).Return(nil).Once()
+	tracker.Unsnoozed(testutils.Context(t), root)
+}
+
+func TestParseMessageID(t *testing.T) {
+	id := cciptypes.Hash{0xab, 0xcd}
+
+	for _, s := range []string{id.String(), id.String()[2:], "0xABCD" + id.String()[6:]} {
+		parsed, err := msgtracker.ParseMessageID(s)
+		require.NoError(t, err)
+		assert.Equal(t, id.String(), parsed)
+	}
+
+	_, err := msgtracker.ParseMessageID("0x1234")
+	require.ErrorContains(t, err, "expected 32 bytes, got 2")
+	_, err = msgtracker.ParseMessageID("not hex")
+	require.ErrorContains(t, err, "invalid message ID")
+}
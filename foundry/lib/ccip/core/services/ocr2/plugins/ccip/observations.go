@@ -22,6 +22,8 @@ type CommitObservation struct {
 	Interval          cciptypes.CommitStoreInterval  `json:"interval"`
 	TokenPricesUSD    map[cciptypes.Address]*big.Int `json:"tokensPerFeeCoin"`
 	SourceGasPriceUSD *big.Int                       `json:"sourceGasPrice"`
+	// Observer is the oracle the observation is attributed to by OCR, it isn't part of the observation itself.
+	Observer commontypes.OracleID `json:"-"`
 }
 
 // Marshal MUST be used instead of raw json.Marshal(o) since it contains backwards compatibility related changes.
@@ -111,6 +113,9 @@ func GetParsableObservations[O CommitObservation | ExecutionObservation](l logge
 			l.Errorw("Received unmarshallable observation", "err", err, "observation", string(ao.Observation), "observer", ao.Observer)
 			continue
 		}
+		if commitObs, ok := any(&ob).(*CommitObservation); ok {
+			commitObs.Observer = ao.Observer
+		}
 		parseableObservations = append(parseableObservations, ob)
 		observers = append(observers, ao.Observer)
 	}
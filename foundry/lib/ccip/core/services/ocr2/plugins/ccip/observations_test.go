@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/libocr/commontypes"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
@@ -28,9 +29,10 @@ func TestObservationFilter(t *testing.T) {
 	obs1 := CommitObservation{Interval: cciptypes.CommitStoreInterval{Min: 1, Max: 10}}
 	b1, err := obs1.Marshal()
 	require.NoError(t, err)
-	nonEmpty := GetParsableObservations[CommitObservation](lggr, []types.AttributedObservation{{Observation: b1}, {Observation: []byte{}}})
+	nonEmpty := GetParsableObservations[CommitObservation](lggr, []types.AttributedObservation{{Observation: b1, Observer: 3}, {Observation: []byte{}}})
 	require.Equal(t, 1, len(nonEmpty))
 	assert.Equal(t, nonEmpty[0].Interval, obs1.Interval)
+	assert.Equal(t, commontypes.OracleID(3), nonEmpty[0].Observer)
 }
 
 // After 1.2, the observation struct is version agnostic
@@ -255,7 +257,7 @@ func TestAddressEncodingBackwardsCompatibility(t *testing.T) {
 
 		fields := testhelpers.FindStructFieldsOfCertainType(
 			"ccip.Address",
-			config.CommitPluginJobSpecConfig{PriceGetterConfig: &config.DynamicPriceGetterConfig{}},
+			config.CommitPluginJobSpecConfig{PriceGetterConfig: &config.DynamicPriceGetterConfig{}, MedianPriceGetterConfig: &config.MedianPriceGetterConfig{}},
 		)
 		assert.Equal(t, exp, fields)
 	})
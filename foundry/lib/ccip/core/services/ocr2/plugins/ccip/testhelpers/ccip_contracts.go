@@ -268,7 +268,7 @@ func (c *CCIPContracts) DeployNewOffRamp(t *testing.T) {
 }
 
 func (c *CCIPContracts) EnableOffRamp(t *testing.T) {
-	_, err := c.Dest.Router.ApplyRampUpdates(c.Dest.User, nil, nil, []router.RouterOffRamp{{SourceChainSelector: SourceChainSelector, OffRamp: c.Dest.OffRamp.Address()}})
+	_, err := c.Dest.Router.ApplyRampUpdates(c.Dest.User, nil, nil, []router.RouterOffRamp{{SourceChainSelector: c.Source.ChainSelector, OffRamp: c.Dest.OffRamp.Address()}})
 	require.NoError(t, err)
 	c.Dest.Chain.Commit()
 
@@ -505,7 +505,7 @@ func OnchainPublicKeyToAddress(publicKeys []ocrtypes.OnchainPublicKey) (addresse
 	return addresses, nil
 }
 
-func (c *CCIPContracts) DeriveOCR2Config(t *testing.T, oracles []confighelper.OracleIdentityExtra, rawOnchainConfig []byte, rawOffchainConfig []byte) *OCR2Config {
+func (c *CCIPContracts) DeriveOCR2Config(t testing.TB, oracles []confighelper.OracleIdentityExtra, rawOnchainConfig []byte, rawOffchainConfig []byte) *OCR2Config {
 	signers, transmitters, threshold, onchainConfig, offchainConfigVersion, offchainConfig, err := confighelper.ContractSetConfigArgsForTests(
 		2*time.Second,        // deltaProgress
 		1*time.Second,        // deltaResend
@@ -548,7 +548,7 @@ func (c *CCIPContracts) DeriveOCR2Config(t *testing.T, oracles []confighelper.Or
 	}
 }
 
-func (c *CCIPContracts) SetupCommitOCR2Config(t *testing.T, commitOnchainConfig, commitOffchainConfig []byte) {
+func (c *CCIPContracts) SetupCommitOCR2Config(t testing.TB, commitOnchainConfig, commitOffchainConfig []byte) {
 	c.commitOCRConfig = c.DeriveOCR2Config(t, c.Oracles, commitOnchainConfig, commitOffchainConfig)
 	// Set the DON on the commit store
 	_, err := c.Dest.CommitStore.SetOCR2Config(
@@ -564,7 +564,7 @@ func (c *CCIPContracts) SetupCommitOCR2Config(t *testing.T, commitOnchainConfig,
 	c.Dest.Chain.Commit()
 }
 
-func (c *CCIPContracts) SetupExecOCR2Config(t *testing.T, execOnchainConfig, execOffchainConfig []byte) {
+func (c *CCIPContracts) SetupExecOCR2Config(t testing.TB, execOnchainConfig, execOffchainConfig []byte) {
 	c.execOCRConfig = c.DeriveOCR2Config(t, c.Oracles, execOnchainConfig, execOffchainConfig)
 	// Same DON on the offramp
 	_, err := c.Dest.OffRamp.SetOCR2Config(
@@ -580,7 +580,7 @@ func (c *CCIPContracts) SetupExecOCR2Config(t *testing.T, execOnchainConfig, exe
 	c.Dest.Chain.Commit()
 }
 
-func (c *CCIPContracts) SetupOnchainConfig(t *testing.T, commitOnchainConfig, commitOffchainConfig, execOnchainConfig, execOffchainConfig []byte) int64 {
+func (c *CCIPContracts) SetupOnchainConfig(t testing.TB, commitOnchainConfig, commitOffchainConfig, execOnchainConfig, execOffchainConfig []byte) int64 {
 	// Note We do NOT set the payees, payment is done in the OCR2Base implementation
 	blockBeforeConfig, err := c.Dest.Chain.BlockByNumber(context.Background(), nil)
 	require.NoError(t, err)
@@ -644,7 +644,7 @@ func MustEncodeAddress(t *testing.T, address common.Address) []byte {
 	return bts
 }
 
-func SetAdminAndRegisterPool(t *testing.T,
+func SetAdminAndRegisterPool(t testing.TB,
 	chain *backends.SimulatedBackend,
 	user *bind.TransactOpts,
 	tokenAdminRegistry *token_admin_registry.TokenAdminRegistry,
@@ -663,7 +663,16 @@ func SetAdminAndRegisterPool(t *testing.T,
 func SetupCCIPContracts(t *testing.T, sourceChainID, sourceChainSelector, destChainID, destChainSelector uint64) CCIPContracts {
 	sourceChain, sourceUser := SetupChain(t)
 	destChain, destUser := SetupChain(t)
+	return SetupCCIPContractsOnChains(t, sourceChainID, sourceChainSelector, sourceChain, sourceUser, destChainID, destChainSelector, destChain, destUser)
+}
 
+// SetupCCIPContractsOnChains deploys the contracts of a lane on existing chains, e.g. to deploy several lanes sharing
+// a chain. Every lane gets its own contracts, the users are the owners of the chains created by SetupChain.
+func SetupCCIPContractsOnChains(
+	t testing.TB,
+	sourceChainID, sourceChainSelector uint64, sourceChain *backends.SimulatedBackend, sourceUser *bind.TransactOpts,
+	destChainID, destChainSelector uint64, destChain *backends.SimulatedBackend, destUser *bind.TransactOpts,
+) CCIPContracts {
 	// ================================================================
 	// │                         Deploy RMN                           │
 	// ================================================================
@@ -885,7 +894,7 @@ func SetupCCIPContracts(t *testing.T, sourceChainID, sourceChainSelector, destCh
 	_, err = sourceLinkPool.ApplyChainUpdates(
 		sourceUser,
 		[]lock_release_token_pool.TokenPoolChainUpdate{{
-			RemoteChainSelector: DestChainSelector,
+			RemoteChainSelector: destChainSelector,
 			RemotePoolAddress:   abiEncodedDestLinkPool,
 			Allowed:             true,
 			OutboundRateLimiterConfig: lock_release_token_pool.RateLimiterConfig{
@@ -907,7 +916,7 @@ func SetupCCIPContracts(t *testing.T, sourceChainID, sourceChainSelector, destCh
 	_, err = sourceWeth9Pool.ApplyChainUpdates(
 		sourceUser,
 		[]lock_release_token_pool.TokenPoolChainUpdate{{
-			RemoteChainSelector: DestChainSelector,
+			RemoteChainSelector: destChainSelector,
 			RemotePoolAddress:   abiEncodedDestWrappedPool,
 			Allowed:             true,
 			OutboundRateLimiterConfig: lock_release_token_pool.RateLimiterConfig{
@@ -930,7 +939,7 @@ func SetupCCIPContracts(t *testing.T, sourceChainID, sourceChainSelector, destCh
 	_, err = destLinkPool.ApplyChainUpdates(
 		destUser,
 		[]lock_release_token_pool.TokenPoolChainUpdate{{
-			RemoteChainSelector: SourceChainSelector,
+			RemoteChainSelector: sourceChainSelector,
 			RemotePoolAddress:   abiEncodedSourceLinkPool,
 			Allowed:             true,
 			OutboundRateLimiterConfig: lock_release_token_pool.RateLimiterConfig{
@@ -952,7 +961,7 @@ func SetupCCIPContracts(t *testing.T, sourceChainID, sourceChainSelector, destCh
 	_, err = destWrappedPool.ApplyChainUpdates(
 		destUser,
 		[]lock_release_token_pool.TokenPoolChainUpdate{{
-			RemoteChainSelector: SourceChainSelector,
+			RemoteChainSelector: sourceChainSelector,
 			RemotePoolAddress:   abiEncodedSourceWrappedPool,
 			Allowed:             true,
 			OutboundRateLimiterConfig: lock_release_token_pool.RateLimiterConfig{
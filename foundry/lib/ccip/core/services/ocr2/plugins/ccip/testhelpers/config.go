@@ -17,7 +17,7 @@ import (
 
 var PermissionLessExecutionThresholdSeconds = uint32(FirstBlockAge.Seconds())
 
-func (c *CCIPContracts) CreateDefaultCommitOnchainConfig(t *testing.T) []byte {
+func (c *CCIPContracts) CreateDefaultCommitOnchainConfig(t testing.TB) []byte {
 	config, err := abihelpers.EncodeAbiStruct(ccipdata.CommitOnchainConfig{
 		PriceRegistry: c.Dest.PriceRegistry.Address(),
 	})
@@ -25,11 +25,11 @@ func (c *CCIPContracts) CreateDefaultCommitOnchainConfig(t *testing.T) []byte {
 	return config
 }
 
-func (c *CCIPContracts) CreateDefaultCommitOffchainConfig(t *testing.T) []byte {
+func (c *CCIPContracts) CreateDefaultCommitOffchainConfig(t testing.TB) []byte {
 	return c.createCommitOffchainConfig(t, 10*time.Second, 5*time.Second)
 }
 
-func (c *CCIPContracts) createCommitOffchainConfig(t *testing.T, feeUpdateHearBeat time.Duration, inflightCacheExpiry time.Duration) []byte {
+func (c *CCIPContracts) createCommitOffchainConfig(t testing.TB, feeUpdateHearBeat time.Duration, inflightCacheExpiry time.Duration) []byte {
 	config, err := NewCommitOffchainConfig(
 		*config.MustNewDuration(feeUpdateHearBeat),
 		1,
@@ -42,7 +42,7 @@ func (c *CCIPContracts) createCommitOffchainConfig(t *testing.T, feeUpdateHearBe
 	return config
 }
 
-func (c *CCIPContracts) CreateDefaultExecOnchainConfig(t *testing.T) []byte {
+func (c *CCIPContracts) CreateDefaultExecOnchainConfig(t testing.TB) []byte {
 	config, err := abihelpers.EncodeAbiStruct(v1_2_0.ExecOnchainConfig{
 		PermissionLessExecutionThresholdSeconds: PermissionLessExecutionThresholdSeconds,
 		Router:                                  c.Dest.Router.Address(),
@@ -55,11 +55,11 @@ func (c *CCIPContracts) CreateDefaultExecOnchainConfig(t *testing.T) []byte {
 	return config
 }
 
-func (c *CCIPContracts) CreateDefaultExecOffchainConfig(t *testing.T) []byte {
+func (c *CCIPContracts) CreateDefaultExecOffchainConfig(t testing.TB) []byte {
 	return c.createExecOffchainConfig(t, 1*time.Minute, 1*time.Minute)
 }
 
-func (c *CCIPContracts) createExecOffchainConfig(t *testing.T, inflightCacheExpiry time.Duration, rootSnoozeTime time.Duration) []byte {
+func (c *CCIPContracts) createExecOffchainConfig(t testing.TB, inflightCacheExpiry time.Duration, rootSnoozeTime time.Duration) []byte {
 	config, err := NewExecOffchainConfig(
 		1,
 		5_000_000,
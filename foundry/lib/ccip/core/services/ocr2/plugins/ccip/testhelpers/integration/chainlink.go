@@ -62,6 +62,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	ksMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_0_0"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
@@ -326,7 +327,7 @@ func (node *Node) ConsistentlySeqNumHasNotBeenExecuted(t *testing.T, ccipContrac
 	return log
 }
 
-func (node *Node) AddJob(t *testing.T, spec *OCR2TaskJobSpec) {
+func (node *Node) AddJob(t testing.TB, spec *OCR2TaskJobSpec) {
 	specString, err := spec.String()
 	require.NoError(t, err)
 	ccipJob, err := validate.ValidatedOracleSpecToml(
@@ -342,7 +343,7 @@ func (node *Node) AddJob(t *testing.T, spec *OCR2TaskJobSpec) {
 	require.NoError(t, err)
 }
 
-func (node *Node) AddBootstrapJob(t *testing.T, spec *OCR2TaskJobSpec) {
+func (node *Node) AddBootstrapJob(t testing.TB, spec *OCR2TaskJobSpec) {
 	specString, err := spec.String()
 	require.NoError(t, err)
 	ccipJob, err := ocrbootstrap.ValidatedBootstrapSpecToml(specString)
@@ -351,7 +352,7 @@ func (node *Node) AddBootstrapJob(t *testing.T, spec *OCR2TaskJobSpec) {
 	require.NoError(t, err)
 }
 
-func (node *Node) AddJobsWithSpec(t *testing.T, jobSpec *OCR2TaskJobSpec) {
+func (node *Node) AddJobsWithSpec(t testing.TB, jobSpec *OCR2TaskJobSpec) {
 	// set node specific values
 	jobSpec.OCR2OracleSpec.OCRKeyBundleID.SetValid(node.KeyBundle.ID())
 	jobSpec.OCR2OracleSpec.TransmitterID.SetValid(node.Transmitter.Hex())
@@ -359,7 +360,7 @@ func (node *Node) AddJobsWithSpec(t *testing.T, jobSpec *OCR2TaskJobSpec) {
 }
 
 func setupNodeCCIP(
-	t *testing.T,
+	t testing.TB,
 	owner *bind.TransactOpts,
 	port int64,
 	dbName string,
@@ -367,6 +368,7 @@ func setupNodeCCIP(
 	sourceChainID *big.Int, destChainID *big.Int,
 	bootstrapPeerID string,
 	bootstrapPort int64,
+	wrapClient func(chainID *big.Int, c client.Client) client.Client,
 ) (chainlink.Application, string, common.Address, ocr2key.KeyBundle) {
 	trueRef, falseRef := true, false
 
@@ -423,8 +425,12 @@ func setupNodeCCIP(
 	// test, we fake different chainIDs using the wrapped sim cltest.SimulatedBackend so the RPC
 	// appears to operate on different chainIDs and we use an EthKeyStoreSim wrapper which always
 	// signs 1337 see https://github.com/smartcontractkit/chainlink-ccip/blob/a24dd436810250a458d27d8bb3fb78096afeb79c/core/services/ocr2/plugins/ccip/testhelpers/simulated_backend.go#L35
-	sourceClient := client.NewSimulatedBackendClient(t, sourceChain, sourceChainID)
-	destClient := client.NewSimulatedBackendClient(t, destChain, destChainID)
+	var sourceClient client.Client = client.NewSimulatedBackendClient(t, sourceChain, sourceChainID)
+	var destClient client.Client = client.NewSimulatedBackendClient(t, destChain, destChainID)
+	if wrapClient != nil {
+		sourceClient = wrapClient(sourceChainID, sourceClient)
+		destClient = wrapClient(destChainID, destClient)
+	}
 	csaKeyStore := ksMocks.NewCSA(t)
 
 	key, err := csakey.NewV2()
@@ -547,6 +553,12 @@ type CCIPIntegrationTestHarness struct {
 	testhelpers.CCIPContracts
 	Nodes     []Node
 	Bootstrap Node
+	// NumOracles is the number of oracles started by SetupAndStartNodes, defaults to 4 which is the minimum.
+	NumOracles int
+	// WrapClient wraps the RPC clients of the nodes when set, e.g. to inject RPC errors.
+	WrapClient func(chainID *big.Int, c client.Client) client.Client
+	// ChainHealth is set in the commit and exec job specs when set.
+	ChainHealth *ccipconfig.ChainHealthConfig
 }
 
 func SetupCCIPIntegrationTH(t *testing.T, sourceChainID, sourceChainSelector, destChainId, destChainSelector uint64) CCIPIntegrationTestHarness {
@@ -555,7 +567,7 @@ func SetupCCIPIntegrationTH(t *testing.T, sourceChainID, sourceChainSelector, de
 	}
 }
 
-func (c *CCIPIntegrationTestHarness) CreatePricesPipeline(t *testing.T) (string, *httptest.Server, *httptest.Server) {
+func (c *CCIPIntegrationTestHarness) CreatePricesPipeline(t testing.TB) (string, *httptest.Server, *httptest.Server) {
 	linkUSD := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		_, err := w.Write([]byte(`{"UsdPerLink": "8000000000000000000"}`))
 		require.NoError(t, err)
@@ -582,7 +594,7 @@ merge [type=merge left="{}" right="{\\\"%s\\\":$(link_parse), \\\"%s\\\":$(eth_p
 	return tokenPricesUSDPipeline, linkUSD, ethUSD
 }
 
-func (c *CCIPIntegrationTestHarness) AddAllJobs(t *testing.T, jobParams CCIPJobSpecParams) {
+func (c *CCIPIntegrationTestHarness) AddAllJobs(t testing.TB, jobParams CCIPJobSpecParams) {
 	jobParams.OffRamp = c.Dest.OffRamp.Address()
 
 	commitSpec, err := jobParams.CommitJobSpec()
@@ -874,10 +886,10 @@ func (c *CCIPIntegrationTestHarness) ConsistentlyReportNotCommitted(t *testing.T
 	}, testutils.WaitTimeout(t), time.Second).Should(gomega.BeFalse(), "report has been committed")
 }
 
-func (c *CCIPIntegrationTestHarness) SetupAndStartNodes(ctx context.Context, t *testing.T, bootstrapNodePort int64) (Node, []Node, int64) {
+func (c *CCIPIntegrationTestHarness) SetupAndStartNodes(ctx context.Context, t testing.TB, bootstrapNodePort int64) (Node, []Node, int64) {
 	appBootstrap, bootstrapPeerID, bootstrapTransmitter, bootstrapKb := setupNodeCCIP(t, c.Dest.User, bootstrapNodePort,
 		"bootstrap_ccip", c.Source.Chain, c.Dest.Chain, big.NewInt(0).SetUint64(c.Source.ChainID),
-		big.NewInt(0).SetUint64(c.Dest.ChainID), "", 0)
+		big.NewInt(0).SetUint64(c.Dest.ChainID), "", 0, c.WrapClient)
 	var (
 		oracles []confighelper.OracleIdentityExtra
 		nodes   []Node
@@ -892,8 +904,12 @@ func (c *CCIPIntegrationTestHarness) SetupAndStartNodes(ctx context.Context, t *
 		Transmitter: bootstrapTransmitter,
 		KeyBundle:   bootstrapKb,
 	}
-	// Set up the minimum 4 oracles all funded with destination ETH
-	for i := int64(0); i < 4; i++ {
+	// Set up the oracles all funded with destination ETH
+	numOracles := c.NumOracles
+	if numOracles == 0 {
+		numOracles = 4
+	}
+	for i := 0; i < numOracles; i++ {
 		app, peerID, transmitter, kb := setupNodeCCIP(
 			t,
 			c.Dest.User,
@@ -905,6 +921,7 @@ func (c *CCIPIntegrationTestHarness) SetupAndStartNodes(ctx context.Context, t *
 			big.NewInt(0).SetUint64(c.Dest.ChainID),
 			bootstrapPeerID,
 			bootstrapNodePort,
+			c.WrapClient,
 		)
 		nodes = append(nodes, Node{
 			App:         app,
@@ -940,7 +957,7 @@ func (c *CCIPIntegrationTestHarness) SetupAndStartNodes(ctx context.Context, t *
 	return bootstrapNode, nodes, configBlock
 }
 
-func (c *CCIPIntegrationTestHarness) SetUpNodesAndJobs(t *testing.T, pricePipeline string, priceGetterConfig string, usdcAttestationAPI string) CCIPJobSpecParams {
+func (c *CCIPIntegrationTestHarness) SetUpNodesAndJobs(t testing.TB, pricePipeline string, priceGetterConfig string, usdcAttestationAPI string) CCIPJobSpecParams {
 	// setup Jobs
 	ctx := context.Background()
 	// Starts nodes and configures them in the OCR contracts.
@@ -3,6 +3,7 @@ package integrationtesthelpers
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 	"time"
 
@@ -170,6 +171,7 @@ type CCIPJobSpecParams struct {
 	DestStartBlock         uint64
 	USDCAttestationAPI     string
 	USDCConfig             *config.USDCConfig
+	ChainHealth            *config.ChainHealthConfig
 	P2PV2Bootstrappers     pq.StringArray
 }
 
@@ -250,6 +252,9 @@ func (params CCIPJobSpecParams) CommitJobSpec() (*OCR2TaskJobSpec, error) {
 	if params.SourceStartBlock > 0 {
 		ocrSpec.PluginConfig["sourceStartBlock"] = params.SourceStartBlock
 	}
+	if params.ChainHealth != nil {
+		addChainHealthPluginConfig(ocrSpec.PluginConfig, *params.ChainHealth)
+	}
 	return &OCR2TaskJobSpec{
 		OCR2OracleSpec: ocrSpec,
 		JobType:        "offchainreporting2",
@@ -295,6 +300,9 @@ func (params CCIPJobSpecParams) ExecutionJobSpec() (*OCR2TaskJobSpec, error) {
 		ocrSpec.PluginConfig["USDCConfig.SourceMessageTransmitterAddress"] = fmt.Sprintf(`"%s"`, params.USDCConfig.SourceMessageTransmitterAddress)
 		ocrSpec.PluginConfig["USDCConfig.AttestationAPITimeoutSeconds"] = params.USDCConfig.AttestationAPITimeoutSeconds
 	}
+	if params.ChainHealth != nil {
+		addChainHealthPluginConfig(ocrSpec.PluginConfig, *params.ChainHealth)
+	}
 	return &OCR2TaskJobSpec{
 		OCR2OracleSpec: ocrSpec,
 		JobType:        "offchainreporting2",
@@ -302,6 +310,19 @@ func (params CCIPJobSpecParams) ExecutionJobSpec() (*OCR2TaskJobSpec, error) {
 	}, err
 }
 
+func addChainHealthPluginConfig(pluginConfig map[string]interface{}, cfg config.ChainHealthConfig) {
+	if len(cfg.FatalChecks) > 0 {
+		checks := make([]string, len(cfg.FatalChecks))
+		for i, check := range cfg.FatalChecks {
+			checks[i] = fmt.Sprintf(`"%s"`, check)
+		}
+		pluginConfig["chainHealth.FatalChecks"] = fmt.Sprintf("[%s]", strings.Join(checks, ", "))
+	}
+	pluginConfig["chainHealth.MaxHeadLagSeconds"] = cfg.MaxHeadLagSeconds
+	pluginConfig["chainHealth.StickyUnhealthySeconds"] = cfg.StickyUnhealthySeconds
+	pluginConfig["chainHealth.ClearableStickyUnhealthy"] = cfg.ClearableStickyUnhealthy
+}
+
 func (params CCIPJobSpecParams) BootstrapJob(contractID string) *OCR2TaskJobSpec {
 	bootstrapSpec := job.OCR2OracleSpec{
 		ContractID:                        contractID,
@@ -330,5 +351,6 @@ func (c *CCIPIntegrationTestHarness) NewCCIPJobSpecParams(tokenPricesUSDPipeline
 		PriceGetterConfig:      priceGetterConfig,
 		DestStartBlock:         uint64(configBlock),
 		USDCAttestationAPI:     usdcAttestationAPI,
+		ChainHealth:            c.ChainHealth,
 	}
 }
@@ -0,0 +1,55 @@
+package integrationtesthelpers
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipsim"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/testhelpers"
+)
+
+// SimEnvironment deploys the chains and lanes of a ccipsim simulation with the integration test harness. The harness
+// fails t instead of returning errors, the nodes need a database, see heavyweight.FullTestDBNoFixturesV2.
+type SimEnvironment struct {
+	t testing.TB
+}
+
+var _ ccipsim.Environment = (*SimEnvironment)(nil)
+
+func NewSimEnvironment(t testing.TB) *SimEnvironment {
+	return &SimEnvironment{t: t}
+}
+
+func (e *SimEnvironment) NewChain(ccipsim.ChainConfig) (*backends.SimulatedBackend, *bind.TransactOpts, error) {
+	backend, user := testhelpers.SetupChain(e.t)
+	return backend, user, nil
+}
+
+func (e *SimEnvironment) NewLane(source, dest ccipsim.Chain, opts ccipsim.LaneOptions) (ccipsim.LaneContracts, error) {
+	th := CCIPIntegrationTestHarness{
+		CCIPContracts: testhelpers.SetupCCIPContractsOnChains(e.t,
+			source.ChainID, source.ChainSelector, source.Backend, source.User,
+			dest.ChainID, dest.ChainSelector, dest.Backend, dest.User),
+		NumOracles:  opts.Oracles,
+		WrapClient:  opts.WrapClient,
+		ChainHealth: opts.ChainHealth,
+	}
+	pricePipeline, linkUSD, ethUSD := th.CreatePricesPipeline(e.t)
+	e.t.Cleanup(linkUSD.Close)
+	e.t.Cleanup(ethUSD.Close)
+	th.SetUpNodesAndJobs(e.t, pricePipeline, "", "")
+
+	return ccipsim.LaneContracts{
+		Router:         th.Source.Router,
+		LinkToken:      th.Source.LinkToken,
+		OnRamp:         th.Source.OnRamp,
+		SourceARM:      th.Source.ARM,
+		CommitStore:    th.Dest.CommitStore,
+		OffRamp:        th.Dest.OffRamp,
+		DestARM:        th.Dest.ARM,
+		Receiver:       th.Dest.Receivers[0].Receiver.Address(),
+		StrictReceiver: th.Dest.Receivers[1].Receiver.Address(),
+	}, nil
+}
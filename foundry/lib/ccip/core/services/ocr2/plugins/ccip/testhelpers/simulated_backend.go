@@ -21,7 +21,7 @@ import (
 // FirstBlockAge is used to compute first block's timestamp in SimulatedBackend (time.Now() - FirstBlockAge)
 const FirstBlockAge = 24 * time.Hour
 
-func SetupChain(t *testing.T) (*backends.SimulatedBackend, *bind.TransactOpts) {
+func SetupChain(t testing.TB) (*backends.SimulatedBackend, *bind.TransactOpts) {
 	key, err := crypto.GenerateKey()
 	require.NoError(t, err)
 	user, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
@@ -55,12 +55,8 @@ func (ks EthKeyStoreSim) Eth() keystore.Eth {
 }
 
 func (ks EthKeyStoreSim) SignTx(address common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
-	if chainID.String() == "1000" {
-		// A terrible hack, just for the multichain test. All simulation clients run on chainID 1337.
-		// We let the DestChainSelector actually use 1337 to make sure the offchainConfig digests are properly generated.
-		return ks.ETHKS.SignTx(context.Background(), address, tx, big.NewInt(1337))
-	}
-	return ks.ETHKS.SignTx(context.Background(), address, tx, chainID)
+	// All simulation clients run on chainID 1337, whatever chainID the nodes are configured with.
+	return ks.ETHKS.SignTx(context.Background(), address, tx, big.NewInt(1337))
 }
 
 var _ keystore.Eth = EthKeyStoreSim{}.ETHKS
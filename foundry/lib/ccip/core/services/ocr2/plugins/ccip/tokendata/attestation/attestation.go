@@ -0,0 +1,386 @@
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"golang.org/x/time/rate"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/http"
+)
+
+const (
+	defaultAttestationTimeout = 5 * time.Second
+
+	// defaultCoolDownDuration defines the default time to wait after getting rate limited.
+	// this value is only used if the 429 response does not contain the Retry-After header
+	defaultCoolDownDuration = 5 * time.Minute
+
+	// maxCoolDownDuration defines the maximum duration we can wait till firing the next request
+	maxCoolDownDuration = 10 * time.Minute
+
+	// defaultRequestInterval defines the rate in requests per second that the attestation API can be called.
+	defaultRequestInterval = 100 * time.Millisecond
+
+	// APIIntervalRateLimitDisabled is a special value to disable the rate limiting.
+	APIIntervalRateLimitDisabled = -1
+	// APIIntervalRateLimitDefault is a special value to select the default rate limit interval.
+	APIIntervalRateLimitDefault = 0
+)
+
+var (
+	ErrUnknownResponse = errors.New("unexpected response from attestation API")
+
+	bytesArguments = abi.Arguments{{Type: mustNewType("bytes")}}
+)
+
+// urlParams are the values available to the attestation API url template.
+type urlParams struct {
+	MessageHash string
+	MessageID   string
+	TxHash      string
+}
+
+// TokenDataReader is a tokendata.Reader for attestation services that follow the
+// "hash the source log, poll an API, abi encode the result" flow.
+type TokenDataReader struct {
+	lggr                  logger.Logger
+	eventReader           ccipdata.TokenEventReader
+	httpClient            http.IHttpClient
+	tokenAddress          common.Address
+	eventDataIsBytes      bool
+	attestationApi        *template.Template
+	attestationApiTimeout time.Duration
+	statusPath            string
+	successStatus         string
+	errorPath             string
+	tokenDataArgs         abi.Arguments
+	tokenDataSources      []string
+	rate                  *rate.Limiter
+
+	// coolDownUntil defines whether requests are blocked or not.
+	coolDownUntil time.Time
+	coolDownMu    *sync.RWMutex
+}
+
+var _ tokendata.Reader = &TokenDataReader{}
+
+func NewTokenDataReader(
+	lggr logger.Logger,
+	eventReader ccipdata.TokenEventReader,
+	httpClient http.IHttpClient,
+	tokenAddress common.Address,
+	cfg ccipconfig.HTTPAttestationConfig,
+) (*TokenDataReader, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	attestationApi, err := template.New("attestationAPI").Option("missingkey=error").Parse(cfg.AttestationAPI)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse attestation API template")
+	}
+
+	tokenDataArgs := make(abi.Arguments, 0, len(cfg.TokenData))
+	tokenDataSources := make([]string, 0, len(cfg.TokenData))
+	for _, field := range cfg.TokenData {
+		typ, err := abi.NewType(field.Type, "", nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid token data type %s", field.Type)
+		}
+		tokenDataArgs = append(tokenDataArgs, abi.Argument{Type: typ})
+		tokenDataSources = append(tokenDataSources, field.Source)
+	}
+
+	timeout := time.Duration(cfg.AttestationAPITimeoutSeconds) * time.Second
+	if cfg.AttestationAPITimeoutSeconds == 0 {
+		timeout = defaultAttestationTimeout
+	}
+
+	requestInterval := time.Duration(cfg.AttestationAPIIntervalMilliseconds) * time.Millisecond
+	if cfg.AttestationAPIIntervalMilliseconds == APIIntervalRateLimitDisabled {
+		requestInterval = 0
+	} else if cfg.AttestationAPIIntervalMilliseconds == APIIntervalRateLimitDefault {
+		requestInterval = defaultRequestInterval
+	}
+
+	return &TokenDataReader{
+		lggr:                  lggr,
+		eventReader:           eventReader,
+		httpClient:            httpClient,
+		tokenAddress:          tokenAddress,
+		eventDataIsBytes:      cfg.SourceEventDataIsBytes,
+		attestationApi:        attestationApi,
+		attestationApiTimeout: timeout,
+		statusPath:            cfg.StatusPath,
+		successStatus:         cfg.SuccessStatus,
+		errorPath:             cfg.ErrorPath,
+		tokenDataArgs:         tokenDataArgs,
+		tokenDataSources:      tokenDataSources,
+		rate:                  rate.NewLimiter(rate.Every(requestInterval), 1),
+		coolDownMu:            &sync.RWMutex{},
+	}, nil
+}
+
+// ReadTokenData reads the attested source event of the token, queries the attestation API
+// with its hash and abi encodes the configured token data fields.
+func (s *TokenDataReader) ReadTokenData(ctx context.Context, msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta, tokenIndex int) ([]byte, error) {
+	if tokenIndex < 0 || tokenIndex >= len(msg.TokenAmounts) {
+		return nil, fmt.Errorf("token index out of bounds")
+	}
+
+	if s.inCoolDownPeriod() {
+		// rate limiting cool-down period, we prevent new requests from being sent
+		return nil, tokendata.ErrRequestsBlocked
+	}
+
+	if s.rate != nil {
+		if err := s.rate.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("attestation rate limiting error: %w", err)
+		}
+	}
+
+	message, err := s.getMessage(ctx, msg, tokenIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting the attested message")
+	}
+	messageHash := utils.Keccak256Fixed(message)
+
+	attestationURL, err := s.attestationURL(urlParams{
+		MessageHash: hexutil.Encode(messageHash[:]),
+		MessageID:   hexutil.Encode(msg.MessageID[:]),
+		TxHash:      msg.TxHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.lggr.Infow("Calling attestation API", "messageHash", hexutil.Encode(messageHash[:]), "messageID", hexutil.Encode(msg.MessageID[:]))
+	response, err := s.callAttestationApi(ctx, attestationURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed calling attestation API")
+	}
+
+	status := response.Get(s.statusPath)
+	switch {
+	case !status.Exists():
+		return nil, fmt.Errorf("invalid attestation response: %s", response.Raw)
+	case status.String() != s.successStatus:
+		return nil, tokendata.ErrNotReady
+	}
+
+	tokenData, err := s.encodeTokenData(message, messageHash, response)
+	if err != nil {
+		s.lggr.Errorw("Unexpected response from attestation API", "response", response.Raw, "err", err)
+		return nil, errors.Wrap(ErrUnknownResponse, err.Error())
+	}
+	return tokenData, nil
+}
+
+func (s *TokenDataReader) getMessage(ctx context.Context, msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta, tokenIndex int) ([]byte, error) {
+	if msg.TokenAmounts[tokenIndex].Token != ccipcalc.EvmAddrToGeneric(s.tokenAddress) {
+		return nil, fmt.Errorf("the specified token index %d is not a %s token", tokenIndex, s.tokenAddress)
+	}
+
+	// Every transfer of the token emits its own event, later transfers of the same token come after it.
+	tokenEndOffset := 0
+	for i := tokenIndex + 1; i < len(msg.TokenAmounts); i++ {
+		evmTokenAddr, err := ccipcalc.GenericAddrToEvm(msg.TokenAmounts[i].Token)
+		if err != nil {
+			continue
+		}
+		if evmTokenAddr == s.tokenAddress {
+			tokenEndOffset++
+		}
+	}
+
+	eventData, err := s.eventReader.GetEventDataPriorToLogIndexInTx(ctx, int64(msg.LogIndex), tokenEndOffset, msg.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if !s.eventDataIsBytes {
+		return eventData, nil
+	}
+
+	decoded, err := bytesArguments.Unpack(eventData)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode event data")
+	}
+	message, ok := decoded[0].([]byte)
+	if !ok || len(message) == 0 {
+		return nil, errors.New("event data is not a non-empty bytes value")
+	}
+	return message, nil
+}
+
+func (s *TokenDataReader) attestationURL(params urlParams) (string, error) {
+	var buf bytes.Buffer
+	if err := s.attestationApi.Execute(&buf, params); err != nil {
+		return "", errors.Wrap(err, "execute attestation API template")
+	}
+	return buf.String(), nil
+}
+
+// callAttestationApi calls the attestation API. If the API responds with HTTP 429 all
+// requests are blocked for the duration of the Retry-After header or a default cool-down.
+func (s *TokenDataReader) callAttestationApi(ctx context.Context, attestationURL string) (gjson.Result, error) {
+	body, _, headers, err := s.httpClient.Get(ctx, attestationURL, s.attestationApiTimeout)
+	switch {
+	case errors.Is(err, tokendata.ErrRateLimit):
+		coolDownDuration := defaultCoolDownDuration
+		if retryAfterHeader, exists := headers["Retry-After"]; exists && len(retryAfterHeader) > 0 {
+			if retryAfterSec, errParseInt := strconv.ParseInt(retryAfterHeader[0], 10, 64); errParseInt == nil {
+				coolDownDuration = time.Duration(retryAfterSec) * time.Second
+			}
+		}
+		s.setCoolDownPeriod(coolDownDuration)
+
+		// Explicitly signal if the API is being rate limited
+		return gjson.Result{}, tokendata.ErrRateLimit
+	case err != nil:
+		return gjson.Result{}, fmt.Errorf("request error: %w", err)
+	}
+
+	if !gjson.ValidBytes(body) {
+		return gjson.Result{}, fmt.Errorf("invalid attestation response: %s", string(body))
+	}
+	response := gjson.ParseBytes(body)
+	if s.errorPath != "" {
+		if apiErr := response.Get(s.errorPath); apiErr.Exists() && apiErr.String() != "" {
+			return gjson.Result{}, fmt.Errorf("attestation API error: %s", apiErr.String())
+		}
+	}
+	return response, nil
+}
+
+// encodeTokenData abi encodes the configured token data fields as a tuple.
+func (s *TokenDataReader) encodeTokenData(message []byte, messageHash [32]byte, response gjson.Result) ([]byte, error) {
+	values := make([]interface{}, len(s.tokenDataArgs))
+	for i, arg := range s.tokenDataArgs {
+		var err error
+		switch source := s.tokenDataSources[i]; source {
+		case ccipconfig.AttestationSourceMessage:
+			values[i], err = toAbiValue(arg.Type, hexutil.Encode(message))
+		case ccipconfig.AttestationSourceMessageHash:
+			values[i], err = toAbiValue(arg.Type, hexutil.Encode(messageHash[:]))
+		default:
+			field := response.Get(source)
+			if !field.Exists() {
+				return nil, fmt.Errorf("missing field %s", source)
+			}
+			values[i], err = toAbiValue(arg.Type, field.String())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("token data field %d: %w", i, err)
+		}
+	}
+	return s.tokenDataArgs.Pack(values...)
+}
+
+// toAbiValue converts the string representation of a value to the go type expected by the abi encoder.
+// Bytes and addresses are hex encoded, integers are decimal or 0x prefixed hex.
+func toAbiValue(typ abi.Type, value string) (interface{}, error) {
+	switch typ.T {
+	case abi.BytesTy:
+		return hexutil.Decode(withHexPrefix(value))
+	case abi.FixedBytesTy:
+		b, err := hexutil.Decode(withHexPrefix(value))
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != typ.Size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(b))
+		}
+		arr := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(arr, reflect.ValueOf(b))
+		return arr.Interface(), nil
+	case abi.AddressTy:
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid address %s", value)
+		}
+		return common.HexToAddress(value), nil
+	case abi.StringTy:
+		return value, nil
+	case abi.BoolTy:
+		return strconv.ParseBool(value)
+	case abi.UintTy, abi.IntTy:
+		v, ok := new(big.Int).SetString(value, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", value)
+		}
+		if typ.Size > 64 {
+			return v, nil
+		}
+		// Integers up to 64 bits are expected as the matching native go type.
+		native := reflect.New(typ.GetType()).Elem()
+		if typ.T == abi.UintTy {
+			if !v.IsUint64() || native.OverflowUint(v.Uint64()) {
+				return nil, fmt.Errorf("integer %s overflows %s", value, typ.String())
+			}
+			native.SetUint(v.Uint64())
+		} else {
+			if !v.IsInt64() || native.OverflowInt(v.Int64()) {
+				return nil, fmt.Errorf("integer %s overflows %s", value, typ.String())
+			}
+			native.SetInt(v.Int64())
+		}
+		return native.Interface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ.String())
+	}
+}
+
+func withHexPrefix(value string) string {
+	if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		return value
+	}
+	return "0x" + value
+}
+
+func (s *TokenDataReader) setCoolDownPeriod(d time.Duration) {
+	s.coolDownMu.Lock()
+	if d > maxCoolDownDuration {
+		d = maxCoolDownDuration
+	}
+	s.coolDownUntil = time.Now().Add(d)
+	s.coolDownMu.Unlock()
+}
+
+func (s *TokenDataReader) inCoolDownPeriod() bool {
+	s.coolDownMu.RLock()
+	defer s.coolDownMu.RUnlock()
+	return time.Now().Before(s.coolDownUntil)
+}
+
+func (s *TokenDataReader) Close() error {
+	return nil
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
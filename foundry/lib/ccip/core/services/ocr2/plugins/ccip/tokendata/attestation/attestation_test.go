@@ -0,0 +1,222 @@
+package attestation
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+	tokendatahttp "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/http"
+)
+
+func newTestConfig(url string) ccipconfig.HTTPAttestationConfig {
+	return ccipconfig.HTTPAttestationConfig{
+		SourceEventSignature:               "MessageSent(bytes)",
+		SourceEventAddress:                 utils.RandomAddress(),
+		SourceEventDataIsBytes:             true,
+		AttestationAPI:                     url + "/v1/attestations/{{.MessageHash}}",
+		AttestationAPIIntervalMilliseconds: APIIntervalRateLimitDisabled,
+		StatusPath:                         "data.status",
+		SuccessStatus:                      "complete",
+		ErrorPath:                          "error",
+		TokenData: []ccipconfig.AttestationTokenDataField{
+			{Type: "bytes", Source: ccipconfig.AttestationSourceMessage},
+			{Type: "bytes", Source: "data.attestation"},
+			{Type: "uint64", Source: "data.nonce"},
+		},
+	}
+}
+
+func newTestMsg(token common.Address) cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta {
+	return cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{
+		EVM2EVMMessage: cciptypes.EVM2EVMMessage{
+			MessageID: utils.RandomBytes32(),
+			TokenAmounts: []cciptypes.TokenAmount{
+				{Token: ccipcalc.EvmAddrToGeneric(token), Amount: big.NewInt(10)},
+				{Token: ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), Amount: big.NewInt(20)},
+				{Token: ccipcalc.EvmAddrToGeneric(token), Amount: big.NewInt(30)},
+			},
+		},
+		TxHash:   common.Hash(utils.RandomBytes32()).String(),
+		LogIndex: 10,
+	}
+}
+
+func TestTokenDataReader_ReadTokenData(t *testing.T) {
+	ctx := testutils.Context(t)
+	token := utils.RandomAddress()
+	message := []byte{0xb0, 0xd1}
+	messageHash := utils.Keccak256Fixed(message)
+	eventData, err := bytesArguments.Pack(message)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		status     int
+		response   string
+		tokenIndex int
+		offset     int
+		expectErr  error
+	}{
+		{
+			name:       "complete attestation",
+			status:     http.StatusOK,
+			response:   `{"data": {"status": "complete", "attestation": "0x720502893578a89a", "nonce": "42"}}`,
+			tokenIndex: 0,
+			offset:     1,
+		},
+		{
+			name:       "complete attestation of last token",
+			status:     http.StatusOK,
+			response:   `{"data": {"status": "complete", "attestation": "720502893578a89a", "nonce": 42}}`,
+			tokenIndex: 2,
+			offset:     0,
+		},
+		{
+			name:       "pending attestation",
+			status:     http.StatusOK,
+			response:   `{"data": {"status": "pending"}}`,
+			tokenIndex: 0,
+			offset:     1,
+			expectErr:  tokendata.ErrNotReady,
+		},
+		{
+			name:       "missing attestation field",
+			status:     http.StatusOK,
+			response:   `{"data": {"status": "complete", "nonce": 42}}`,
+			tokenIndex: 0,
+			offset:     1,
+			expectErr:  ErrUnknownResponse,
+		},
+		{
+			name:       "rate limited",
+			status:     http.StatusTooManyRequests,
+			tokenIndex: 0,
+			offset:     1,
+			expectErr:  tokendata.ErrRateLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var requestedPath string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.WriteHeader(tc.status)
+				_, err := w.Write([]byte(tc.response))
+				require.NoError(t, err)
+			}))
+			defer ts.Close()
+
+			msg := newTestMsg(token)
+			eventReader := ccipdatamocks.NewTokenEventReader(t)
+			eventReader.On("GetEventDataPriorToLogIndexInTx", mock.Anything, int64(msg.LogIndex), tc.offset, msg.TxHash).Return(eventData, nil)
+
+			reader, err := NewTokenDataReader(logger.TestLogger(t), eventReader, &tokendatahttp.HttpClient{}, token, newTestConfig(ts.URL))
+			require.NoError(t, err)
+
+			tokenData, err := reader.ReadTokenData(ctx, msg, tc.tokenIndex)
+			assert.Equal(t, "/v1/attestations/"+hexutil.Encode(messageHash[:]), requestedPath)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			decoded, err := reader.tokenDataArgs.Unpack(tokenData)
+			require.NoError(t, err)
+			assert.Equal(t, message, decoded[0])
+			assert.Equal(t, hexutil.MustDecode("0x720502893578a89a"), decoded[1])
+			assert.Equal(t, uint64(42), decoded[2])
+		})
+	}
+}
+
+func TestTokenDataReader_ReadTokenDataErrors(t *testing.T) {
+	ctx := testutils.Context(t)
+	token := utils.RandomAddress()
+	eventReader := ccipdatamocks.NewTokenEventReader(t)
+
+	reader, err := NewTokenDataReader(logger.TestLogger(t), eventReader, &tokendatahttp.HttpClient{}, token, newTestConfig("http://localhost"))
+	require.NoError(t, err)
+
+	msg := newTestMsg(token)
+	_, err = reader.ReadTokenData(ctx, msg, 3)
+	require.Error(t, err)
+
+	_, err = reader.ReadTokenData(ctx, msg, 1)
+	require.ErrorContains(t, err, "is not a")
+
+	reader.setCoolDownPeriod(maxCoolDownDuration)
+	_, err = reader.ReadTokenData(ctx, msg, 0)
+	require.ErrorIs(t, err, tokendata.ErrRequestsBlocked)
+}
+
+func TestNewTokenDataReader_InvalidConfig(t *testing.T) {
+	cfg := newTestConfig("http://localhost")
+	cfg.TokenData = append(cfg.TokenData, ccipconfig.AttestationTokenDataField{Type: "not-a-type", Source: "data"})
+
+	_, err := NewTokenDataReader(logger.TestLogger(t), ccipdatamocks.NewTokenEventReader(t), &tokendatahttp.HttpClient{}, utils.RandomAddress(), cfg)
+	require.Error(t, err)
+}
+
+func TestToAbiValue(t *testing.T) {
+	mustType := func(typeName string) abi.Type {
+		typ, err := abi.NewType(typeName, "", nil)
+		require.NoError(t, err)
+		return typ
+	}
+	addr := utils.RandomAddress()
+
+	testCases := []struct {
+		typ       string
+		value     string
+		expected  interface{}
+		expectErr bool
+	}{
+		{typ: "bytes", value: "0x0102", expected: []byte{1, 2}},
+		{typ: "bytes", value: "0102", expected: []byte{1, 2}},
+		{typ: "bytes2", value: "0x0102", expected: [2]byte{1, 2}},
+		{typ: "bytes2", value: "0x010203", expectErr: true},
+		{typ: "address", value: addr.Hex(), expected: addr},
+		{typ: "address", value: "0x12", expectErr: true},
+		{typ: "string", value: "hello", expected: "hello"},
+		{typ: "bool", value: "true", expected: true},
+		{typ: "uint8", value: "255", expected: uint8(255)},
+		{typ: "uint8", value: "256", expectErr: true},
+		{typ: "int32", value: "-5", expected: int32(-5)},
+		{typ: "uint256", value: "0x10", expected: big.NewInt(16)},
+		{typ: "uint256", value: "abc", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(strings.Join([]string{tc.typ, tc.value}, "_"), func(t *testing.T) {
+			value, err := toAbiValue(mustType(tc.typ), tc.value)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
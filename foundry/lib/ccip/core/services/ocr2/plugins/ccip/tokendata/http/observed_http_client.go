@@ -31,6 +31,11 @@ var (
 		Help:    "Latency of calls to the USDC client",
 		Buckets: usdcLatencyBuckets,
 	}, []string{"status", "success"})
+	attestationClientHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ccip_attestation_client_request_total",
+		Help:    "Latency of calls to the generic attestation client",
+		Buckets: usdcLatencyBuckets,
+	}, []string{"status", "success"})
 )
 
 type ObservedIHttpClient struct {
@@ -43,6 +48,11 @@ func NewObservedIHttpClient(origin IHttpClient) *ObservedIHttpClient {
 	return NewObservedIHttpClientWithMetric(origin, usdcClientHistogram)
 }
 
+// NewObservedAttestationIHttpClient Create a new ObservedIHttpClient with the generic attestation client metric.
+func NewObservedAttestationIHttpClient(origin IHttpClient) *ObservedIHttpClient {
+	return NewObservedIHttpClientWithMetric(origin, attestationClientHistogram)
+}
+
 func NewObservedIHttpClientWithMetric(origin IHttpClient, histogram *prometheus.HistogramVec) *ObservedIHttpClient {
 	return &ObservedIHttpClient{
 		IHttpClient: origin,
@@ -0,0 +1,66 @@
+package observability
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+)
+
+var (
+	readerLatencyBuckets = []float64{
+		float64(10 * time.Millisecond),
+		float64(50 * time.Millisecond),
+		float64(100 * time.Millisecond),
+		float64(250 * time.Millisecond),
+		float64(500 * time.Millisecond),
+		float64(1 * time.Second),
+		float64(2 * time.Second),
+		float64(5 * time.Second),
+		float64(10 * time.Second),
+	}
+	readerHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ccip_token_data_reader_duration",
+		Help:    "Duration of calls to the token data providers",
+		Buckets: readerLatencyBuckets,
+	}, []string{"evmChainID", "provider", "success"})
+)
+
+// ObservedReader is a tokendata.Reader that records the latency and outcome of every
+// ReadTokenData call, labelled by the provider type.
+type ObservedReader struct {
+	tokendata.Reader
+	histogram    *prometheus.HistogramVec
+	chainID      string
+	providerType string
+}
+
+var _ tokendata.Reader = &ObservedReader{}
+
+func NewObservedReader(origin tokendata.Reader, chainID int64, providerType string) *ObservedReader {
+	return NewObservedReaderWithMetric(origin, chainID, providerType, readerHistogram)
+}
+
+func NewObservedReaderWithMetric(origin tokendata.Reader, chainID int64, providerType string, histogram *prometheus.HistogramVec) *ObservedReader {
+	return &ObservedReader{
+		Reader:       origin,
+		histogram:    histogram,
+		chainID:      strconv.FormatInt(chainID, 10),
+		providerType: providerType,
+	}
+}
+
+func (o *ObservedReader) ReadTokenData(ctx context.Context, msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta, tokenIndex int) ([]byte, error) {
+	started := time.Now()
+	data, err := o.Reader.ReadTokenData(ctx, msg, tokenIndex)
+	o.histogram.
+		WithLabelValues(o.chainID, o.providerType, strconv.FormatBool(err == nil)).
+		Observe(float64(time.Since(started)))
+	return data, err
+}
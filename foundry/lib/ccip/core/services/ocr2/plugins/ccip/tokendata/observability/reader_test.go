@@ -0,0 +1,44 @@
+package observability
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+)
+
+func TestObservedReader(t *testing.T) {
+	ctx := testutils.Context(t)
+	histogram := promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "test_token_data_reader_histogram",
+		Help: "Latency of calls to the mock token data reader",
+	}, []string{"evmChainID", "provider", "success"})
+
+	reader := tokendata.NewMockReader(t)
+	reader.On("ReadTokenData", mock.Anything, mock.Anything, 0).Return([]byte{1, 2}, nil).Times(3)
+	reader.On("ReadTokenData", mock.Anything, mock.Anything, 1).Return(nil, errors.New("not ready")).Times(2)
+
+	observed := NewObservedReaderWithMetric(reader, 1337, "usdc", histogram)
+	msg := cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{}
+	for i := 0; i < 3; i++ {
+		data, err := observed.ReadTokenData(ctx, msg, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, data)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := observed.ReadTokenData(ctx, msg, 1)
+		require.Error(t, err)
+	}
+
+	assert.Equal(t, 3, counterFromHistogramByLabels(t, histogram, "1337", "usdc", "true"))
+	assert.Equal(t, 2, counterFromHistogramByLabels(t, histogram, "1337", "usdc", "false"))
+}
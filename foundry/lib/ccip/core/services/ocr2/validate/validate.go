@@ -356,10 +356,7 @@ func validateOCR2CCIPExecutionSpec(jsonConfig job.JSONConfig) error {
 	if err != nil {
 		return pkgerrors.Wrap(err, "error while unmarshalling plugin config")
 	}
-	if cfg.USDCConfig != (config.USDCConfig{}) {
-		return cfg.USDCConfig.ValidateUSDCConfig()
-	}
-	return nil
+	return cfg.Validate()
 }
 
 func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig) error {
@@ -372,22 +369,40 @@ func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig) error {
 		return pkgerrors.Wrap(err, "error while unmarshalling plugin config")
 	}
 
-	// Ensure that either the tokenPricesUSDPipeline or the priceGetterConfig is set, but not both.
+	// Ensure that exactly one of the tokenPricesUSDPipeline, priceGetterConfig or medianPriceGetterConfig is set.
 	emptyPipeline := strings.Trim(cfg.TokenPricesUSDPipeline, "\n\t ") == ""
 	emptyPriceGetter := cfg.PriceGetterConfig == nil
-	if emptyPipeline && emptyPriceGetter {
-		return fmt.Errorf("either tokenPricesUSDPipeline or priceGetterConfig must be set")
+	emptyMedianPriceGetter := cfg.MedianPriceGetterConfig == nil
+	if emptyPipeline && emptyPriceGetter && emptyMedianPriceGetter {
+		return fmt.Errorf("either tokenPricesUSDPipeline, priceGetterConfig or medianPriceGetterConfig must be set")
 	}
 	if !emptyPipeline && !emptyPriceGetter {
 		return fmt.Errorf("only one of tokenPricesUSDPipeline or priceGetterConfig must be set: %s and %v", cfg.TokenPricesUSDPipeline, cfg.PriceGetterConfig)
 	}
+	if !emptyMedianPriceGetter && (!emptyPipeline || !emptyPriceGetter) {
+		return fmt.Errorf("medianPriceGetterConfig cannot be combined with tokenPricesUSDPipeline or priceGetterConfig")
+	}
+	if err = cfg.ChainHealth.Validate(); err != nil {
+		return pkgerrors.Wrap(err, "invalid chainHealth")
+	}
+	if err = cfg.PriceConsensus.Validate(); err != nil {
+		return pkgerrors.Wrap(err, "invalid priceConsensus")
+	}
+	if err = config.ValidateDebugRounds(cfg.DebugRounds); err != nil {
+		return err
+	}
 
-	if !emptyPipeline {
+	switch {
+	case !emptyPipeline:
 		_, err = pipeline.Parse(cfg.TokenPricesUSDPipeline)
 		if err != nil {
 			return pkgerrors.Wrap(err, "invalid token prices pipeline")
 		}
-	} else {
+	case !emptyMedianPriceGetter:
+		if err = cfg.MedianPriceGetterConfig.Validate(); err != nil {
+			return pkgerrors.Wrap(err, "invalid medianPriceGetterConfig")
+		}
+	default:
 		// Validate prices config (like it was done for the pipeline).
 		if emptyPriceGetter {
 			return pkgerrors.New("priceGetterConfig is empty")
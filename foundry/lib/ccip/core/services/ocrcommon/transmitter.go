@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 )
@@ -31,11 +32,13 @@ type transmitter struct {
 	effectiveTransmitterAddress common.Address
 	strategy                    types.TxStrategy
 	checker                     txmgr.TransmitCheckerSpec
+	urgency                     feetypes.Urgency
 	chainID                     *big.Int
 	keystore                    roundRobinKeystore
 }
 
-// NewTransmitter creates a new eth transmitter
+// NewTransmitter creates a new eth transmitter, its txs are priced and bumped with the fee tier of the urgency
+// unless it is empty.
 func NewTransmitter(
 	txm txManager,
 	fromAddresses []common.Address,
@@ -43,6 +46,7 @@ func NewTransmitter(
 	effectiveTransmitterAddress common.Address,
 	strategy types.TxStrategy,
 	checker txmgr.TransmitCheckerSpec,
+	urgency feetypes.Urgency,
 	chainID *big.Int,
 	keystore roundRobinKeystore,
 ) (Transmitter, error) {
@@ -59,6 +63,7 @@ func NewTransmitter(
 		effectiveTransmitterAddress: effectiveTransmitterAddress,
 		strategy:                    strategy,
 		checker:                     checker,
+		urgency:                     urgency,
 		chainID:                     chainID,
 		keystore:                    keystore,
 	}, nil
@@ -80,6 +85,7 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Urgency:          t.urgency,
 	})
 	return errors.Wrap(err, "skipped OCR transmission")
 }
@@ -12,6 +12,7 @@ import (
 
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -114,6 +115,8 @@ func NewCCIPExecutionProvider(ctx context.Context, lggr logger.Logger, chainSet
 	subjectID := chainToUUID(configWatcher.chain.ID())
 	contractTransmitter, err := newOnChainContractTransmitter(ctx, lggr, rargs, transmitterID, ks, configWatcher, configTransmitterOpts{
 		subjectID: &subjectID,
+		// The messages of the report are waiting for it, so it is priced and bumped with the High fee tier.
+		urgency: feetypes.UrgencyHigh,
 	}, OCR2AggregatorTransmissionContractABI, fn)
 	if err != nil {
 		return nil, err
@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -484,6 +485,8 @@ type configTransmitterOpts struct {
 	pluginGasLimit *uint32
 	// subjectID overrides the queueing subject id (the job external id will be used by default).
 	subjectID *uuid.UUID
+	// urgency selects the fee tier of the transmissions, they are untiered by default.
+	urgency feetypes.Urgency
 }
 
 // newOnChainContractTransmitter creates a new contract transmitter.
@@ -544,6 +547,7 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 		effectiveTransmitterAddress,
 		strategy,
 		checker,
+		opts.urgency,
 		configWatcher.chain.ID(),
 		ethKeystore,
 	)
@@ -94,6 +94,7 @@ func (r *rebalancerRelayer) NewRebalancerProvider(ctx context.Context, rargs com
 			fromAddresses[0],
 			txmgr.NewSendEveryStrategy(),
 			txmgrtypes.TransmitCheckerSpec[common.Address]{},
+			"",
 			chain.ID(),
 			r.ethKeystore,
 		)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/pelletier/go-toml"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/mercury"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/targets"
+	coreTriggers "github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -71,6 +73,7 @@ type Delegate struct {
 	registry        types.CapabilitiesRegistry
 	logger          logger.Logger
 	legacyEVMChains legacyevm.LegacyChainContainer
+	db              *sqlx.DB
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -95,6 +98,21 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		d.logger.Errorw("could not initialize writes", err)
 	}
 
+	err = targets.InitializeRead(d.registry, d.legacyEVMChains, d.logger)
+	if err != nil {
+		d.logger.Errorw("could not initialize reads", err)
+	}
+
+	err = coreTriggers.InitializeCron(d.registry, d.logger)
+	if err != nil {
+		d.logger.Errorw("could not initialize cron trigger", err)
+	}
+
+	err = coreTriggers.InitializeLogTriggers(d.registry, d.legacyEVMChains, d.logger)
+	if err != nil {
+		d.logger.Errorw("could not initialize log triggers", err)
+	}
+
 	trigger := triggers.NewMercuryTriggerService(d.logger)
 	err = d.registry.Add(context.Background(), trigger)
 	if err != nil {
@@ -108,6 +126,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		Spec:       hardcodedWorkflow,
 		Registry:   d.registry,
 		WorkflowID: mockedWorkflowID,
+		DB:         d.db,
 	}
 	engine, err := NewEngine(cfg)
 	if err != nil {
@@ -116,8 +135,8 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 	return []job.ServiceCtx{engine}, nil
 }
 
-func NewDelegate(logger logger.Logger, registry types.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer) *Delegate {
-	return &Delegate{logger: logger, registry: registry, legacyEVMChains: legacyEVMChains}
+func NewDelegate(logger logger.Logger, registry types.CapabilitiesRegistry, legacyEVMChains legacyevm.LegacyChainContainer, db *sqlx.DB) *Delegate {
+	return &Delegate{logger: logger, registry: registry, legacyEVMChains: legacyEVMChains, db: db}
 }
 
 func mercuryEventLoop(trigger *triggers.MercuryTriggerService, logger logger.Logger) {
@@ -4,17 +4,23 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/dominikbraun/graph"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
+var errStepTimeout = errors.New("step execution timed out")
+
 const (
 	// NOTE: max 32 bytes per ID - consider enforcing exactly 32 bytes?
 	mockedTriggerID  = "cccccccccc0000000000000000000000"
@@ -27,7 +33,7 @@ type Engine struct {
 	logger              logger.Logger
 	registry            types.CapabilitiesRegistry
 	workflow            *workflow
-	executionStates     *inMemoryStore
+	executionStates     store
 	pendingStepRequests chan stepRequest
 	triggerEvents       chan capabilities.CapabilityResponse
 	newWorkerCh         chan struct{}
@@ -145,7 +151,10 @@ LOOP:
 		}
 	}
 
-	// We have all needed capabilities, now we can register for trigger events
+	// We have all needed capabilities, now we can pick up the executions interrupted by a restart
+	e.resumeExecutions(ctx)
+
+	// and register for trigger events
 	for _, t := range e.workflow.triggers {
 		err := e.registerTrigger(ctx, t)
 		if err != nil {
@@ -281,7 +290,9 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event v
 				outputs: &stepOutput{
 					value: event,
 				},
-				status: statusCompleted,
+				status:      statusCompleted,
+				executionID: executionID,
+				ref:         keywordTrigger,
 			},
 		},
 		workflowID:  e.workflow.id,
@@ -309,6 +320,59 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event v
 	return nil
 }
 
+// resumeExecutions resumes the executions left in progress, e.g. by a node restart.
+// Steps that had not completed are scheduled again, executions that cannot be resumed are marked as errored.
+//
+// Only the step results are persisted, a step that was executing when the node stopped is executed again.
+// Steps are therefore executed at least once, and targets must tolerate writing the same report twice.
+func (e *Engine) resumeExecutions(ctx context.Context) {
+	states, err := e.executionStates.unfinished(ctx, e.workflow.id)
+	if err != nil {
+		e.logger.Errorw("failed to get unfinished executions", "err", err)
+		return
+	}
+
+	for _, state := range states {
+		err := e.resumeExecution(ctx, state)
+		if err == nil {
+			continue
+		}
+
+		e.logger.Errorw("failed to resume execution, marking it as errored", "executionID", state.executionID, "err", err)
+		if err := e.finishExecution(ctx, state.executionID, statusErrored); err != nil {
+			e.logger.Errorw("failed to mark execution as errored", "executionID", state.executionID, "err", err)
+		}
+	}
+}
+
+func (e *Engine) resumeExecution(ctx context.Context, state executionState) error {
+	for ref, stepState := range state.steps {
+		s, err := e.workflow.Vertex(ref)
+		if err != nil {
+			return fmt.Errorf("step %s is not part of the workflow anymore: %w", ref, err)
+		}
+		if stepFailed(stepState.status) && s.OnError == "" {
+			return fmt.Errorf("step %s errored", ref)
+		}
+	}
+
+	// The execution might have been interrupted after its last step completed.
+	pending, err := e.pendingSteps(state)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) == 0 {
+		return e.finishExecution(ctx, state.executionID, statusCompleted)
+	}
+
+	e.logger.Infow("resuming execution", "executionID", state.executionID)
+	for _, s := range pending {
+		e.queueIfReady(state, s)
+	}
+	return nil
+}
+
 func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate stepState) error {
 	state, err := e.executionStates.updateStep(ctx, &stepUpdate)
 	if err != nil {
@@ -322,54 +386,96 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate stepState) err
 			return err
 		}
 
-		// There are no steps left to process in the current path, so let's check if
-		// we've completed the workflow.
-		// If not, we'll check for any dependents that are ready to process.
-		if len(stepDependents) == 0 {
-			workflowCompleted := true
-			err := e.workflow.walkDo(keywordTrigger, func(s *step) error {
-				step, ok := state.steps[s.Ref]
-				// The step is missing from the state,
-				// which means it hasn't been processed yet.
-				// Let's mark `workflowCompleted` = false, and
-				// continue.
-				if !ok {
-					workflowCompleted = false
-					return nil
-				}
-
-				switch step.status {
-				case statusCompleted, statusErrored:
-				default:
-					workflowCompleted = false
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-
-			if workflowCompleted {
-				err := e.finishExecution(ctx, state.executionID, statusCompleted)
-				if err != nil {
-					return err
-				}
-			}
-		}
-
 		for _, sd := range stepDependents {
 			e.queueIfReady(state, sd)
 		}
-	case statusErrored:
-		err := e.finishExecution(ctx, state.executionID, statusErrored)
+	case statusErrored, statusTimeout:
+		s, err := e.workflow.Vertex(stepUpdate.ref)
+		if err != nil {
+			return err
+		}
+
+		// Without an error step, a failed step fails the whole execution.
+		if s.OnError == "" {
+			return e.finishExecution(ctx, state.executionID, statusErrored)
+		}
+
+		errorStep, err := e.workflow.Vertex(s.OnError)
 		if err != nil {
 			return err
 		}
+
+		e.logger.Infow("step failed, routing to its error step", "executionID", state.executionID, "stepRef", s.Ref, "onError", s.OnError)
+		e.queueIfReady(state, errorStep)
+	}
+
+	// Let's check if we've completed the workflow, i.e. no step is
+	// left to process, either executing, queued, or waiting on dependencies.
+	pending, err := e.pendingSteps(state)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) == 0 {
+		return e.finishExecution(ctx, state.executionID, statusCompleted)
 	}
 
 	return nil
 }
 
+// pendingSteps returns the steps of an execution which haven't finished but still can run.
+// This includes the steps being executed, the queued ones and the ones waiting on dependencies.
+// Steps depending on a failed step, and error steps whose step didn't fail, won't run.
+func (e *Engine) pendingSteps(state executionState) ([]*step, error) {
+	refs, err := graph.TopologicalSort(e.workflow.Graph)
+	if err != nil {
+		return nil, err
+	}
+
+	// willRun returns true if the step completed, or is pending itself.
+	// Steps are processed in topological order, so any dependency is already known.
+	canRun := map[string]bool{}
+	willRun := func(ref string) bool {
+		st, ok := state.steps[ref]
+		return (ok && st.status == statusCompleted) || canRun[ref]
+	}
+
+	var pending []*step
+	for _, ref := range refs {
+		if _, ok := state.steps[ref]; ok {
+			continue
+		}
+
+		s, err := e.workflow.Vertex(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		runnable := true
+		for _, dr := range s.dependencies {
+			if !willRun(dr) {
+				runnable = false
+			}
+		}
+
+		if s.errorStepOf != "" {
+			st, ok := state.steps[s.errorStepOf]
+			runnable = runnable && ((ok && stepFailed(st.status)) || canRun[s.errorStepOf])
+		}
+
+		if runnable {
+			canRun[ref] = true
+			pending = append(pending, s)
+		}
+	}
+
+	return pending, nil
+}
+
+func stepFailed(status string) bool {
+	return status == statusErrored || status == statusTimeout
+}
+
 func (e *Engine) queueIfReady(state executionState, step *step) {
 	// Check if all dependencies are completed for the current step
 	var waitingOnDependencies bool
@@ -390,6 +496,14 @@ func (e *Engine) queueIfReady(state executionState, step *step) {
 		}
 	}
 
+	// An error step only runs once the step routing its errors to it has failed.
+	if step.errorStepOf != "" {
+		stepState, ok := state.steps[step.errorStepOf]
+		if !ok || !stepFailed(stepState.status) {
+			waitingOnDependencies = true
+		}
+	}
+
 	// If all dependencies are completed, enqueue the step.
 	if !waitingOnDependencies {
 		e.logger.Debugw("step request enqueued", "ref", step.Ref, "state", copyState(state))
@@ -429,11 +543,14 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		ref:         msg.stepRef,
 	}
 
-	inputs, outputs, err := e.executeStep(ctx, msg)
+	inputs, outputs, err := e.executeStepWithRetries(ctx, msg)
 	if err != nil {
 		e.logger.Errorf("error executing step request: %s", err, "executionID", msg.state.executionID, "stepRef", msg.stepRef)
 		stepState.outputs.err = err
 		stepState.status = statusErrored
+		if errors.Is(err, errStepTimeout) {
+			stepState.status = statusTimeout
+		}
 	} else {
 		e.logger.Infow("step executed successfully", "executionID", msg.state.executionID, "stepRef", msg.stepRef, "outputs", outputs)
 		stepState.outputs.value = outputs
@@ -446,8 +563,9 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	// If the context is canceled, we'll just drop the update.
 	// This means the engine is shutting down and the
 	// receiving loop may not pick up any messages we emit.
-	// Note: When full persistence support is added, any hanging steps
-	// like this one will get picked up again and will be reprocessed.
+	// Note: When the executions are persisted, any hanging steps
+	// like this one will get picked up again and will be reprocessed
+	// when the engine restarts.
 	select {
 	case <-ctx.Done():
 		e.logger.Errorf("context canceled before step update could be issued", err, "executionID", msg.state.executionID, "stepRef", msg.stepRef)
@@ -455,13 +573,33 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	}
 }
 
-// executeStep executes the referenced capability within a step and returns the result.
-func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map, values.Value, error) {
+// executeStepWithRetries executes a step up to its maximum number of attempts, waiting for
+// the step backoff between attempts. The result of the last attempt is returned.
+func (e *Engine) executeStepWithRetries(ctx context.Context, msg stepRequest) (*values.Map, values.Value, error) {
 	step, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	for attempt := 1; ; attempt++ {
+		inputs, outputs, err := e.executeStep(ctx, step, msg)
+		if err == nil || attempt >= step.maxAttempts {
+			return inputs, outputs, err
+		}
+
+		backoff := step.retryBackoff(attempt)
+		e.logger.Warnw("step execution failed, retrying", "executionID", msg.state.executionID, "stepRef", msg.stepRef, "attempt", attempt, "backoff", backoff, "err", err)
+		select {
+		case <-ctx.Done():
+			return inputs, nil, err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// executeStep executes the referenced capability within a step and returns the result.
+func (e *Engine) executeStep(ctx context.Context, step *step, msg stepRequest) (*values.Map, values.Value, error) {
+
 	i, err := findAndInterpolateAllKeys(step.Inputs, msg.state)
 	if err != nil {
 		return nil, nil, err
@@ -481,8 +619,18 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 		},
 	}
 
-	resp, err := capabilities.ExecuteSync(ctx, step.capability, tr)
+	execCtx := ctx
+	if step.timeout > 0 {
+		var cancel context.CancelFunc
+		execCtx, cancel = context.WithTimeout(ctx, step.timeout)
+		defer cancel()
+	}
+
+	resp, err := capabilities.ExecuteSync(execCtx, step.capability, tr)
 	if err != nil {
+		if errors.Is(execCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return inputs, nil, fmt.Errorf("%w after %s: %w", errStepTimeout, step.timeout, err)
+		}
 		return inputs, nil, err
 	}
 
@@ -566,6 +714,9 @@ type Config struct {
 	MaxWorkerLimit   int
 	QueueSize        int
 	NewWorkerTimeout time.Duration
+	// DB persists the executions, they are kept in memory if nil.
+	// Persisted executions are resumed on start, see Engine.resumeExecutions.
+	DB pg.Queryer
 }
 
 const (
@@ -608,11 +759,17 @@ func NewEngine(cfg Config) (engine *Engine, err error) {
 		newWorkerCh <- struct{}{}
 	}
 
+	lggr := cfg.Lggr.Named("WorkflowEngine")
+	var executionStates store = newInMemoryStore()
+	if cfg.DB != nil {
+		executionStates = newDBStore(cfg.DB, lggr)
+	}
+
 	engine = &Engine{
-		logger:               cfg.Lggr.Named("WorkflowEngine"),
+		logger:               lggr,
 		registry:             cfg.Registry,
 		workflow:             workflow,
-		executionStates:      newInMemoryStore(),
+		executionStates:      executionStates,
 		pendingStepRequests:  make(chan stepRequest, cfg.QueueSize),
 		newWorkerCh:          newWorkerCh,
 		stepUpdateCh:         make(chan stepState),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -387,3 +389,302 @@ func TestEngine_MultiStepDependencies(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, obs.([]any)[1], o)
 }
+
+func TestEngine_ResumesUnfinishedExecutions(t *testing.T) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, cr := mockTrigger(t)
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus()))
+	target := mockTarget()
+	require.NoError(t, reg.Add(ctx, target))
+
+	action, _ := mockAction()
+	require.NoError(t, reg.Add(ctx, action))
+
+	cfg := Config{
+		Lggr:     logger.TestLogger(t),
+		Registry: reg,
+		Spec:     multiStepWorkflow,
+	}
+	eng, err := NewEngine(cfg)
+	require.NoError(t, err)
+
+	unfinished := func(executionID string, steps map[string]*stepState) *executionState {
+		steps[keywordTrigger] = &stepState{
+			executionID: executionID,
+			ref:         keywordTrigger,
+			status:      statusCompleted,
+			outputs:     &stepOutput{value: cr.Value},
+		}
+		return &executionState{steps: steps, executionID: executionID, status: statusStarted}
+	}
+	// Interrupted after the action step completed, evm_median and the target are left to execute.
+	require.NoError(t, eng.executionStates.add(ctx, unfinished("resumed", map[string]*stepState{
+		"read_chain_action": {
+			executionID: "resumed",
+			ref:         "read_chain_action",
+			status:      statusCompleted,
+			outputs:     &stepOutput{value: values.NewString("output")},
+		},
+	})))
+	// Interrupted before the errored step could finish the execution.
+	require.NoError(t, eng.executionStates.add(ctx, unfinished("errored", map[string]*stepState{
+		"read_chain_action": {
+			executionID: "errored",
+			ref:         "read_chain_action",
+			status:      statusErrored,
+			outputs:     &stepOutput{err: errors.New("fatal action error")},
+		},
+	})))
+	// Started with a step which isn't part of the workflow anymore.
+	require.NoError(t, eng.executionStates.add(ctx, unfinished("unknown-step", map[string]*stepState{
+		"removed_step": {
+			executionID: "unknown-step",
+			ref:         "removed_step",
+			status:      statusCompleted,
+			outputs:     &stepOutput{},
+		},
+	})))
+
+	err = eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	for eid := ""; eid != "resumed"; {
+		eid = <-eng.xxxExecutionFinished
+	}
+
+	for executionID, expectedStatus := range map[string]string{
+		"resumed":      statusCompleted,
+		"errored":      statusErrored,
+		"unknown-step": statusErrored,
+	} {
+		require.Eventually(t, func() bool {
+			state, err := eng.executionStates.get(ctx, executionID)
+			require.NoError(t, err)
+			return state.status == expectedStatus
+		}, testutils.WaitTimeout(t), testutils.TestInterval, executionID)
+	}
+
+	state, err := eng.executionStates.get(ctx, "resumed")
+	require.NoError(t, err)
+	assert.Equal(t, statusCompleted, state.steps["evm_median"].status)
+	assert.Equal(t, statusCompleted, state.steps["write_polygon-testnet-mumbai"].status)
+}
+
+func TestEngine_PersistsAndResumesExecutionsInDB(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	trigger, cr := mockTrigger(t)
+	consensus := mockConsensus()
+	target := mockTarget()
+	newEngine := func() *Engine {
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, consensus))
+		require.NoError(t, reg.Add(ctx, target))
+
+		eng, err := NewEngine(Config{
+			Lggr:       logger.TestLogger(t),
+			Registry:   reg,
+			Spec:       simpleWorkflow,
+			WorkflowID: mockedWorkflowID,
+			DB:         db,
+		})
+		require.NoError(t, err)
+		return eng
+	}
+
+	eng := newEngine()
+	require.NoError(t, eng.Start(ctx))
+	eid := <-eng.xxxExecutionFinished
+	assert.Equal(t, cr, <-target.response)
+	require.NoError(t, eng.Close())
+
+	state, err := eng.executionStates.get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, statusCompleted, state.status)
+	require.Contains(t, state.steps, keywordTrigger)
+	assert.Equal(t, eid, state.steps[keywordTrigger].executionID)
+	assert.Equal(t, statusCompleted, state.steps["evm_median"].status)
+	assert.Equal(t, statusCompleted, state.steps["write_polygon-testnet-mumbai"].status)
+
+	// Interrupt the execution before its target step completed.
+	_, err = db.ExecContext(ctx, `DELETE FROM workflow_steps WHERE workflow_execution_id = $1 AND ref = $2`, eid, "write_polygon-testnet-mumbai")
+	require.NoError(t, err)
+	require.NoError(t, eng.executionStates.updateStatus(ctx, eid, statusStarted))
+
+	eng = newEngine()
+	require.NoError(t, eng.Start(ctx))
+	defer eng.Close()
+	assert.Equal(t, eid, <-eng.xxxExecutionFinished)
+	assert.Equal(t, cr, <-target.response)
+
+	state, err = eng.executionStates.get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, statusCompleted, state.status)
+	assert.Equal(t, statusCompleted, state.steps["write_polygon-testnet-mumbai"].status)
+}
+
+const (
+	executionPolicyWorkflow = `
+triggers:
+  - type: "mercury-trigger"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - type: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+    retry:
+      maxAttempts: 3
+      backoff: 10ms
+
+targets:
+  - type: "write_polygon-testnet-mumbai"
+    ref: "write_polygon"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+    timeout: 50ms
+    onError: "write_polygon_fallback"
+  - type: "write_polygon-testnet-mumbai-fallback"
+    ref: "write_polygon_fallback"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+`
+)
+
+func mockFlakyConsensus(failures int) *mockCapability {
+	consensus := mockConsensus()
+	transform := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if failures > 0 {
+			failures--
+			return capabilities.CapabilityResponse{}, errors.New("transient consensus error")
+		}
+		return transform(req)
+	}
+	return consensus
+}
+
+func mockFallbackTarget() *mockCapability {
+	return newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"write_polygon-testnet-mumbai-fallback",
+			capabilities.CapabilityTypeTarget,
+			"a fallback write capability targeting polygon mumbai testnet",
+			"v1.0.0",
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			m := req.Inputs.Underlying["report"].(*values.Map)
+			return capabilities.CapabilityResponse{
+				Value: m,
+			}, nil
+		},
+	)
+}
+
+func runExecutionPolicyWorkflow(t *testing.T, consensus *mockCapability, target *mockCapability, fallback *mockCapability) executionState {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, target))
+	require.NoError(t, reg.Add(ctx, fallback))
+
+	cfg := Config{
+		Lggr:     logger.TestLogger(t),
+		Registry: reg,
+		Spec:     executionPolicyWorkflow,
+	}
+	eng, err := NewEngine(cfg)
+	require.NoError(t, err)
+
+	err = eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	eid := <-eng.xxxExecutionFinished
+	state, err := eng.executionStates.get(ctx, eid)
+	require.NoError(t, err)
+	return state
+}
+
+func TestEngine_RetriesFailedSteps(t *testing.T) {
+	t.Run("succeeds within the max attempts", func(t *testing.T) {
+		state := runExecutionPolicyWorkflow(t, mockFlakyConsensus(2), mockTarget(), mockFallbackTarget())
+
+		assert.Equal(t, statusCompleted, state.status)
+		assert.Equal(t, statusCompleted, state.steps["evm_median"].status)
+		assert.Equal(t, statusCompleted, state.steps["write_polygon"].status)
+		// The error step only runs when its step fails.
+		assert.NotContains(t, state.steps, "write_polygon_fallback")
+	})
+
+	t.Run("fails after the max attempts", func(t *testing.T) {
+		state := runExecutionPolicyWorkflow(t, mockFlakyConsensus(3), mockTarget(), mockFallbackTarget())
+
+		assert.Equal(t, statusErrored, state.status)
+		assert.Equal(t, statusErrored, state.steps["evm_median"].status)
+		assert.EqualError(t, state.steps["evm_median"].outputs.err, "transient consensus error")
+	})
+}
+
+func TestEngine_RoutesFailedStepsToTheirErrorStep(t *testing.T) {
+	t.Run("errored step", func(t *testing.T) {
+		target := mockTarget()
+		target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, errors.New("fatal write error")
+		}
+		fallback := mockFallbackTarget()
+		state := runExecutionPolicyWorkflow(t, mockConsensus(), target, fallback)
+
+		assert.Equal(t, statusCompleted, state.status)
+		assert.Equal(t, statusErrored, state.steps["write_polygon"].status)
+		assert.Equal(t, statusCompleted, state.steps["write_polygon_fallback"].status)
+		assert.Len(t, fallback.response, 1)
+	})
+
+	t.Run("timed out step", func(t *testing.T) {
+		target := mockTarget()
+		target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			time.Sleep(time.Second)
+			return capabilities.CapabilityResponse{}, errors.New("too late")
+		}
+		state := runExecutionPolicyWorkflow(t, mockConsensus(), target, mockFallbackTarget())
+
+		assert.Equal(t, statusCompleted, state.status)
+		assert.Equal(t, statusTimeout, state.steps["write_polygon"].status)
+		assert.ErrorIs(t, state.steps["write_polygon"].outputs.err, errStepTimeout)
+		assert.Equal(t, statusCompleted, state.steps["write_polygon_fallback"].status)
+	})
+
+	t.Run("failed error step", func(t *testing.T) {
+		target := mockTarget()
+		target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, errors.New("fatal write error")
+		}
+		fallback := mockFallbackTarget()
+		fallback.transform = target.transform
+		state := runExecutionPolicyWorkflow(t, mockConsensus(), target, fallback)
+
+		assert.Equal(t, statusErrored, state.status)
+		assert.Equal(t, statusErrored, state.steps["write_polygon_fallback"].status)
+	})
+}
@@ -3,6 +3,8 @@ package workflows
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/dominikbraun/graph"
 
@@ -23,6 +25,17 @@ type stepDefinition struct {
 	Ref    string         `json:"ref,omitempty" jsonschema:"pattern=^[a-z0-9_]+$"`
 	Inputs map[string]any `json:"inputs,omitempty"`
 	Config map[string]any `json:"config" jsonschema:"required"`
+
+	Retry   *stepRetry `json:"retry,omitempty"`
+	Timeout string     `json:"timeout,omitempty"`
+	OnError string     `json:"onError,omitempty"`
+}
+
+// stepRetry is the retry policy of a step. Durations use the time.ParseDuration format.
+type stepRetry struct {
+	MaxAttempts uint32 `json:"maxAttempts" jsonschema:"required,minimum=1"`
+	Backoff     string `json:"backoff,omitempty"`
+	MaxBackoff  string `json:"maxBackoff,omitempty"`
 }
 
 // workflowSpec is the parsed representation of a workflow.
@@ -109,6 +122,27 @@ type step struct {
 	dependencies []string
 	capability   capabilities.CallbackExecutable
 	config       *values.Map
+
+	// maxAttempts, backoff, maxBackoff and timeout are the parsed execution policy of the step.
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	timeout     time.Duration
+	// errorStepOf is the ref of the step routing its failures to this step, if any.
+	// Such a step only runs when that step fails.
+	errorStepOf string
+}
+
+// retryBackoff returns the delay before the given retry, the backoff doubles with every attempt.
+func (s *step) retryBackoff(retry int) time.Duration {
+	backoff := s.backoff
+	for i := 1; i < retry && (s.maxBackoff == 0 || backoff < s.maxBackoff); i++ {
+		backoff *= 2
+	}
+	if s.maxBackoff > 0 && backoff > s.maxBackoff {
+		backoff = s.maxBackoff
+	}
+	return backoff
 }
 
 type triggerCapability struct {
@@ -149,6 +183,12 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 		return nil, err
 	}
 
+	for _, t := range spec.Triggers {
+		if t.Retry != nil || t.Timeout != "" || t.OnError != "" {
+			return nil, fmt.Errorf("trigger %s cannot define retry, timeout or onError", t.Type)
+		}
+	}
+
 	// Next, let's populate the other entries in the graph.
 	for _, s := range spec.steps() {
 		// TODO: The workflow format spec doesn't always require a `Ref`
@@ -159,7 +199,13 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 			s.Ref = s.Type
 		}
 
-		innerErr := g.AddVertex(&step{stepDefinition: s})
+		st := &step{stepDefinition: s}
+		innerErr := parseExecutionPolicy(st)
+		if innerErr != nil {
+			return nil, fmt.Errorf("invalid step %s: %w", s.Ref, innerErr)
+		}
+
+		innerErr = g.AddVertex(st)
 		if innerErr != nil {
 			return nil, fmt.Errorf("cannot add vertex %s: %w", s.Ref, innerErr)
 		}
@@ -196,6 +242,25 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 		}
 	}
 
+	// Finally, route the failures of steps with an `onError` to their error step.
+	// The edge only prevents cycles: the error step doesn't depend on the outputs
+	// of the failed step, which has none.
+	for stepRef := range stepRefs {
+		step, innerErr := g.Vertex(stepRef)
+		if innerErr != nil {
+			return nil, innerErr
+		}
+
+		if step.OnError == "" {
+			continue
+		}
+
+		innerErr = addErrorStep(g, step)
+		if innerErr != nil {
+			return nil, fmt.Errorf("invalid onError of step %s: %w", step.Ref, innerErr)
+		}
+	}
+
 	triggerSteps := []*triggerCapability{}
 	for _, t := range spec.Triggers {
 		triggerSteps = append(triggerSteps, &triggerCapability{
@@ -209,3 +274,73 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 	}
 	return wf, err
 }
+
+// parseExecutionPolicy parses and validates the retry and timeout of a step.
+func parseExecutionPolicy(s *step) error {
+	s.maxAttempts = 1
+	if s.Timeout != "" {
+		timeout, err := time.ParseDuration(s.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout: %w", err)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive, got %s", s.Timeout)
+		}
+		s.timeout = timeout
+	}
+
+	if s.Retry == nil {
+		return nil
+	}
+
+	if s.Retry.MaxAttempts < 1 {
+		return errors.New("retry maxAttempts must be at least 1")
+	}
+	s.maxAttempts = int(s.Retry.MaxAttempts)
+
+	if s.Retry.Backoff != "" {
+		backoff, err := time.ParseDuration(s.Retry.Backoff)
+		if err != nil {
+			return fmt.Errorf("invalid retry backoff: %w", err)
+		}
+		if backoff < 0 {
+			return fmt.Errorf("retry backoff cannot be negative, got %s", s.Retry.Backoff)
+		}
+		s.backoff = backoff
+	}
+
+	if s.Retry.MaxBackoff != "" {
+		maxBackoff, err := time.ParseDuration(s.Retry.MaxBackoff)
+		if err != nil {
+			return fmt.Errorf("invalid retry maxBackoff: %w", err)
+		}
+		if maxBackoff < s.backoff {
+			return fmt.Errorf("retry maxBackoff %s is lower than backoff %s", s.Retry.MaxBackoff, s.Retry.Backoff)
+		}
+		s.maxBackoff = maxBackoff
+	}
+	return nil
+}
+
+// addErrorStep validates the error step of a failing step and adds the edge between them.
+func addErrorStep(g graph.Graph[string, *step], failing *step) error {
+	if failing.OnError == keywordTrigger || failing.OnError == failing.Ref {
+		return fmt.Errorf("cannot route errors to step %s", failing.OnError)
+	}
+
+	errorStep, err := g.Vertex(failing.OnError)
+	if err != nil {
+		return fmt.Errorf("step %s: %w", failing.OnError, err)
+	}
+
+	if errorStep.errorStepOf != "" {
+		return fmt.Errorf("step %s already handles the errors of step %s", errorStep.Ref, errorStep.errorStepOf)
+	}
+
+	if slices.Contains(errorStep.dependencies, failing.Ref) {
+		return fmt.Errorf("step %s cannot depend on the outputs of the failing step", errorStep.Ref)
+	}
+
+	errorStep.errorStepOf = failing.Ref
+	return g.AddEdge(failing.Ref, errorStep.Ref)
+}
@@ -2,6 +2,7 @@ package workflows
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -211,6 +212,162 @@ targets:
 `,
 			errMsg: "all non-trigger steps must have a dependent ref",
 		},
+		{
+			name: "onError routes to an error step",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+    onError: "a-fallback-target"
+  - type: "a-fallback-target"
+    ref: "a-fallback-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+`,
+			graph: map[string]map[string]struct{}{
+				keywordTrigger: {
+					"a-consensus": struct{}{},
+				},
+				"a-consensus": {
+					"a-target":          struct{}{},
+					"a-fallback-target": struct{}{},
+				},
+				"a-target": {
+					"a-fallback-target": struct{}{},
+				},
+				"a-fallback-target": {},
+			},
+		},
+		{
+			name: "onError step doesn't exist",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+    onError: "a-missing-target"
+`,
+			errMsg: "invalid onError of step a-target: step a-missing-target: vertex not found",
+		},
+		{
+			name: "onError routes to the step itself",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+    onError: "a-target"
+`,
+			errMsg: "cannot route errors to step a-target",
+		},
+		{
+			name: "onError step depends on the failing step",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+    onError: "a-target"
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+`,
+			errMsg: "step a-target cannot depend on the outputs of the failing step",
+		},
+		{
+			name: "onError step shared by two steps",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+    onError: "a-fallback-target"
+  - type: "a-second-target"
+    ref: "a-second-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+    onError: "a-fallback-target"
+  - type: "a-fallback-target"
+    ref: "a-fallback-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+`,
+			errMsg: "step a-fallback-target already handles the errors of step",
+		},
+		{
+			name: "onError creates a cycle",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+      fallback_output: $(a-fallback-target.outputs)
+    onError: "a-fallback-target"
+  - type: "a-fallback-target"
+    ref: "a-fallback-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+`,
+			errMsg: "edge would create a cycle",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -239,3 +396,134 @@ targets:
 		})
 	}
 }
+
+func TestParse_ExecutionPolicy(t *testing.T) {
+	workflowWithTarget := func(target string) string {
+		return `
+triggers:
+  - type: "a-trigger"
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+` + target
+	}
+
+	testCases := []struct {
+		name        string
+		yaml        string
+		maxAttempts int
+		backoffs    []time.Duration
+		timeout     time.Duration
+		errMsg      string
+	}{
+		{
+			name:        "defaults to a single attempt without timeout",
+			yaml:        workflowWithTarget(""),
+			maxAttempts: 1,
+			backoffs:    []time.Duration{0},
+		},
+		{
+			name: "retry with capped exponential backoff",
+			yaml: workflowWithTarget(`
+    retry:
+      maxAttempts: 5
+      backoff: 1s
+      maxBackoff: 5s
+    timeout: 30s
+`),
+			maxAttempts: 5,
+			backoffs:    []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+			timeout:     30 * time.Second,
+		},
+		{
+			name: "retry without backoff",
+			yaml: workflowWithTarget(`
+    retry:
+      maxAttempts: 3
+`),
+			maxAttempts: 3,
+			backoffs:    []time.Duration{0, 0},
+		},
+		{
+			name: "no attempts",
+			yaml: workflowWithTarget(`
+    retry:
+      maxAttempts: 0
+`),
+			errMsg: "invalid step a-target: retry maxAttempts must be at least 1",
+		},
+		{
+			name: "invalid backoff",
+			yaml: workflowWithTarget(`
+    retry:
+      maxAttempts: 2
+      backoff: soon
+`),
+			errMsg: "invalid step a-target: invalid retry backoff",
+		},
+		{
+			name: "max backoff lower than backoff",
+			yaml: workflowWithTarget(`
+    retry:
+      maxAttempts: 2
+      backoff: 10s
+      maxBackoff: 1s
+`),
+			errMsg: "retry maxBackoff 1s is lower than backoff 10s",
+		},
+		{
+			name:   "invalid timeout",
+			yaml:   workflowWithTarget("    timeout: -1s\n"),
+			errMsg: "invalid step a-target: timeout must be positive",
+		},
+		{
+			name: "policy on a trigger",
+			yaml: `
+triggers:
+  - type: "a-trigger"
+    timeout: 10s
+
+consensus:
+  - type: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - type: "a-target"
+    ref: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+`,
+			errMsg: "trigger a-trigger cannot define retry, timeout or onError",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wf, err := Parse(tc.yaml)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			s, err := wf.Vertex("a-target")
+			require.NoError(t, err)
+			assert.Equal(t, tc.maxAttempts, s.maxAttempts)
+			assert.Equal(t, tc.timeout, s.timeout)
+			for i, backoff := range tc.backoffs {
+				assert.Equal(t, backoff, s.retryBackoff(i+1), "retry %d", i+1)
+			}
+		})
+	}
+}
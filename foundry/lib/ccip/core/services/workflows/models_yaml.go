@@ -230,6 +230,48 @@ type stepDefinitionYaml struct {
 	//        method: "updateFeedValues(report bytes, role uint8)"
 	//        params: [$(inputs.report), 1]
 	Config mapping `json:"config" jsonschema:"required"`
+
+	// Actions, Consensus and Targets can specify an optional “retry” property. A failed execution of the capability is retried until it succeeds or “maxAttempts” executions were made. The delay before the first retry is “backoff”, and it doubles after every retry up to “maxBackoff”. Durations follow the Go duration format, e.g. “500ms” or “1m”.
+	//
+	// Validation must throw an error if:
+	//  - “maxAttempts” is lower than 1.
+	//  - A duration cannot be parsed, or “maxBackoff” is lower than “backoff”.
+	//  - “retry” is defined on triggers.
+	//
+	// Example
+	//  retry:
+	//    maxAttempts: 3
+	//    backoff: 1s
+	//    maxBackoff: 10s
+	Retry *stepRetry `json:"retry,omitempty"`
+
+	// The optional “timeout” property bounds the duration of every execution attempt of the capability. An attempt exceeding it fails with a timeout.
+	//
+	// Validation must throw an error if:
+	//  - The duration cannot be parsed or isn't positive.
+	//  - “timeout” is defined on triggers.
+	Timeout string `json:"timeout,omitempty"`
+
+	// The optional “onError” property references a step executed when this step fails, after all its retries, instead of erroring the whole workflow execution. The referenced step only runs on such a failure, once its own “inputs” dependencies have completed.
+	//
+	// Validation must throw an error if:
+	//  - The referenced step doesn't exist, is the step itself or is a trigger.
+	//  - The referenced step is already the “onError” of another step.
+	//  - The referenced step depends on the outputs of this step.
+	//  - “onError” is defined on triggers.
+	//
+	// Example
+	//  targets:
+	//    - type: write_polygon_mainnet@1
+	//      ref: write_polygon
+	//      inputs:
+	//        report: $(evm_median.outputs.report)
+	//      onError: write_polygon_fallback
+	//    - type: write_polygon_mainnet_fallback@1
+	//      ref: write_polygon_fallback
+	//      inputs:
+	//        report: $(evm_median.outputs.report)
+	OnError string `json:"onError,omitempty" jsonschema:"pattern=^[a-z0-9_]+$"`
 }
 
 // toStepDefinition converts a stepDefinitionYaml to a stepDefinition.
@@ -241,6 +283,10 @@ func (s stepDefinitionYaml) toStepDefinition() stepDefinition {
 		Type:   s.Type.String(),
 		Inputs: s.Inputs,
 		Config: s.Config,
+
+		Retry:   s.Retry,
+		Timeout: s.Timeout,
+		OnError: s.OnError,
 	}
 }
 
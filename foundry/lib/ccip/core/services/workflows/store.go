@@ -6,9 +6,24 @@ import (
 	"sync"
 )
 
-// `inMemoryStore` is a temporary in-memory
-// equivalent of the database table that should persist
-// workflow progress.
+// store persists the progress of workflow executions.
+type store interface {
+	// add adds a new execution state under the given executionID
+	add(ctx context.Context, state *executionState) error
+	// updateStep updates a step for the given executionID
+	updateStep(ctx context.Context, step *stepState) (executionState, error)
+	// updateStatus updates the status for the given executionID
+	updateStatus(ctx context.Context, executionID string, status string) error
+	// get gets the state for the given executionID
+	get(ctx context.Context, executionID string) (executionState, error)
+	// unfinished returns the executions of the given workflow still in progress
+	unfinished(ctx context.Context, workflowID string) ([]executionState, error)
+}
+
+var _ store = (*inMemoryStore)(nil)
+
+// `inMemoryStore` is an in-memory store, used when
+// the workflow progress doesn't need to survive restarts.
 type inMemoryStore struct {
 	idToState map[string]*executionState
 	mu        sync.RWMutex
@@ -68,3 +83,16 @@ func (s *inMemoryStore) get(ctx context.Context, executionID string) (executionS
 
 	return *state, nil
 }
+
+// unfinished returns the executions of the given workflow still in progress
+func (s *inMemoryStore) unfinished(ctx context.Context, workflowID string) ([]executionState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var states []executionState
+	for _, state := range s.idToState {
+		if state.workflowID == workflowID && state.status == statusStarted {
+			states = append(states, *state)
+		}
+	}
+	return states, nil
+}
@@ -0,0 +1,248 @@
+package workflows
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/values/pb"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+var _ store = (*dbStore)(nil)
+
+// dbStore persists the workflow executions and their step states in the
+// workflow_executions and workflow_steps tables, so that they survive node restarts.
+type dbStore struct {
+	q    pg.Queryer
+	lggr logger.Logger
+}
+
+func newDBStore(q pg.Queryer, lggr logger.Logger) *dbStore {
+	return &dbStore{q: q, lggr: lggr}
+}
+
+type executionRow struct {
+	ID         string `db:"id"`
+	WorkflowID string `db:"workflow_id"`
+	Status     string `db:"status"`
+}
+
+type stepRow struct {
+	WorkflowExecutionID string         `db:"workflow_execution_id"`
+	Ref                 string         `db:"ref"`
+	Status              string         `db:"status"`
+	Inputs              []byte         `db:"inputs"`
+	Outputs             []byte         `db:"outputs"`
+	OutputErr           sql.NullString `db:"output_err"`
+}
+
+// add adds a new execution state under the given executionID
+func (s *dbStore) add(ctx context.Context, state *executionState) error {
+	return pg.SqlxTransaction(ctx, s.q, s.lggr, func(tx pg.Queryer) error {
+		res, err := tx.ExecContext(ctx, `
+INSERT INTO workflow_executions (id, workflow_id, status, created_at, updated_at)
+VALUES ($1, $2, $3, NOW(), NOW())
+ON CONFLICT (id) DO NOTHING`, state.executionID, state.workflowID, state.status)
+		if err != nil {
+			return fmt.Errorf("failed to insert execution %s: %w", state.executionID, err)
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if inserted == 0 {
+			return fmt.Errorf("execution ID %s already exists in store", state.executionID)
+		}
+
+		for _, step := range state.steps {
+			if err := upsertStep(ctx, tx, step); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// updateStep updates a step for the given executionID
+func (s *dbStore) updateStep(ctx context.Context, step *stepState) (executionState, error) {
+	err := pg.SqlxTransaction(ctx, s.q, s.lggr, func(tx pg.Queryer) error {
+		res, err := tx.ExecContext(ctx, `UPDATE workflow_executions SET updated_at = NOW() WHERE id = $1`, step.executionID)
+		if err != nil {
+			return fmt.Errorf("failed to update execution %s: %w", step.executionID, err)
+		}
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return fmt.Errorf("could not find execution %s", step.executionID)
+		}
+		return upsertStep(ctx, tx, step)
+	})
+	if err != nil {
+		return executionState{}, err
+	}
+	return s.get(ctx, step.executionID)
+}
+
+// updateStatus updates the status for the given executionID
+func (s *dbStore) updateStatus(ctx context.Context, executionID string, status string) error {
+	res, err := s.q.ExecContext(ctx, `
+UPDATE workflow_executions SET status = $2, updated_at = NOW(),
+	finished_at = CASE WHEN $3::boolean THEN NOW() ELSE NULL END
+WHERE id = $1`, executionID, status, status != statusStarted)
+	if err != nil {
+		return fmt.Errorf("failed to update status of execution %s: %w", executionID, err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("could not find execution %s", executionID)
+	}
+	return nil
+}
+
+// get gets the state for the given executionID
+func (s *dbStore) get(ctx context.Context, executionID string) (executionState, error) {
+	var row executionRow
+	err := s.q.GetContext(ctx, &row, `SELECT id, workflow_id, status FROM workflow_executions WHERE id = $1`, executionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return executionState{}, fmt.Errorf("could not find execution %s", executionID)
+	} else if err != nil {
+		return executionState{}, fmt.Errorf("failed to get execution %s: %w", executionID, err)
+	}
+	return s.withSteps(ctx, row)
+}
+
+// unfinished returns the executions of the given workflow still in progress
+func (s *dbStore) unfinished(ctx context.Context, workflowID string) ([]executionState, error) {
+	var rows []executionRow
+	err := s.q.SelectContext(ctx, &rows, `
+SELECT id, workflow_id, status FROM workflow_executions
+WHERE workflow_id = $1 AND status = $2
+ORDER BY created_at ASC`, workflowID, statusStarted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unfinished executions of workflow %s: %w", workflowID, err)
+	}
+
+	states := make([]executionState, 0, len(rows))
+	for _, row := range rows {
+		state, err := s.withSteps(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+func (s *dbStore) withSteps(ctx context.Context, row executionRow) (executionState, error) {
+	var stepRows []stepRow
+	err := s.q.SelectContext(ctx, &stepRows, `
+SELECT workflow_execution_id, ref, status, inputs, outputs, output_err FROM workflow_steps
+WHERE workflow_execution_id = $1`, row.ID)
+	if err != nil {
+		return executionState{}, fmt.Errorf("failed to get steps of execution %s: %w", row.ID, err)
+	}
+
+	state := executionState{
+		steps:       make(map[string]*stepState, len(stepRows)),
+		executionID: row.ID,
+		workflowID:  row.WorkflowID,
+		status:      row.Status,
+	}
+	for _, sr := range stepRows {
+		step, err := sr.toStepState()
+		if err != nil {
+			return executionState{}, fmt.Errorf("failed to decode step %s of execution %s: %w", sr.Ref, row.ID, err)
+		}
+		state.steps[step.ref] = step
+	}
+	return state, nil
+}
+
+func upsertStep(ctx context.Context, q pg.Queryer, step *stepState) error {
+	row, err := newStepRow(step)
+	if err != nil {
+		return fmt.Errorf("failed to encode step %s of execution %s: %w", step.ref, step.executionID, err)
+	}
+	_, err = q.ExecContext(ctx, `
+INSERT INTO workflow_steps (workflow_execution_id, ref, status, inputs, outputs, output_err, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())
+ON CONFLICT (workflow_execution_id, ref) DO UPDATE
+SET status = $3, inputs = $4, outputs = $5, output_err = $6, updated_at = NOW()`,
+		row.WorkflowExecutionID, row.Ref, row.Status, row.Inputs, row.Outputs, row.OutputErr)
+	if err != nil {
+		return fmt.Errorf("failed to upsert step %s of execution %s: %w", step.ref, step.executionID, err)
+	}
+	return nil
+}
+
+func newStepRow(step *stepState) (stepRow, error) {
+	row := stepRow{
+		WorkflowExecutionID: step.executionID,
+		Ref:                 step.ref,
+		Status:              step.status,
+	}
+
+	if step.inputs != nil {
+		inputs, err := proto.Marshal(values.Proto(step.inputs))
+		if err != nil {
+			return stepRow{}, err
+		}
+		row.Inputs = inputs
+	}
+
+	if step.outputs != nil {
+		if step.outputs.value != nil {
+			outputs, err := proto.Marshal(values.Proto(step.outputs.value))
+			if err != nil {
+				return stepRow{}, err
+			}
+			row.Outputs = outputs
+		}
+		if step.outputs.err != nil {
+			row.OutputErr = sql.NullString{String: step.outputs.err.Error(), Valid: true}
+		}
+	}
+	return row, nil
+}
+
+func (r stepRow) toStepState() (*stepState, error) {
+	step := &stepState{
+		executionID: r.WorkflowExecutionID,
+		ref:         r.Ref,
+		status:      r.Status,
+		outputs:     &stepOutput{},
+	}
+
+	if r.Inputs != nil {
+		inputs := &pb.Value{}
+		if err := proto.Unmarshal(r.Inputs, inputs); err != nil {
+			return nil, err
+		}
+		step.inputs = values.FromMapValueProto(inputs.GetMapValue())
+	}
+
+	if r.Outputs != nil {
+		outputs := &pb.Value{}
+		if err := proto.Unmarshal(r.Outputs, outputs); err != nil {
+			return nil, err
+		}
+		step.outputs.value = values.FromProto(outputs)
+	}
+
+	if r.OutputErr.Valid {
+		step.outputs.err = errors.New(r.OutputErr.String)
+	}
+	return step, nil
+}
@@ -0,0 +1,122 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newTestDBStore(t *testing.T) *dbStore {
+	return newDBStore(pgtest.NewSqlxDB(t), logger.TestLogger(t))
+}
+
+func newTestExecutionState(t *testing.T, executionID string, workflowID string) *executionState {
+	triggerOutputs, err := values.NewMap(map[string]any{"feedID": "0x1111", "price": int64(100)})
+	require.NoError(t, err)
+
+	return &executionState{
+		steps: map[string]*stepState{
+			keywordTrigger: {
+				executionID: executionID,
+				ref:         keywordTrigger,
+				status:      statusCompleted,
+				outputs:     &stepOutput{value: triggerOutputs},
+			},
+		},
+		executionID: executionID,
+		workflowID:  workflowID,
+		status:      statusStarted,
+	}
+}
+
+func TestDBStore_AddAndGet(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := newTestDBStore(t)
+
+	state := newTestExecutionState(t, "execution-1", "workflow-1")
+	require.NoError(t, s.add(ctx, state))
+
+	got, err := s.get(ctx, "execution-1")
+	require.NoError(t, err)
+	assert.Equal(t, *state, got)
+
+	err = s.add(ctx, state)
+	require.ErrorContains(t, err, "already exists")
+
+	_, err = s.get(ctx, "unknown-execution")
+	require.ErrorContains(t, err, "could not find execution")
+}
+
+func TestDBStore_UpdateStep(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := newTestDBStore(t)
+
+	state := newTestExecutionState(t, "execution-1", "workflow-1")
+	require.NoError(t, s.add(ctx, state))
+
+	inputs, err := values.NewMap(map[string]any{"observations": []any{"a", "b"}})
+	require.NoError(t, err)
+	step := &stepState{
+		executionID: "execution-1",
+		ref:         "evm_median",
+		status:      statusCompleted,
+		inputs:      inputs,
+		outputs:     &stepOutput{value: values.NewString("report")},
+	}
+	got, err := s.updateStep(ctx, step)
+	require.NoError(t, err)
+	assert.Len(t, got.steps, 2)
+	assert.Equal(t, step, got.steps["evm_median"])
+
+	// Updating the same step overrides its state, errors are kept as their message.
+	errored := &stepState{
+		executionID: "execution-1",
+		ref:         "evm_median",
+		status:      statusErrored,
+		inputs:      inputs,
+		outputs:     &stepOutput{err: errors.New("fatal consensus error")},
+	}
+	got, err = s.updateStep(ctx, errored)
+	require.NoError(t, err)
+	assert.Len(t, got.steps, 2)
+	assert.Equal(t, statusErrored, got.steps["evm_median"].status)
+	assert.Nil(t, got.steps["evm_median"].outputs.value)
+	assert.EqualError(t, got.steps["evm_median"].outputs.err, "fatal consensus error")
+
+	_, err = s.updateStep(ctx, &stepState{executionID: "unknown-execution", ref: "evm_median", outputs: &stepOutput{}})
+	require.ErrorContains(t, err, "could not find execution")
+}
+
+func TestDBStore_UpdateStatusAndUnfinished(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := newTestDBStore(t)
+
+	require.NoError(t, s.add(ctx, newTestExecutionState(t, "execution-1", "workflow-1")))
+	require.NoError(t, s.add(ctx, newTestExecutionState(t, "execution-2", "workflow-1")))
+	require.NoError(t, s.add(ctx, newTestExecutionState(t, "execution-3", "workflow-2")))
+
+	unfinished, err := s.unfinished(ctx, "workflow-1")
+	require.NoError(t, err)
+	assert.Len(t, unfinished, 2)
+
+	require.NoError(t, s.updateStatus(ctx, "execution-1", statusCompleted))
+	got, err := s.get(ctx, "execution-1")
+	require.NoError(t, err)
+	assert.Equal(t, statusCompleted, got.status)
+
+	unfinished, err = s.unfinished(ctx, "workflow-1")
+	require.NoError(t, err)
+	require.Len(t, unfinished, 1)
+	assert.Equal(t, "execution-2", unfinished[0].executionID)
+	assert.Len(t, unfinished[0].steps, 1)
+
+	require.ErrorContains(t, s.updateStatus(ctx, "unknown-execution", statusCompleted), "could not find execution")
+}
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE workflow_executions (
+    id TEXT PRIMARY KEY,
+    workflow_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_workflow_executions_workflow_id_status ON workflow_executions(workflow_id, status);
+
+CREATE TABLE workflow_steps (
+    id BIGSERIAL PRIMARY KEY,
+    workflow_execution_id TEXT NOT NULL REFERENCES workflow_executions(id) ON DELETE CASCADE,
+    ref TEXT NOT NULL,
+    status TEXT NOT NULL,
+    inputs BYTEA,
+    outputs BYTEA,
+    output_err TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_workflow_steps_execution_ref UNIQUE (workflow_execution_id, ref)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS workflow_steps;
+DROP TABLE IF EXISTS workflow_executions;
+-- +goose StatementEnd
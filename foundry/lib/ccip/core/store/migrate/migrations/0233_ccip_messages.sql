@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE ccip_messages (
+    message_id TEXT PRIMARY KEY,
+    source_chain_selector NUMERIC(20,0) NOT NULL,
+    dest_chain_selector NUMERIC(20,0) NOT NULL,
+    on_ramp TEXT NOT NULL,
+    off_ramp TEXT NOT NULL,
+    sequence_number BIGINT NOT NULL,
+    sender TEXT NOT NULL,
+    receiver TEXT NOT NULL,
+    status TEXT NOT NULL,
+    merkle_root TEXT,
+    send_tx_hash TEXT NOT NULL,
+    commit_tx_hash TEXT,
+    execution_tx_hash TEXT,
+    sent_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_ccip_messages_lane_sequence_number ON ccip_messages(dest_chain_selector, off_ramp, sequence_number);
+CREATE INDEX idx_ccip_messages_merkle_root ON ccip_messages(merkle_root);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ccip_messages;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE ccip_inflight_exec_reports (
+    dest_chain_selector NUMERIC(20,0) NOT NULL,
+    off_ramp TEXT NOT NULL,
+    min_sequence_number BIGINT NOT NULL,
+    messages JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (dest_chain_selector, off_ramp, min_sequence_number)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ccip_inflight_exec_reports;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+// CCIPChainHealthController manages the chain healthchecks of the running CCIP jobs.
+type CCIPChainHealthController struct {
+	App chainlink.Application
+}
+
+// ClearStickyUnhealthy clears the sticky unhealthy status of the chain healthcheck of a CCIP job, if its chain
+// health policy allows it.
+// Example:
+//
+//	"POST <application>/ccip/chain_health/:JobID/clear"
+func (cc *CCIPChainHealthController) ClearStickyUnhealthy(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("JobID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := cc.App.CCIPChainHealthRegistry().ClearStickyUnhealthy(j.ID)
+	if errors.Is(err, ccip.ErrChainHealthcheckNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if errors.Is(err, ccipconfig.ErrStickyUnhealthyNotClearable) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	cc.App.GetLogger().Warnw("Sticky unhealthy status of CCIP job cleared", "jobID", j.ID)
+	jsonAPIResponseWithStatus(c, nil, "ccip_chain_health", http.StatusNoContent)
+}
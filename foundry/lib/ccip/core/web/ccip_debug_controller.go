@@ -0,0 +1,42 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+)
+
+// CCIPDebugController exposes the rounds recorded by the running CCIP jobs with debugRounds enabled.
+type CCIPDebugController struct {
+	App chainlink.Application
+}
+
+// Show returns the latest rounds of a CCIP job as JSON, oldest first: the observation of the node, the
+// observations it received as a leader, the consensus it computed and why the finalized reports were rejected.
+// Example:
+//
+//	"GET <application>/ccip/debug/:JobID"
+func (cc *CCIPDebugController) Show(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("JobID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rounds, err := cc.App.CCIPDebugRegistry().Rounds(j.ID)
+	if errors.Is(err, ccip.ErrDebugRecorderNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rounds)
+}
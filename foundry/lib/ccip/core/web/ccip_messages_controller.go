@@ -0,0 +1,138 @@
+package web
+
+import (
+	"database/sql"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CCIPMessagesController displays the tracked status of CCIP messages.
+type CCIPMessagesController struct {
+	App chainlink.Application
+}
+
+// Show returns the tracked status of a CCIP message.
+// Example:
+//
+//	"<application>/ccip/messages/:MessageID"
+func (cc *CCIPMessagesController) Show(c *gin.Context) {
+	id, err := msgtracker.ParseMessageID(c.Param("MessageID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	msg, err := cc.App.CCIPMessageORM().FindMessage(c, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("CCIP message not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewCCIPMessageResource(msg), "ccip_message")
+}
+
+// ManualExecution returns the manuallyExecute calls of the CCIP messages with the given message ID, or sent by
+// the given source chain transaction hash. Messages that are not tracked are looked up in the onRamp logs by the
+// transaction hash. The optional gasLimit query param overrides the gas limit of the messages.
+// Example:
+//
+//	"<application>/ccip/manual_executions/:ID?gasLimit=500000"
+func (cc *CCIPMessagesController) ManualExecution(c *gin.Context) {
+	id, err := msgtracker.ParseMessageID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var gasLimitOverride *big.Int
+	if gasLimit := c.Query("gasLimit"); gasLimit != "" {
+		var ok bool
+		gasLimitOverride, ok = new(big.Int).SetString(gasLimit, 10)
+		if !ok || gasLimitOverride.Sign() < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid gasLimit %q", gasLimit))
+			return
+		}
+	}
+
+	orm := cc.App.CCIPMessageORM()
+	msgs, err := orm.FindMessagesBySendTxHash(c, id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(msgs) == 0 {
+		msg, err2 := orm.FindMessage(c, id)
+		if err2 != nil && !errors.Is(err2, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusInternalServerError, err2)
+			return
+		}
+		if err2 == nil {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	registry := cc.App.CCIPManualExecutionRegistry()
+	executions := make([]presenters.CCIPManualExecutionResource, 0, len(msgs))
+	for _, msg := range msgs {
+		jobID, err := cc.App.JobORM().FindOCR2JobIDByAddress(msg.OffRamp, nil)
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.Errorf("no execution job found for offRamp %s", msg.OffRamp))
+			return
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		executor, err := registry.Executor(jobID)
+		if err != nil {
+			jsonAPIError(c, http.StatusNotFound, errors.Wrapf(err, "execution job %d of offRamp %s", jobID, msg.OffRamp))
+			return
+		}
+
+		execution, err := executor.Build(c, msg.SequenceNumber, gasLimitOverride)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrapf(err, "failed to build the manual execution of message %s", msg.MessageID))
+			return
+		}
+		executions = append(executions, *presenters.NewCCIPManualExecutionResource(execution))
+	}
+
+	if len(msgs) == 0 {
+		// Messages sent before the tracker started are not tracked, they are looked up in the onRamp logs of the running
+		// execution jobs instead. Message IDs are not indexed in the logs, so these can only be found by send tx hash.
+		for _, executor := range registry.Executors() {
+			sendReqs, err := executor.SendRequestsByTxHash(c, cciptypes.Hash(common.HexToHash(id)))
+			if err != nil {
+				jsonAPIError(c, http.StatusInternalServerError, err)
+				return
+			}
+			for _, sendReq := range sendReqs {
+				execution, err := executor.Build(c, sendReq.SequenceNumber, gasLimitOverride)
+				if err != nil {
+					jsonAPIError(c, http.StatusInternalServerError, errors.Wrapf(err, "failed to build the manual execution of message %s", sendReq.MessageID.String()))
+					return
+				}
+				executions = append(executions, *presenters.NewCCIPManualExecutionResource(execution))
+			}
+		}
+		if len(executions) == 0 {
+			jsonAPIError(c, http.StatusNotFound, errors.New("CCIP message not found, untracked messages can only be found by their send tx hash"))
+			return
+		}
+	}
+
+	jsonAPIResponse(c, executions, "ccip_manual_executions")
+}
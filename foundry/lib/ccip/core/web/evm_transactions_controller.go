@@ -1,13 +1,19 @@
 package web
 
 import (
+	"context"
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 )
@@ -28,6 +34,20 @@ func (tc *TransactionsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "transactions", size, page, ptxs, count, err)
 }
 
+// Dropped returns paginated transactions dropped before broadcast because they reverted during simulation.
+// Example:
+//
+//	"<application>/transactions/evm/dropped"
+func (tc *TransactionsController) Dropped(c *gin.Context, size, page, offset int) {
+	txs, count, err := tc.App.TxmStorageService().DroppedTransactions(c, offset, size)
+	ptxs := make([]presenters.EthTxResource, len(txs))
+	for i, tx := range txs {
+		ptxs[i] = presenters.NewEthTxResource(tx)
+		ptxs[i].JAID = presenters.NewJAID(strconv.FormatInt(tx.ID, 10))
+	}
+	paginatedResponse(c, "transactions", size, page, ptxs, count, err)
+}
+
 // Show returns the details of a Ethereum Transaction details.
 // Example:
 //
@@ -47,3 +67,105 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// ReplaceTransactionRequest carries the new payload, and optionally the new gas limit, of a replaced transaction.
+type ReplaceTransactionRequest struct {
+	Data     hexutil.Bytes `json:"data"`
+	GasLimit *uint64       `json:"gasLimit,omitempty"`
+}
+
+// Cancel cancels a Ethereum Transaction, given by its ID or by the hash of one of its attempts. An unconfirmed
+// transaction is replaced by a zero value transfer to its own sender at the same nonce.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	etxID, chain, ok := tc.findTxAndChain(c)
+	if !ok {
+		return
+	}
+
+	etx, err := chain.TxManager().Cancel(c, etxID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to cancel transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTX": etx,
+	})
+
+	jsonAPIResponse(c, newEthTxResourceFromLatestAttempt(etx), "transaction")
+}
+
+// Replace replaces the payload, and the gas limit when given, of a Ethereum Transaction, given by its ID or by the hash
+// of one of its attempts. An unconfirmed transaction is re-sent with the new payload at the same nonce.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/replace"
+func (tc *TransactionsController) Replace(c *gin.Context) {
+	var req ReplaceTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	etxID, chain, ok := tc.findTxAndChain(c)
+	if !ok {
+		return
+	}
+
+	etx, err := chain.TxManager().Replace(c, etxID, req.Data, req.GasLimit)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to replace transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionReplaced, map[string]interface{}{
+		"ethTX": etx,
+	})
+
+	jsonAPIResponse(c, newEthTxResourceFromLatestAttempt(etx), "transaction")
+}
+
+func (tc *TransactionsController) findTxAndChain(c *gin.Context) (int64, legacyevm.Chain, bool) {
+	etx, err := tc.findTx(c, c.Param("TxHash"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return 0, nil, false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return 0, nil, false
+	}
+
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), etx.ChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return 0, nil, false
+	}
+	return etx.ID, chain, true
+}
+
+// findTx finds a transaction by its ID or by the hash of one of its attempts. Unstarted transactions have no attempts,
+// they can only be found by ID.
+func (tc *TransactionsController) findTx(ctx context.Context, idOrHash string) (txmgr.Tx, error) {
+	if id, err := strconv.ParseInt(idOrHash, 10, 64); err == nil {
+		return tc.App.TxmStorageService().FindTxWithAttempts(ctx, id)
+	}
+	attempt, err := tc.App.TxmStorageService().FindTxAttempt(ctx, common.HexToHash(idOrHash))
+	if err != nil {
+		return txmgr.Tx{}, err
+	}
+	return attempt.Tx, nil
+}
+
+// newEthTxResourceFromLatestAttempt presents the tx with its highest priced attempt, if it has any.
+func newEthTxResourceFromLatestAttempt(etx txmgr.Tx) presenters.EthTxResource {
+	if len(etx.TxAttempts) == 0 {
+		return presenters.NewEthTxResource(etx)
+	}
+	attempt := etx.TxAttempts[0]
+	attempt.Tx = etx
+	return presenters.NewEthTxResourceFromAttempt(attempt)
+}
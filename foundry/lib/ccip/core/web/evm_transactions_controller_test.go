@@ -1,13 +1,16 @@
 package web_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"testing"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -16,6 +19,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestTransactionsController_Index_Success(t *testing.T) {
@@ -76,6 +80,44 @@ func TestTransactionsController_Index_Error(t *testing.T) {
 	cltest.AssertServerResponse(t, resp, 422)
 }
 
+func TestTransactionsController_Dropped_Success(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	ctx := testutils.Context(t)
+
+	txStore := cltest.NewTestTxStore(t, app.GetSqlxDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+
+	fatal := cltest.NewEthTx(from)
+	fatal.State = txmgrcommon.TxFatalError
+	fatal.Error = null.StringFrom("something exploded")
+	require.NoError(t, txStore.InsertTx(ctx, &fatal))
+
+	dropped := cltest.NewEthTx(from)
+	dropped.State = txmgrcommon.TxFatalError
+	dropped.Error = null.StringFrom("transaction reverted during simulation: json-rpc error { Code = 3, Message = 'execution reverted' }")
+	dropped.RevertReason = null.StringFrom("CCIPReceiver.InvalidRouter(0x0000000000000000000000000000000000000001)")
+	require.NoError(t, txStore.InsertTx(ctx, &dropped))
+
+	resp, cleanup := client.Get("/v2/transactions/evm/dropped?size=10")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var txs []presenters.EthTxResource
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &txs, &links))
+
+	require.Len(t, txs, 1)
+	assert.Equal(t, fmt.Sprint(dropped.ID), txs[0].ID)
+	assert.Equal(t, dropped.RevertReason.String, txs[0].RevertReason)
+}
+
 func TestTransactionsController_Show_Success(t *testing.T) {
 	t.Parallel()
 
@@ -126,3 +168,76 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Post("/v2/transactions/evm/"+utils.NewHash().String()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_Cancel_Confirmed(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	txStore := cltest.NewTestTxStore(t, app.GetSqlxDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+	require.Len(t, tx.TxAttempts, 1)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+tx.TxAttempts[0].Hash.String()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestTransactionsController_Cancel_UnstartedByID(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	txStore := cltest.NewTestTxStore(t, app.GetSqlxDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	tx := cltest.NewEthTx(from)
+	tx.ChainID = &cltest.FixtureChainID
+	require.NoError(t, txStore.InsertTx(testutils.Context(t), &tx))
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", tx.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTxResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, string(txmgrcommon.TxFatalError), ptx.State)
+
+	tx, err := txStore.FindTxWithAttempts(testutils.Context(t), tx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxFatalError, tx.State)
+	assert.Equal(t, "cancelled", tx.Error.String)
+}
+
+func TestTransactionsController_Replace_InvalidBody(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	txStore := cltest.NewTestTxStore(t, app.GetSqlxDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+	require.Len(t, tx.TxAttempts, 1)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+tx.TxAttempts[0].Hash.String()+"/replace", bytes.NewBufferString(`{"data": "not hex"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
@@ -0,0 +1,88 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
+)
+
+// CCIPMessageResource represents the tracked status of a CCIP message JSONAPI resource.
+type CCIPMessageResource struct {
+	JAID
+	SourceChainSelector string      `json:"sourceChainSelector"`
+	DestChainSelector   string      `json:"destChainSelector"`
+	OnRamp              string      `json:"onRamp"`
+	OffRamp             string      `json:"offRamp"`
+	SequenceNumber      uint64      `json:"sequenceNumber"`
+	Sender              string      `json:"sender"`
+	Receiver            string      `json:"receiver"`
+	Status              string      `json:"status"`
+	MerkleRoot          null.String `json:"merkleRoot"`
+	SendTxHash          string      `json:"sendTxHash"`
+	CommitTxHash        null.String `json:"commitTxHash"`
+	ExecutionTxHash     null.String `json:"executionTxHash"`
+	SentAt              time.Time   `json:"sentAt"`
+	UpdatedAt           time.Time   `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPMessageResource) GetName() string {
+	return "ccip_messages"
+}
+
+// NewCCIPMessageResource constructs a new CCIPMessageResource
+func NewCCIPMessageResource(msg msgtracker.Message) *CCIPMessageResource {
+	return &CCIPMessageResource{
+		JAID:                NewJAID(msg.MessageID),
+		SourceChainSelector: strconv.FormatUint(msg.SourceChainSelector, 10),
+		DestChainSelector:   strconv.FormatUint(msg.DestChainSelector, 10),
+		OnRamp:              msg.OnRamp,
+		OffRamp:             msg.OffRamp,
+		SequenceNumber:      msg.SequenceNumber,
+		Sender:              msg.Sender,
+		Receiver:            msg.Receiver,
+		Status:              string(msg.Status),
+		MerkleRoot:          msg.MerkleRoot,
+		SendTxHash:          msg.SendTxHash,
+		CommitTxHash:        msg.CommitTxHash,
+		ExecutionTxHash:     msg.ExecutionTxHash,
+		SentAt:              msg.SentAt,
+		UpdatedAt:           msg.UpdatedAt,
+	}
+}
+
+// CCIPManualExecutionResource represents the manuallyExecute call of a CCIP message JSONAPI resource.
+type CCIPManualExecutionResource struct {
+	JAID
+	OffRamp           string          `json:"offRamp"`
+	SequenceNumber    uint64          `json:"sequenceNumber"`
+	MerkleRoot        string          `json:"merkleRoot"`
+	OffchainTokenData []hexutil.Bytes `json:"offchainTokenData"`
+	Calldata          hexutil.Bytes   `json:"calldata"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPManualExecutionResource) GetName() string {
+	return "ccip_manual_executions"
+}
+
+// NewCCIPManualExecutionResource constructs a new CCIPManualExecutionResource
+func NewCCIPManualExecutionResource(execution ccipexec.ManualExecution) *CCIPManualExecutionResource {
+	tokenData := make([]hexutil.Bytes, len(execution.OffchainTokenData))
+	for i, data := range execution.OffchainTokenData {
+		tokenData[i] = data
+	}
+	return &CCIPManualExecutionResource{
+		JAID:              NewJAID(execution.MessageID.String()),
+		OffRamp:           string(execution.OffRamp),
+		SequenceNumber:    execution.SequenceNumber,
+		MerkleRoot:        hexutil.Encode(execution.MerkleRoot[:]),
+		OffchainTokenData: tokenData,
+		Calldata:          execution.Calldata,
+	}
+}
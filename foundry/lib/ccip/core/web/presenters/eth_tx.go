@@ -26,6 +26,8 @@ type EthTxResource struct {
 	To         *common.Address `json:"to"`
 	Value      string          `json:"value"`
 	EVMChainID big.Big         `json:"evmChainID"`
+	// RevertReason is set on transactions dropped because they reverted during simulation.
+	RevertReason string `json:"revertReason,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -48,6 +50,9 @@ func NewEthTxResource(tx txmgr.Tx) EthTxResource {
 		To:       &tx.ToAddress,
 		Value:    v.String(),
 	}
+	if tx.RevertReason.Valid {
+		r.RevertReason = tx.RevertReason.String
+	}
 
 	if tx.ChainID != nil {
 		r.EVMChainID = *big.New(tx.ChainID)
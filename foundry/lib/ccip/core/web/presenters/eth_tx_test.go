@@ -9,6 +9,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -62,6 +63,11 @@ func TestEthTxResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 
+	dropped := tx
+	dropped.State = txmgrcommon.TxFatalError
+	dropped.RevertReason = null.StringFrom("CCIPReceiver.InvalidRouter(0x0000000000000000000000000000000000000001)")
+	assert.Equal(t, dropped.RevertReason.String, NewEthTxResource(dropped).RevertReason)
+
 	var (
 		nonce           = evmtypes.Nonce(100)
 		hash            = common.BytesToHash([]byte{1, 2, 3})
@@ -0,0 +1,116 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
+)
+
+// CCIPMessageResolver resolves the CCIPMessage type.
+type CCIPMessageResolver struct {
+	msg msgtracker.Message
+}
+
+func NewCCIPMessage(msg msgtracker.Message) *CCIPMessageResolver {
+	return &CCIPMessageResolver{msg: msg}
+}
+
+// MessageID resolves the message's ID.
+func (r *CCIPMessageResolver) MessageID() graphql.ID {
+	return graphql.ID(r.msg.MessageID)
+}
+
+// SourceChainSelector resolves the message's source chain selector.
+func (r *CCIPMessageResolver) SourceChainSelector() string {
+	return strconv.FormatUint(r.msg.SourceChainSelector, 10)
+}
+
+// DestChainSelector resolves the message's destination chain selector.
+func (r *CCIPMessageResolver) DestChainSelector() string {
+	return strconv.FormatUint(r.msg.DestChainSelector, 10)
+}
+
+// OnRamp resolves the address of the message's onRamp.
+func (r *CCIPMessageResolver) OnRamp() string {
+	return r.msg.OnRamp
+}
+
+// OffRamp resolves the address of the message's offRamp.
+func (r *CCIPMessageResolver) OffRamp() string {
+	return r.msg.OffRamp
+}
+
+// SequenceNumber resolves the message's sequence number.
+func (r *CCIPMessageResolver) SequenceNumber() string {
+	return strconv.FormatUint(r.msg.SequenceNumber, 10)
+}
+
+// Sender resolves the message's sender.
+func (r *CCIPMessageResolver) Sender() string {
+	return r.msg.Sender
+}
+
+// Receiver resolves the message's receiver.
+func (r *CCIPMessageResolver) Receiver() string {
+	return r.msg.Receiver
+}
+
+// Status resolves the message's status.
+func (r *CCIPMessageResolver) Status() string {
+	return string(r.msg.Status)
+}
+
+// MerkleRoot resolves the merkle root the message was committed in.
+func (r *CCIPMessageResolver) MerkleRoot() *string {
+	return r.msg.MerkleRoot.Ptr()
+}
+
+// SendTxHash resolves the hash of the source chain transaction that sent the message.
+func (r *CCIPMessageResolver) SendTxHash() string {
+	return r.msg.SendTxHash
+}
+
+// CommitTxHash resolves the hash of the destination chain transaction that committed the message.
+func (r *CCIPMessageResolver) CommitTxHash() *string {
+	return r.msg.CommitTxHash.Ptr()
+}
+
+// ExecutionTxHash resolves the hash of the destination chain transaction that executed the message.
+func (r *CCIPMessageResolver) ExecutionTxHash() *string {
+	return r.msg.ExecutionTxHash.Ptr()
+}
+
+// SentAt resolves the block timestamp the message was sent at.
+func (r *CCIPMessageResolver) SentAt() graphql.Time {
+	return graphql.Time{Time: r.msg.SentAt}
+}
+
+// UpdatedAt resolves the time the message's status was last updated.
+func (r *CCIPMessageResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.msg.UpdatedAt}
+}
+
+// -- CCIPMessage Query --
+
+// CCIPMessagePayloadResolver resolves a single CCIP message response
+type CCIPMessagePayloadResolver struct {
+	msg msgtracker.Message
+	NotFoundErrorUnionType
+}
+
+func NewCCIPMessagePayload(msg msgtracker.Message, err error) *CCIPMessagePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "ccip message not found"}
+
+	return &CCIPMessagePayloadResolver{msg: msg, NotFoundErrorUnionType: e}
+}
+
+// ToCCIPMessage implements the CCIPMessage union type of the payload
+func (r *CCIPMessagePayloadResolver) ToCCIPMessage() (*CCIPMessageResolver, bool) {
+	if r.err == nil {
+		return NewCCIPMessage(r.msg), true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,109 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
+)
+
+func TestResolver_CCIPMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		messageID = "0xabcd000000000000000000000000000000000000000000000000000000000000"
+		query     = `
+			query GetCCIPMessage {
+				ccipMessage(id: "0xABCD000000000000000000000000000000000000000000000000000000000000") {
+					... on CCIPMessage {
+						messageID
+						sourceChainSelector
+						destChainSelector
+						onRamp
+						offRamp
+						sequenceNumber
+						sender
+						receiver
+						status
+						merkleRoot
+						sendTxHash
+						commitTxHash
+						executionTxHash
+						sentAt
+						updatedAt
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "ccipMessage"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CCIPMessageORM").Return(f.Mocks.ccipMessageORM)
+				f.Mocks.ccipMessageORM.On("FindMessage", mock.Anything, messageID).Return(msgtracker.Message{
+					MessageID:           messageID,
+					SourceChainSelector: 5009297550715157269,
+					DestChainSelector:   16015286601757825753,
+					OnRamp:              "0x1111111111111111111111111111111111111111",
+					OffRamp:             "0x2222222222222222222222222222222222222222",
+					SequenceNumber:      42,
+					Sender:              "0x3333333333333333333333333333333333333333",
+					Receiver:            "0x4444444444444444444444444444444444444444",
+					Status:              msgtracker.StatusCommitted,
+					MerkleRoot:          null.StringFrom("0xroot"),
+					SendTxHash:          "0xsend",
+					CommitTxHash:        null.StringFrom("0xcommit"),
+					SentAt:              f.Timestamp(),
+					UpdatedAt:           f.Timestamp(),
+				}, nil)
+			},
+			query: query,
+			result: `{
+				"ccipMessage": {
+					"messageID": "0xabcd000000000000000000000000000000000000000000000000000000000000",
+					"sourceChainSelector": "5009297550715157269",
+					"destChainSelector": "16015286601757825753",
+					"onRamp": "0x1111111111111111111111111111111111111111",
+					"offRamp": "0x2222222222222222222222222222222222222222",
+					"sequenceNumber": "42",
+					"sender": "0x3333333333333333333333333333333333333333",
+					"receiver": "0x4444444444444444444444444444444444444444",
+					"status": "committed",
+					"merkleRoot": "0xroot",
+					"sendTxHash": "0xsend",
+					"commitTxHash": "0xcommit",
+					"executionTxHash": null,
+					"sentAt": "2021-01-01T00:00:00Z",
+					"updatedAt": "2021-01-01T00:00:00Z"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CCIPMessageORM").Return(f.Mocks.ccipMessageORM)
+				f.Mocks.ccipMessageORM.On("FindMessage", mock.Anything, messageID).Return(msgtracker.Message{}, sql.ErrNoRows)
+			},
+			query: query,
+			result: `{
+				"ccipMessage": {
+					"message": "ccip message not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
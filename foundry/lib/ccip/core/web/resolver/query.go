@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
@@ -63,6 +64,29 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	return NewBridgesPayload(brdgs, int32(count)), nil
 }
 
+// CCIPMessage retrieves the tracked status of a CCIP message by its ID.
+func (r *Resolver) CCIPMessage(ctx context.Context, args struct{ ID graphql.ID }) (*CCIPMessagePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := msgtracker.ParseMessageID(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := r.App.CCIPMessageORM().FindMessage(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewCCIPMessagePayload(msg, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewCCIPMessagePayload(msg, nil), nil
+}
+
 // Chain retrieves a chain by id.
 func (r *Resolver) Chain(ctx context.Context, args struct{ ID graphql.ID }) (*ChainPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
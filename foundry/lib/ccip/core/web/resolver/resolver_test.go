@@ -25,6 +25,7 @@ import (
 	feedsMocks "github.com/smartcontractkit/chainlink/v2/core/services/feeds/mocks"
 	jobORMMocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	msgtrackerMocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/msgtracker/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -58,6 +59,7 @@ type mocks struct {
 	eIMgr                *webhookmocks.ExternalInitiatorManager
 	balM                 *evmORMMocks.BalanceMonitor
 	txmStore             *evmtxmgrmocks.EvmTxStore
+	ccipMessageORM       *msgtrackerMocks.ORM
 	auditLogger          *audit.AuditLoggerService
 }
 
@@ -117,6 +119,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		eIMgr:                webhookmocks.NewExternalInitiatorManager(t),
 		balM:                 evmORMMocks.NewBalanceMonitor(t),
 		txmStore:             evmtxmgrmocks.NewEvmTxStore(t),
+		ccipMessageORM:       msgtrackerMocks.NewORM(t),
 		auditLogger:          &audit.AuditLoggerService{},
 	}
 
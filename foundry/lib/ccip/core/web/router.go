@@ -286,10 +286,24 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/evm/dropped", paginatedRequest(txs.Dropped))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		// cancel and replace also accept the ID of the transaction, unstarted transactions have no hash
+		authv2.POST("/transactions/evm/:TxHash/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:TxHash/replace", auth.RequiresAdminRole(txs.Replace))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
+		ccipmc := CCIPMessagesController{app}
+		authv2.GET("/ccip/messages/:MessageID", ccipmc.Show)
+		authv2.GET("/ccip/manual_executions/:ID", ccipmc.ManualExecution)
+
+		ccipchc := CCIPChainHealthController{app}
+		authv2.POST("/ccip/chain_health/:JobID/clear", auth.RequiresAdminRole(ccipchc.ClearStickyUnhealthy))
+
+		ccipdc := CCIPDebugController{app}
+		authv2.GET("/ccip/debug/:JobID", ccipdc.Show)
+
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 